@@ -7,21 +7,23 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/preview/hdinsight/mgmt/2018-06-01-preview/hdinsight"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func hdinsightClusterUpdate(clusterKind string, readFunc schema.ReadFunc) schema.UpdateFunc {
 	return func(d *schema.ResourceData, meta interface{}) error {
-		client := meta.(*ArmClient).hdinsight.ClustersClient
-		ctx := meta.(*ArmClient).StopContext
+		client := meta.(*ArmClient).HDInsight().ClustersClient
+		ctx, cancel := timeouts.ForUpdate(meta.(*ArmClient).StopContext, d)
+		defer cancel()
 
-		id, err := parseAzureResourceID(d.Id())
+		id, err := azure.ParseHDInsightClusterID(d.Id())
 		if err != nil {
 			return err
 		}
 
 		resourceGroup := id.ResourceGroup
-		name := id.Path["clusters"]
+		name := id.Name
 
 		if d.HasChange("tags") {
 			tags := d.Get("tags").(map[string]interface{})
@@ -60,16 +62,17 @@ func hdinsightClusterUpdate(clusterKind string, readFunc schema.ReadFunc) schema
 
 func hdinsightClusterDelete(clusterKind string) schema.DeleteFunc {
 	return func(d *schema.ResourceData, meta interface{}) error {
-		client := meta.(*ArmClient).hdinsight.ClustersClient
-		ctx := meta.(*ArmClient).StopContext
+		client := meta.(*ArmClient).HDInsight().ClustersClient
+		ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+		defer cancel()
 
-		id, err := parseAzureResourceID(d.Id())
+		id, err := azure.ParseHDInsightClusterID(d.Id())
 		if err != nil {
 			return err
 		}
 
 		resourceGroup := id.ResourceGroup
-		name := id.Path["clusters"]
+		name := id.Name
 
 		future, err := client.Delete(ctx, resourceGroup, name)
 		if err != nil {
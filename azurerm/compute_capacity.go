@@ -0,0 +1,142 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	computeSvc "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/compute"
+)
+
+const (
+	computeCapacityResourceTypeDisks           = "disks"
+	computeCapacityResourceTypeVirtualMachines = "virtualMachines"
+)
+
+// computeCapacityRequest is a SKU (and the number of instances of it) used by a Compute resource
+type computeCapacityRequest struct {
+	ResourceType string
+	Sku          string
+	Instances    int64
+}
+
+// shouldValidateComputeCapacity returns whether the availability of the SKUs used by a resource should be validated
+// during the plan - which happens when the feature is enabled, all of the specified fields are known and either the
+// resource is new or one of these fields has changed
+func shouldValidateComputeCapacity(d *schema.ResourceDiff, meta interface{}, fields ...string) bool {
+	if meta == nil || !meta.(*ArmClient).features.ComputeCapacity.ValidateDuringPlan {
+		return false
+	}
+
+	for _, field := range fields {
+		if !d.NewValueKnown(field) {
+			return false
+		}
+	}
+
+	if d.Id() == "" {
+		return true
+	}
+
+	for _, field := range fields {
+		if d.HasChange(field) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// validateComputeCapacity validates that the requested SKUs are available in the specified Location (and Zones) and
+// that there's sufficient vCPU quota for the additional cores required over and above the existing SKUs
+func validateComputeCapacity(meta interface{}, location string, zones []string, existing []computeCapacityRequest, requested []computeCapacityRequest) error {
+	armClient := meta.(*ArmClient)
+	client := armClient.Compute()
+	ctx := armClient.StopContext
+
+	skus, err := client.AvailabilityCache.ResourceSkus(ctx, client.ResourceSkusClient)
+	if err != nil {
+		// the plan shouldn't fail if the SKUs can't be retrieved, since this isn't a prerequisite for the apply
+		log.Printf("[WARN] Unable to validate the availability of SKUs in %q: %+v", location, err)
+		return nil
+	}
+
+	familyCores := make(map[string]int64)
+	totalCores := int64(0)
+
+	for _, request := range requested {
+		if request.Sku == "" {
+			continue
+		}
+
+		sku, err := computeSvc.ValidateSkuAvailability(skus, request.ResourceType, request.Sku, location, zones)
+		if err != nil {
+			return err
+		}
+
+		if request.ResourceType != computeCapacityResourceTypeVirtualMachines || sku.Family == nil {
+			continue
+		}
+
+		cores := computeSvc.ResourceSkuVCPUs(*sku) * request.Instances
+		familyCores[*sku.Family] += cores
+		totalCores += cores
+	}
+
+	for _, request := range existing {
+		if request.ResourceType != computeCapacityResourceTypeVirtualMachines {
+			continue
+		}
+
+		sku := computeSvc.FindResourceSku(skus, request.ResourceType, request.Sku, location)
+		if sku == nil || sku.Family == nil {
+			continue
+		}
+
+		cores := computeSvc.ResourceSkuVCPUs(*sku) * request.Instances
+		familyCores[*sku.Family] -= cores
+		totalCores -= cores
+	}
+
+	additionalCoresRequired := totalCores > 0
+	for _, cores := range familyCores {
+		if cores > 0 {
+			additionalCoresRequired = true
+		}
+	}
+	if !additionalCoresRequired {
+		return nil
+	}
+
+	usages, err := client.AvailabilityCache.Usages(ctx, client.UsageClient, location)
+	if err != nil {
+		log.Printf("[WARN] Unable to validate the vCPU quota in %q: %+v", location, err)
+		return nil
+	}
+
+	if err := computeSvc.ValidateCoreQuota(usages, "cores", totalCores); err != nil {
+		return fmt.Errorf("%s in %q", err, location)
+	}
+
+	for family, cores := range familyCores {
+		if err := computeSvc.ValidateCoreQuota(usages, family, cores); err != nil {
+			return fmt.Errorf("%s in %q", err, location)
+		}
+	}
+
+	return nil
+}
+
+// expandComputeCapacityZones returns the Zones from the specified field of the ResourceDiff
+func expandComputeCapacityZones(d *schema.ResourceDiff, field string) []string {
+	zones := make([]string, 0)
+
+	for _, v := range d.Get(field).([]interface{}) {
+		if zone, ok := v.(string); ok && strings.TrimSpace(zone) != "" {
+			zones = append(zones, zone)
+		}
+	}
+
+	return zones
+}
@@ -3,106 +3,69 @@ package azurerm
 import (
 	"context"
 	"fmt"
-	"log"
-	"os"
-	"strings"
 	"sync"
-	"time"
 
 	resourcesprofile "github.com/Azure/azure-sdk-for-go/profiles/2017-03-09/resources/mgmt/resources"
-	"github.com/Azure/azure-sdk-for-go/services/apimanagement/mgmt/2018-01-01/apimanagement"
-	appinsights "github.com/Azure/azure-sdk-for-go/services/appinsights/mgmt/2015-05-01/insights"
-	automationSvc "github.com/Azure/azure-sdk-for-go/services/automation/mgmt/2015-10-31/automation"
-	"github.com/Azure/azure-sdk-for-go/services/batch/mgmt/2018-12-01/batch"
-	cdnSvc "github.com/Azure/azure-sdk-for-go/services/cdn/mgmt/2017-10-12/cdn"
-	cognitiveSvc "github.com/Azure/azure-sdk-for-go/services/cognitiveservices/mgmt/2017-04-18/cognitiveservices"
-	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2018-06-01/compute"
-	"github.com/Azure/azure-sdk-for-go/services/containerinstance/mgmt/2018-10-01/containerinstance"
-	"github.com/Azure/azure-sdk-for-go/services/containerregistry/mgmt/2017-10-01/containerregistry"
-	"github.com/Azure/azure-sdk-for-go/services/containerservice/mgmt/2019-02-01/containerservice"
-	"github.com/Azure/azure-sdk-for-go/services/cosmos-db/mgmt/2015-04-08/documentdb"
-	databricksSvc "github.com/Azure/azure-sdk-for-go/services/databricks/mgmt/2018-04-01/databricks"
-	datafactorySvc "github.com/Azure/azure-sdk-for-go/services/datafactory/mgmt/2018-06-01/datafactory"
-	analyticsAccount "github.com/Azure/azure-sdk-for-go/services/datalake/analytics/mgmt/2016-11-01/account"
-	"github.com/Azure/azure-sdk-for-go/services/datalake/store/2016-11-01/filesystem"
-	storeAccount "github.com/Azure/azure-sdk-for-go/services/datalake/store/mgmt/2016-11-01/account"
-	"github.com/Azure/azure-sdk-for-go/services/devtestlabs/mgmt/2016-05-15/dtl"
-	eventHubSvc "github.com/Azure/azure-sdk-for-go/services/eventhub/mgmt/2017-04-01/eventhub"
-	"github.com/Azure/azure-sdk-for-go/services/graphrbac/1.6/graphrbac"
-	keyVault "github.com/Azure/azure-sdk-for-go/services/keyvault/2016-10-01/keyvault"
-	"github.com/Azure/azure-sdk-for-go/services/keyvault/mgmt/2018-02-14/keyvault"
-	"github.com/Azure/azure-sdk-for-go/services/logic/mgmt/2016-06-01/logic"
-	"github.com/Azure/azure-sdk-for-go/services/mariadb/mgmt/2018-06-01/mariadb"
-	mediaSvc "github.com/Azure/azure-sdk-for-go/services/mediaservices/mgmt/2018-07-01/media"
-	"github.com/Azure/azure-sdk-for-go/services/mysql/mgmt/2017-12-01/mysql"
-	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-12-01/network"
-	notificationHubsSvc "github.com/Azure/azure-sdk-for-go/services/notificationhubs/mgmt/2017-04-01/notificationhubs"
-	"github.com/Azure/azure-sdk-for-go/services/postgresql/mgmt/2017-12-01/postgresql"
-	"github.com/Azure/azure-sdk-for-go/services/preview/authorization/mgmt/2018-01-01-preview/authorization"
-	"github.com/Azure/azure-sdk-for-go/services/preview/devspaces/mgmt/2018-06-01-preview/devspaces"
-	dnsSvc "github.com/Azure/azure-sdk-for-go/services/preview/dns/mgmt/2018-03-01-preview/dns"
-	eventGridSvc "github.com/Azure/azure-sdk-for-go/services/preview/eventgrid/mgmt/2018-09-15-preview/eventgrid"
-	hdinsightSvc "github.com/Azure/azure-sdk-for-go/services/preview/hdinsight/mgmt/2018-06-01-preview/hdinsight"
-	iotHubSvc "github.com/Azure/azure-sdk-for-go/services/preview/iothub/mgmt/2018-12-01-preview/devices"
-	"github.com/Azure/azure-sdk-for-go/services/preview/monitor/mgmt/2018-03-01/insights"
-	msiSvc "github.com/Azure/azure-sdk-for-go/services/preview/msi/mgmt/2015-08-31-preview/msi"
-	"github.com/Azure/azure-sdk-for-go/services/preview/operationalinsights/mgmt/2015-11-01-preview/operationalinsights"
-	"github.com/Azure/azure-sdk-for-go/services/preview/operationsmanagement/mgmt/2015-11-01-preview/operationsmanagement"
-	"github.com/Azure/azure-sdk-for-go/services/preview/resources/mgmt/2018-03-01-preview/managementgroups"
-	"github.com/Azure/azure-sdk-for-go/services/preview/security/mgmt/v1.0/security"
-	signalrSvc "github.com/Azure/azure-sdk-for-go/services/preview/signalr/mgmt/2018-03-01-preview/signalr"
-	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/2015-05-01-preview/sql"
-	MsSql "github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/2017-10-01-preview/sql"
-	"github.com/Azure/azure-sdk-for-go/services/recoveryservices/mgmt/2016-06-01/recoveryservices"
-	"github.com/Azure/azure-sdk-for-go/services/recoveryservices/mgmt/2017-07-01/backup"
-	redisSvc "github.com/Azure/azure-sdk-for-go/services/redis/mgmt/2018-03-01/redis"
-	relaySvc "github.com/Azure/azure-sdk-for-go/services/relay/mgmt/2017-04-01/relay"
-	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2016-06-01/subscriptions"
-	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2016-09-01/locks"
-	policySvc "github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2018-05-01/policy"
-	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2018-05-01/resources"
-	schedulerSvc "github.com/Azure/azure-sdk-for-go/services/scheduler/mgmt/2016-03-01/scheduler"
-	searchSvc "github.com/Azure/azure-sdk-for-go/services/search/mgmt/2015-08-19/search"
-	servicebusSvc "github.com/Azure/azure-sdk-for-go/services/servicebus/mgmt/2017-04-01/servicebus"
-	"github.com/Azure/azure-sdk-for-go/services/servicefabric/mgmt/2018-02-01/servicefabric"
-	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2018-02-01/storage"
-	"github.com/Azure/azure-sdk-for-go/services/streamanalytics/mgmt/2016-03-01/streamanalytics"
-	"github.com/Azure/azure-sdk-for-go/services/trafficmanager/mgmt/2018-04-01/trafficmanager"
-	"github.com/Azure/azure-sdk-for-go/services/web/mgmt/2018-02-01/web"
+	mainStorage "github.com/Azure/azure-sdk-for-go/storage"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/adal"
+	az "github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/go-azure-helpers/authentication"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/common"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceproviders"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/apimgmt"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/applicationinsights"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/authorization"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/automation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/batch"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/cdn"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/cognitive"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/compute"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/containers"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/cosmos"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/databricks"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/datafactory"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/datalake"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/devspace"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/devtestlabs"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/dns"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/eventgrid"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/eventhub"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/graph"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/hdinsight"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/iothub"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/keyvault"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/loganalytics"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/logic"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/managementgroup"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/mariadb"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/media"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/monitor"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/msi"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/mssql"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/mysql"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/notificationhub"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/policy"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/postgres"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/recoveryservices"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/redis"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/relay"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/resource"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/scheduler"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/search"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/securitycenter"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/servicebus"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/servicefabric"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/signalr"
-
-	mainStorage "github.com/Azure/azure-sdk-for-go/storage"
-	"github.com/Azure/go-autorest/autorest"
-	"github.com/Azure/go-autorest/autorest/adal"
-	az "github.com/Azure/go-autorest/autorest/azure"
-	"github.com/hashicorp/go-azure-helpers/authentication"
-	"github.com/hashicorp/terraform/httpclient"
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/sql"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/storage"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/streamanalytics"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/trafficmanager"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/web"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
-	"github.com/terraform-providers/terraform-provider-azurerm/version"
 )
 
 // ArmClient contains the handles to all the specific Azure Resource Manager
@@ -115,232 +78,87 @@ type ArmClient struct {
 	usingServicePrincipal    bool
 	environment              az.Environment
 	skipProviderRegistration bool
+	retryOptions             azure.RetryOptions
+	logRedaction             azure.LogRedactionOptions
+
+	// storageUseAzureAD determines whether Storage Data Plane requests are authorized using Azure AD
+	storageUseAzureAD bool
+	storageAuthorizer autorest.Authorizer
+
+	// storageUseKeyFallback determines whether the Account Key can be used for Storage Data Plane requests
+	// to services which don't support Azure AD when `storageUseAzureAD` is enabled
+	storageUseKeyFallback bool
+
+	// defaultTags are the tags configured on the Provider which are assigned to every resource supporting tags
+	defaultTags map[string]interface{}
+
+	// features are the behaviours toggled by users within the `features` block of the Provider
+	features features.UserFeatures
 
 	StopContext context.Context
 
+	// clientOptions are used to build the Clients for each Service below the first time they're accessed,
+	// so that only the Services used within a configuration are built - tests can assign a Service to use a fake
+	clientOptions *common.ClientOptions
+	servicesLock  sync.Mutex
+
 	// Services
 	apimgmt          *apimgmt.Client
+	appInsights      *applicationinsights.Client
+	authorization    *authorization.Client
 	automation       *automation.Client
+	batch            *batch.Client
 	cdn              *cdn.Client
 	cognitive        *cognitive.Client
+	compute          *compute.Client
 	containers       *containers.Client
+	cosmos           *cosmos.Client
 	databricks       *databricks.Client
 	dataFactory      *datafactory.Client
+	dataLake         *datalake.Client
 	devSpace         *devspace.Client
+	devTestLabs      *devtestlabs.Client
 	dns              *dns.Client
 	eventGrid        *eventgrid.Client
 	eventhub         *eventhub.Client
+	graph            *graph.Client
 	hdinsight        *hdinsight.Client
 	iothub           *iothub.Client
+	keyVault         *keyvault.Client
 	logAnalytics     *loganalytics.Client
+	logic            *logic.Client
+	managementGroups *managementgroup.Client
+	mariadb          *mariadb.Client
 	media            *media.Client
+	monitor          *monitor.Client
 	msi              *msi.Client
+	mssql            *mssql.Client
+	mysql            *mysql.Client
+	network          *network.Client
 	notificationHubs *notificationhub.Client
 	policy           *policy.Client
+	postgres         *postgres.Client
+	recoveryServices *recoveryservices.Client
 	redis            *redis.Client
 	relay            *relay.Client
+	resource         *resource.Client
 	scheduler        *scheduler.Client
 	search           *search.Client
+	securityCenter   *securitycenter.Client
 	servicebus       *servicebus.Client
+	serviceFabric    *servicefabric.Client
 	signalr          *signalr.Client
-
-	// TODO: refactor
-	cosmosAccountsClient documentdb.DatabaseAccountsClient
-
-	// Application Insights
-	appInsightsClient         appinsights.ComponentsClient
-	appInsightsAPIKeyClient   appinsights.APIKeysClient
-	appInsightsWebTestsClient appinsights.WebTestsClient
-
-	// Authentication
-	roleAssignmentsClient   authorization.RoleAssignmentsClient
-	roleDefinitionsClient   authorization.RoleDefinitionsClient
-	applicationsClient      graphrbac.ApplicationsClient
-	servicePrincipalsClient graphrbac.ServicePrincipalsClient
-
-	// Autoscale Settings
-	autoscaleSettingsClient insights.AutoscaleSettingsClient
-
-	// Batch
-	batchAccountClient     batch.AccountClient
-	batchCertificateClient batch.CertificateClient
-	batchPoolClient        batch.PoolClient
-
-	// Compute
-	availSetClient             compute.AvailabilitySetsClient
-	diskClient                 compute.DisksClient
-	imageClient                compute.ImagesClient
-	galleriesClient            compute.GalleriesClient
-	galleryImagesClient        compute.GalleryImagesClient
-	galleryImageVersionsClient compute.GalleryImageVersionsClient
-	snapshotsClient            compute.SnapshotsClient
-	usageOpsClient             compute.UsageClient
-	vmExtensionImageClient     compute.VirtualMachineExtensionImagesClient
-	vmExtensionClient          compute.VirtualMachineExtensionsClient
-	vmScaleSetClient           compute.VirtualMachineScaleSetsClient
-	vmImageClient              compute.VirtualMachineImagesClient
-	vmClient                   compute.VirtualMachinesClient
-
-	// DevTestLabs
-	devTestLabsClient            dtl.LabsClient
-	devTestPoliciesClient        dtl.PoliciesClient
-	devTestVirtualMachinesClient dtl.VirtualMachinesClient
-	devTestVirtualNetworksClient dtl.VirtualNetworksClient
-
-	// Databases
-	mariadbDatabasesClient                   mariadb.DatabasesClient
-	mariadbServersClient                     mariadb.ServersClient
-	mysqlConfigurationsClient                mysql.ConfigurationsClient
-	mysqlDatabasesClient                     mysql.DatabasesClient
-	mysqlFirewallRulesClient                 mysql.FirewallRulesClient
-	mysqlServersClient                       mysql.ServersClient
-	mysqlVirtualNetworkRulesClient           mysql.VirtualNetworkRulesClient
-	postgresqlConfigurationsClient           postgresql.ConfigurationsClient
-	postgresqlDatabasesClient                postgresql.DatabasesClient
-	postgresqlFirewallRulesClient            postgresql.FirewallRulesClient
-	postgresqlServersClient                  postgresql.ServersClient
-	postgresqlVirtualNetworkRulesClient      postgresql.VirtualNetworkRulesClient
-	sqlDatabasesClient                       sql.DatabasesClient
-	sqlDatabaseThreatDetectionPoliciesClient sql.DatabaseThreatDetectionPoliciesClient
-	sqlElasticPoolsClient                    sql.ElasticPoolsClient
-	// Client for the new 2017-10-01-preview SQL API which implements vCore, DTU, and Azure data standards
-	msSqlElasticPoolsClient              MsSql.ElasticPoolsClient
-	sqlFirewallRulesClient               sql.FirewallRulesClient
-	sqlServersClient                     sql.ServersClient
-	sqlServerAzureADAdministratorsClient sql.ServerAzureADAdministratorsClient
-	sqlVirtualNetworkRulesClient         sql.VirtualNetworkRulesClient
-
-	// Data Lake Store
-	dataLakeStoreAccountClient       storeAccount.AccountsClient
-	dataLakeStoreFirewallRulesClient storeAccount.FirewallRulesClient
-	dataLakeStoreFilesClient         filesystem.Client
-
-	// Data Lake Analytics
-	dataLakeAnalyticsAccountClient       analyticsAccount.AccountsClient
-	dataLakeAnalyticsFirewallRulesClient analyticsAccount.FirewallRulesClient
-
-	// KeyVault
-	keyVaultClient           keyvault.VaultsClient
-	keyVaultManagementClient keyVault.BaseClient
-
-	// Logic
-	logicWorkflowsClient logic.WorkflowsClient
-
-	// Management Groups
-	managementGroupsClient             managementgroups.Client
-	managementGroupsSubscriptionClient managementgroups.SubscriptionsClient
-
-	// Monitor
-	monitorActionGroupsClient               insights.ActionGroupsClient
-	monitorActivityLogAlertsClient          insights.ActivityLogAlertsClient
-	monitorAlertRulesClient                 insights.AlertRulesClient
-	monitorDiagnosticSettingsClient         insights.DiagnosticSettingsClient
-	monitorDiagnosticSettingsCategoryClient insights.DiagnosticSettingsCategoryClient
-	monitorLogProfilesClient                insights.LogProfilesClient
-	monitorMetricAlertsClient               insights.MetricAlertsClient
-
-	// Networking
-	applicationGatewayClient        network.ApplicationGatewaysClient
-	applicationSecurityGroupsClient network.ApplicationSecurityGroupsClient
-	azureFirewallsClient            network.AzureFirewallsClient
-	connectionMonitorsClient        network.ConnectionMonitorsClient
-	ddosProtectionPlanClient        network.DdosProtectionPlansClient
-	expressRouteAuthsClient         network.ExpressRouteCircuitAuthorizationsClient
-	expressRouteCircuitClient       network.ExpressRouteCircuitsClient
-	expressRoutePeeringsClient      network.ExpressRouteCircuitPeeringsClient
-	ifaceClient                     network.InterfacesClient
-	loadBalancerClient              network.LoadBalancersClient
-	localNetConnClient              network.LocalNetworkGatewaysClient
-	netProfileClient                network.ProfilesClient
-	packetCapturesClient            network.PacketCapturesClient
-	publicIPClient                  network.PublicIPAddressesClient
-	publicIPPrefixClient            network.PublicIPPrefixesClient
-	routesClient                    network.RoutesClient
-	routeTablesClient               network.RouteTablesClient
-	secGroupClient                  network.SecurityGroupsClient
-	secRuleClient                   network.SecurityRulesClient
-	subnetClient                    network.SubnetsClient
-	vnetGatewayConnectionsClient    network.VirtualNetworkGatewayConnectionsClient
-	vnetGatewayClient               network.VirtualNetworkGatewaysClient
-	vnetClient                      network.VirtualNetworksClient
-	vnetPeeringsClient              network.VirtualNetworkPeeringsClient
-	watcherClient                   network.WatchersClient
-
-	// Recovery Services
-	recoveryServicesVaultsClient             recoveryservices.VaultsClient
-	recoveryServicesProtectedItemsClient     backup.ProtectedItemsGroupClient
-	recoveryServicesProtectionPoliciesClient backup.ProtectionPoliciesClient
-
-	// Resources
-	managementLocksClient locks.ManagementLocksClient
-	deploymentsClient     resources.DeploymentsGroupClient
-	providersClient       resourcesprofile.ProvidersClient
-	resourcesClient       resources.GroupClient
-	resourceGroupsClient  resources.GroupsGroupClient
-	subscriptionsClient   subscriptions.GroupClient
-
-	// Security Centre
-	securityCenterPricingClient   security.PricingsClient
-	securityCenterContactsClient  security.ContactsClient
-	securityCenterWorkspaceClient security.WorkspaceSettingsClient
-
-	// Service Fabric
-	serviceFabricClustersClient servicefabric.ClustersClient
-
-	// Storage
-	storageServiceClient storage.AccountsClient
-	storageUsageClient   storage.UsageClient
-
-	// Stream Analytics
-	streamAnalyticsFunctionsClient       streamanalytics.FunctionsClient
-	streamAnalyticsJobsClient            streamanalytics.StreamingJobsClient
-	streamAnalyticsInputsClient          streamanalytics.InputsClient
-	streamAnalyticsOutputsClient         streamanalytics.OutputsClient
-	streamAnalyticsTransformationsClient streamanalytics.TransformationsClient
-
-	// Traffic Manager
-	trafficManagerGeographialHierarchiesClient trafficmanager.GeographicHierarchiesClient
-	trafficManagerProfilesClient               trafficmanager.ProfilesClient
-	trafficManagerEndpointsClient              trafficmanager.EndpointsClient
-
-	// Web
-	appServicePlansClient web.AppServicePlansClient
-	appServicesClient     web.AppsClient
-}
-
-func (c *ArmClient) configureClient(client *autorest.Client, auth autorest.Authorizer) {
-	setUserAgent(client, c.partnerId)
-	client.Authorizer = auth
-	client.RequestInspector = azure.WithCorrelationRequestID(azure.CorrelationRequestID())
-	client.Sender = azure.BuildSender()
-	client.SkipResourceProviderRegistration = c.skipProviderRegistration
-	client.PollingDuration = 60 * time.Minute
-}
-
-func setUserAgent(client *autorest.Client, partnerID string) {
-	// TODO: This is the SDK version not the CLI version, once we are on 0.12, should revisit
-	tfUserAgent := httpclient.UserAgentString()
-
-	pv := version.ProviderVersion
-	providerUserAgent := fmt.Sprintf("%s terraform-provider-azurerm/%s", tfUserAgent, pv)
-	client.UserAgent = strings.TrimSpace(fmt.Sprintf("%s %s", client.UserAgent, providerUserAgent))
-
-	// append the CloudShell version to the user agent if it exists
-	if azureAgent := os.Getenv("AZURE_HTTP_USER_AGENT"); azureAgent != "" {
-		client.UserAgent = fmt.Sprintf("%s %s", client.UserAgent, azureAgent)
-	}
-
-	if partnerID != "" {
-		client.UserAgent = fmt.Sprintf("%s pid-%s", client.UserAgent, partnerID)
-	}
-
-	log.Printf("[DEBUG] AzureRM Client User Agent: %s\n", client.UserAgent)
+	sql              *sql.Client
+	storage          *storage.Client
+	streamAnalytics  *streamanalytics.Client
+	trafficManager   *trafficmanager.Client
+	web              *web.Client
 }
 
 // getArmClient is a helper method which returns a fully instantiated
 // *ArmClient based on the Config's current settings.
-func getArmClient(c *authentication.Config, skipProviderRegistration bool, partnerId string) (*ArmClient, error) {
-	env, err := authentication.DetermineEnvironment(c.Environment)
+func getArmClient(c *authentication.Config, skipProviderRegistration bool, partnerId string, retryOptions azure.RetryOptions, logRedaction azure.LogRedactionOptions, storageUseAzureAD bool, storageUseKeyFallback bool, environmentOptions azure.EnvironmentOptions, auxiliaryTenantIds []string) (*ArmClient, error) {
+	env, err := azure.LoadEnvironment(c.Environment, environmentOptions)
 	if err != nil {
 		return nil, err
 	}
@@ -354,6 +172,10 @@ func getArmClient(c *authentication.Config, skipProviderRegistration bool, partn
 		environment:              *env,
 		usingServicePrincipal:    c.AuthenticatedAsAServicePrincipal,
 		skipProviderRegistration: skipProviderRegistration,
+		retryOptions:             retryOptions,
+		logRedaction:             logRedaction,
+		storageUseAzureAD:        storageUseAzureAD,
+		storageUseKeyFallback:    storageUseKeyFallback,
 	}
 
 	oauthConfig, err := adal.NewOAuthConfig(env.ActiveDirectoryEndpoint, c.TenantID)
@@ -366,18 +188,16 @@ func getArmClient(c *authentication.Config, skipProviderRegistration bool, partn
 		return nil, fmt.Errorf("Unable to configure OAuthConfig for tenant %s", c.TenantID)
 	}
 
-	sender := azure.BuildSender()
+	sender := azure.BuildSender(retryOptions, logRedaction)
 
 	// Resource Manager endpoints
-	endpoint := env.ResourceManagerEndpoint
 	auth, err := c.GetAuthorizationToken(sender, oauthConfig, env.TokenAudience)
 	if err != nil {
 		return nil, err
 	}
 
 	// Graph Endpoints
-	graphEndpoint := env.GraphEndpoint
-	graphAuth, err := c.GetAuthorizationToken(sender, oauthConfig, graphEndpoint)
+	graphAuth, err := c.GetAuthorizationToken(sender, oauthConfig, env.GraphEndpoint)
 	if err != nil {
 		return nil, err
 	}
@@ -392,1023 +212,70 @@ func getArmClient(c *authentication.Config, skipProviderRegistration bool, partn
 		return keyVaultSpt, nil
 	})
 
-	client.registerAPIManagementClients(endpoint, c.SubscriptionID, auth)
-	client.registerAppInsightsClients(endpoint, c.SubscriptionID, auth)
-	client.registerAutomationClients(endpoint, c.SubscriptionID, auth)
-	client.registerAuthentication(endpoint, graphEndpoint, c.SubscriptionID, c.TenantID, auth, graphAuth)
-	client.registerBatchClients(endpoint, c.SubscriptionID, auth)
-	client.registerCDNClients(endpoint, c.SubscriptionID, auth)
-	client.registerCognitiveServiceClients(endpoint, c.SubscriptionID, auth)
-	client.registerComputeClients(endpoint, c.SubscriptionID, auth)
-	client.registerContainerClients(endpoint, c.SubscriptionID, auth)
-	client.registerCosmosAccountsClients(endpoint, c.SubscriptionID, auth)
-	client.registerDatabricksClients(endpoint, c.SubscriptionID, auth)
-	client.registerDatabases(endpoint, c.SubscriptionID, auth, sender)
-	client.registerDataFactoryClients(endpoint, c.SubscriptionID, auth)
-	client.registerDataLakeStoreClients(endpoint, c.SubscriptionID, auth)
-	client.registerDevSpaceClients(endpoint, c.SubscriptionID, auth)
-	client.registerDevTestClients(endpoint, c.SubscriptionID, auth)
-	client.registerDNSClients(endpoint, c.SubscriptionID, auth)
-	client.registerEventGridClients(endpoint, c.SubscriptionID, auth)
-	client.registerEventHubClients(endpoint, c.SubscriptionID, auth)
-	client.registerHDInsightsClients(endpoint, c.SubscriptionID, auth)
-	client.registerIoTHubClients(endpoint, c.SubscriptionID, auth)
-	client.registerKeyVaultClients(endpoint, c.SubscriptionID, auth, keyVaultAuth)
-	client.registerLogicClients(endpoint, c.SubscriptionID, auth)
-	client.registerMediaServiceClients(endpoint, c.SubscriptionID, auth)
-	client.registerMonitorClients(endpoint, c.SubscriptionID, auth)
-	client.registerMSIClient(endpoint, c.SubscriptionID, auth)
-	client.registerNetworkingClients(endpoint, c.SubscriptionID, auth)
-	client.registerNotificationHubsClient(endpoint, c.SubscriptionID, auth)
-	client.registerOperationalInsightsClients(endpoint, c.SubscriptionID, auth)
-	client.registerRecoveryServiceClients(endpoint, c.SubscriptionID, auth)
-	client.registerPolicyClients(endpoint, c.SubscriptionID, auth)
-	client.registerManagementGroupClients(endpoint, auth)
-	client.registerRedisClients(endpoint, c.SubscriptionID, auth)
-	client.registerRelayClients(endpoint, c.SubscriptionID, auth)
-	client.registerResourcesClients(endpoint, c.SubscriptionID, auth)
-	client.registerSearchClients(endpoint, c.SubscriptionID, auth)
-	client.registerSecurityCenterClients(endpoint, c.SubscriptionID, auth)
-	client.registerServiceBusClients(endpoint, c.SubscriptionID, auth)
-	client.registerServiceFabricClients(endpoint, c.SubscriptionID, auth)
-	client.registerSchedulerClients(endpoint, c.SubscriptionID, auth)
-	client.registerSignalRClients(endpoint, c.SubscriptionID, auth)
-	client.registerStorageClients(endpoint, c.SubscriptionID, auth)
-	client.registerStreamAnalyticsClients(endpoint, c.SubscriptionID, auth)
-	client.registerTrafficManagerClients(endpoint, c.SubscriptionID, auth)
-	client.registerWebClients(endpoint, c.SubscriptionID, auth)
-
-	return &client, nil
-}
-
-func (c *ArmClient) registerAPIManagementClients(endpoint, subscriptionId string, auth autorest.Authorizer) {
-	apisClient := apimanagement.NewAPIClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&apisClient.Client, auth)
-
-	apiPoliciesClient := apimanagement.NewAPIPolicyClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&apiPoliciesClient.Client, auth)
-
-	apiOperationsClient := apimanagement.NewAPIOperationClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&apiOperationsClient.Client, auth)
-
-	apiOperationPoliciesClient := apimanagement.NewAPIOperationPolicyClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&apiOperationPoliciesClient.Client, auth)
-
-	apiSchemasClient := apimanagement.NewAPISchemaClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&apiSchemasClient.Client, auth)
-
-	apiVersionSetClient := apimanagement.NewAPIVersionSetClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&apiVersionSetClient.Client, auth)
-
-	authorizationServersClient := apimanagement.NewAuthorizationServerClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&authorizationServersClient.Client, auth)
-
-	certificatesClient := apimanagement.NewCertificateClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&certificatesClient.Client, auth)
-
-	groupsClient := apimanagement.NewGroupClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&groupsClient.Client, auth)
-
-	groupUsersClient := apimanagement.NewGroupUserClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&groupUsersClient.Client, auth)
-
-	loggerClient := apimanagement.NewLoggerClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&loggerClient.Client, auth)
-
-	policyClient := apimanagement.NewPolicyClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&policyClient.Client, auth)
-
-	serviceClient := apimanagement.NewServiceClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&serviceClient.Client, auth)
-
-	signInClient := apimanagement.NewSignInSettingsClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&signInClient.Client, auth)
-
-	signUpClient := apimanagement.NewSignUpSettingsClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&signUpClient.Client, auth)
-
-	openIdConnectClient := apimanagement.NewOpenIDConnectProviderClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&openIdConnectClient.Client, auth)
-
-	productsClient := apimanagement.NewProductClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&productsClient.Client, auth)
-
-	productApisClient := apimanagement.NewProductAPIClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&productApisClient.Client, auth)
-
-	productGroupsClient := apimanagement.NewProductGroupClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&productGroupsClient.Client, auth)
-
-	productPoliciesClient := apimanagement.NewProductPolicyClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&productPoliciesClient.Client, auth)
-
-	propertiesClient := apimanagement.NewPropertyClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&propertiesClient.Client, auth)
-
-	subscriptionsClient := apimanagement.NewSubscriptionClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&subscriptionsClient.Client, auth)
-
-	usersClient := apimanagement.NewUserClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&usersClient.Client, auth)
-
-	c.apimgmt = &apimgmt.Client{
-		ApiClient:                  apisClient,
-		ApiPoliciesClient:          apiPoliciesClient,
-		ApiOperationsClient:        apiOperationsClient,
-		ApiOperationPoliciesClient: apiOperationPoliciesClient,
-		ApiSchemasClient:           apiSchemasClient,
-		ApiVersionSetClient:        apiVersionSetClient,
-		AuthorizationServersClient: authorizationServersClient,
-		CertificatesClient:         certificatesClient,
-		GroupClient:                groupsClient,
-		GroupUsersClient:           groupUsersClient,
-		LoggerClient:               loggerClient,
-		PolicyClient:               policyClient,
-		ServiceClient:              serviceClient,
-		SignInClient:               signInClient,
-		SignUpClient:               signUpClient,
-		OpenIdConnectClient:        openIdConnectClient,
-		ProductsClient:             productsClient,
-		ProductApisClient:          productApisClient,
-		ProductGroupsClient:        productGroupsClient,
-		ProductPoliciesClient:      productPoliciesClient,
-		PropertyClient:             propertiesClient,
-		SubscriptionsClient:        subscriptionsClient,
-		UsersClient:                usersClient,
-	}
-}
-
-func (c *ArmClient) registerAppInsightsClients(endpoint, subscriptionId string, auth autorest.Authorizer) {
-	ai := appinsights.NewComponentsClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&ai.Client, auth)
-	c.appInsightsClient = ai
-
-	aiak := appinsights.NewAPIKeysClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&aiak.Client, auth)
-	c.appInsightsAPIKeyClient = aiak
-
-	aiwt := appinsights.NewWebTestsClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&aiwt.Client, auth)
-	c.appInsightsWebTestsClient = aiwt
-}
-
-func (c *ArmClient) registerAutomationClients(endpoint, subscriptionId string, auth autorest.Authorizer) {
-	accountClient := automationSvc.NewAccountClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&accountClient.Client, auth)
-
-	agentRegistrationInfoClient := automationSvc.NewAgentRegistrationInformationClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&agentRegistrationInfoClient.Client, auth)
-
-	credentialClient := automationSvc.NewCredentialClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&credentialClient.Client, auth)
-
-	dscConfigurationClient := automationSvc.NewDscConfigurationClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&dscConfigurationClient.Client, auth)
-
-	dscNodeConfigurationClient := automationSvc.NewDscNodeConfigurationClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&dscNodeConfigurationClient.Client, auth)
-
-	moduleClient := automationSvc.NewModuleClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&moduleClient.Client, auth)
-
-	runbookClient := automationSvc.NewRunbookClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&runbookClient.Client, auth)
-
-	scheduleClient := automationSvc.NewScheduleClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&scheduleClient.Client, auth)
-
-	runbookDraftClient := automationSvc.NewRunbookDraftClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&runbookDraftClient.Client, auth)
-
-	variableClient := automationSvc.NewVariableClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&variableClient.Client, auth)
-
-	c.automation = &automation.Client{
-		AccountClient:               accountClient,
-		AgentRegistrationInfoClient: agentRegistrationInfoClient,
-		CredentialClient:            credentialClient,
-		DscConfigurationClient:      dscConfigurationClient,
-		DscNodeConfigurationClient:  dscNodeConfigurationClient,
-		ModuleClient:                moduleClient,
-		RunbookClient:               runbookClient,
-		RunbookDraftClient:          runbookDraftClient,
-		ScheduleClient:              scheduleClient,
-		VariableClient:              variableClient,
-	}
-}
-
-func (c *ArmClient) registerAuthentication(endpoint, graphEndpoint, subscriptionId, tenantId string, auth, graphAuth autorest.Authorizer) {
-	assignmentsClient := authorization.NewRoleAssignmentsClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&assignmentsClient.Client, auth)
-	c.roleAssignmentsClient = assignmentsClient
-
-	definitionsClient := authorization.NewRoleDefinitionsClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&definitionsClient.Client, auth)
-	c.roleDefinitionsClient = definitionsClient
-
-	applicationsClient := graphrbac.NewApplicationsClientWithBaseURI(graphEndpoint, tenantId)
-	c.configureClient(&applicationsClient.Client, graphAuth)
-	c.applicationsClient = applicationsClient
-
-	servicePrincipalsClient := graphrbac.NewServicePrincipalsClientWithBaseURI(graphEndpoint, tenantId)
-	c.configureClient(&servicePrincipalsClient.Client, graphAuth)
-	c.servicePrincipalsClient = servicePrincipalsClient
-}
-
-func (c *ArmClient) registerBatchClients(endpoint, subscriptionId string, auth autorest.Authorizer) {
-	batchAccount := batch.NewAccountClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&batchAccount.Client, auth)
-	c.batchAccountClient = batchAccount
-
-	batchCertificateClient := batch.NewCertificateClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&batchCertificateClient.Client, auth)
-	c.batchCertificateClient = batchCertificateClient
-
-	batchPool := batch.NewPoolClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&batchPool.Client, auth)
-	c.batchPoolClient = batchPool
-}
-
-func (c *ArmClient) registerCDNClients(endpoint, subscriptionId string, auth autorest.Authorizer) {
-	customDomainsClient := cdnSvc.NewCustomDomainsClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&customDomainsClient.Client, auth)
-
-	endpointsClient := cdnSvc.NewEndpointsClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&endpointsClient.Client, auth)
-
-	profilesClient := cdnSvc.NewProfilesClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&profilesClient.Client, auth)
-
-	c.cdn = &cdn.Client{
-		CustomDomainsClient: customDomainsClient,
-		EndpointsClient:     endpointsClient,
-		ProfilesClient:      profilesClient,
-	}
-}
-
-func (c *ArmClient) registerCognitiveServiceClients(endpoint, subscriptionId string, auth autorest.Authorizer) {
-	accountsClient := cognitiveSvc.NewAccountsClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&accountsClient.Client, auth)
-
-	c.cognitive = &cognitive.Client{
-		AccountsClient: accountsClient,
-	}
-}
-
-func (c *ArmClient) registerCosmosAccountsClients(endpoint, subscriptionId string, auth autorest.Authorizer) {
-	ca := documentdb.NewDatabaseAccountsClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&ca.Client, auth)
-	c.cosmosAccountsClient = ca
-}
-
-func (c *ArmClient) registerMediaServiceClients(endpoint, subscriptionId string, auth autorest.Authorizer) {
-	mediaServicesClient := mediaSvc.NewMediaservicesClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&mediaServicesClient.Client, auth)
-
-	c.media = &media.Client{
-		ServicesClient: mediaServicesClient,
-	}
-}
-
-func (c *ArmClient) registerComputeClients(endpoint, subscriptionId string, auth autorest.Authorizer) {
-	availabilitySetsClient := compute.NewAvailabilitySetsClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&availabilitySetsClient.Client, auth)
-	c.availSetClient = availabilitySetsClient
-
-	diskClient := compute.NewDisksClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&diskClient.Client, auth)
-	c.diskClient = diskClient
-
-	imagesClient := compute.NewImagesClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&imagesClient.Client, auth)
-	c.imageClient = imagesClient
-
-	snapshotsClient := compute.NewSnapshotsClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&snapshotsClient.Client, auth)
-	c.snapshotsClient = snapshotsClient
-
-	usageClient := compute.NewUsageClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&usageClient.Client, auth)
-	c.usageOpsClient = usageClient
-
-	extensionImagesClient := compute.NewVirtualMachineExtensionImagesClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&extensionImagesClient.Client, auth)
-	c.vmExtensionImageClient = extensionImagesClient
-
-	extensionsClient := compute.NewVirtualMachineExtensionsClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&extensionsClient.Client, auth)
-	c.vmExtensionClient = extensionsClient
-
-	virtualMachineImagesClient := compute.NewVirtualMachineImagesClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&virtualMachineImagesClient.Client, auth)
-	c.vmImageClient = virtualMachineImagesClient
-
-	scaleSetsClient := compute.NewVirtualMachineScaleSetsClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&scaleSetsClient.Client, auth)
-	c.vmScaleSetClient = scaleSetsClient
-
-	virtualMachinesClient := compute.NewVirtualMachinesClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&virtualMachinesClient.Client, auth)
-	c.vmClient = virtualMachinesClient
-
-	galleriesClient := compute.NewGalleriesClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&galleriesClient.Client, auth)
-	c.galleriesClient = galleriesClient
-
-	galleryImagesClient := compute.NewGalleryImagesClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&galleryImagesClient.Client, auth)
-	c.galleryImagesClient = galleryImagesClient
-
-	galleryImageVersionsClient := compute.NewGalleryImageVersionsClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&galleryImageVersionsClient.Client, auth)
-	c.galleryImageVersionsClient = galleryImageVersionsClient
-}
-
-func (c *ArmClient) registerContainerClients(endpoint, subscriptionId string, auth autorest.Authorizer) {
-	registriesClient := containerregistry.NewRegistriesClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&registriesClient.Client, auth)
-
-	replicationsClient := containerregistry.NewReplicationsClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&replicationsClient.Client, auth)
-
-	groupsClient := containerinstance.NewContainerGroupsClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&groupsClient.Client, auth)
-
-	// ACS
-	containerServicesClient := containerservice.NewContainerServicesClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&containerServicesClient.Client, auth)
-
-	// AKS
-	kubernetesClustersClient := containerservice.NewManagedClustersClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&kubernetesClustersClient.Client, auth)
-
-	c.containers = &containers.Client{
-		KubernetesClustersClient:   kubernetesClustersClient,
-		GroupsClient:               groupsClient,
-		RegistryClient:             registriesClient,
-		RegistryReplicationsClient: replicationsClient,
-		ServicesClient:             containerServicesClient,
-	}
-}
-
-func (c *ArmClient) registerDatabricksClients(endpoint, subscriptionId string, auth autorest.Authorizer) {
-	workspacesClient := databricksSvc.NewWorkspacesClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&workspacesClient.Client, auth)
-
-	c.databricks = &databricks.Client{
-		WorkspacesClient: workspacesClient,
-	}
-}
-
-func (c *ArmClient) registerDatabases(endpoint, subscriptionId string, auth autorest.Authorizer, sender autorest.Sender) {
-	mariadbDBClient := mariadb.NewDatabasesClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&mariadbDBClient.Client, auth)
-	c.mariadbDatabasesClient = mariadbDBClient
-
-	mariadbServersClient := mariadb.NewServersClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&mariadbServersClient.Client, auth)
-	c.mariadbServersClient = mariadbServersClient
-
-	// MySQL
-	mysqlConfigClient := mysql.NewConfigurationsClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&mysqlConfigClient.Client, auth)
-	c.mysqlConfigurationsClient = mysqlConfigClient
-
-	mysqlDBClient := mysql.NewDatabasesClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&mysqlDBClient.Client, auth)
-	c.mysqlDatabasesClient = mysqlDBClient
-
-	mysqlFWClient := mysql.NewFirewallRulesClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&mysqlFWClient.Client, auth)
-	c.mysqlFirewallRulesClient = mysqlFWClient
-
-	mysqlServersClient := mysql.NewServersClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&mysqlServersClient.Client, auth)
-	c.mysqlServersClient = mysqlServersClient
-
-	mysqlVirtualNetworkRulesClient := mysql.NewVirtualNetworkRulesClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&mysqlVirtualNetworkRulesClient.Client, auth)
-	c.mysqlVirtualNetworkRulesClient = mysqlVirtualNetworkRulesClient
-
-	// PostgreSQL
-	postgresqlConfigClient := postgresql.NewConfigurationsClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&postgresqlConfigClient.Client, auth)
-	c.postgresqlConfigurationsClient = postgresqlConfigClient
-
-	postgresqlDBClient := postgresql.NewDatabasesClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&postgresqlDBClient.Client, auth)
-	c.postgresqlDatabasesClient = postgresqlDBClient
-
-	postgresqlFWClient := postgresql.NewFirewallRulesClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&postgresqlFWClient.Client, auth)
-	c.postgresqlFirewallRulesClient = postgresqlFWClient
-
-	postgresqlSrvClient := postgresql.NewServersClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&postgresqlSrvClient.Client, auth)
-	c.postgresqlServersClient = postgresqlSrvClient
-
-	postgresqlVNRClient := postgresql.NewVirtualNetworkRulesClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&postgresqlVNRClient.Client, auth)
-	c.postgresqlVirtualNetworkRulesClient = postgresqlVNRClient
-
-	// SQL Azure
-	sqlDBClient := sql.NewDatabasesClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&sqlDBClient.Client, auth)
-	c.sqlDatabasesClient = sqlDBClient
-
-	sqlDTDPClient := sql.NewDatabaseThreatDetectionPoliciesClientWithBaseURI(endpoint, subscriptionId)
-	setUserAgent(&sqlDTDPClient.Client, "")
-	sqlDTDPClient.Authorizer = auth
-	sqlDTDPClient.Sender = sender
-	sqlDTDPClient.SkipResourceProviderRegistration = c.skipProviderRegistration
-	c.sqlDatabaseThreatDetectionPoliciesClient = sqlDTDPClient
-
-	sqlFWClient := sql.NewFirewallRulesClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&sqlFWClient.Client, auth)
-	c.sqlFirewallRulesClient = sqlFWClient
-
-	sqlEPClient := sql.NewElasticPoolsClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&sqlEPClient.Client, auth)
-	c.sqlElasticPoolsClient = sqlEPClient
-
-	MsSqlEPClient := MsSql.NewElasticPoolsClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&MsSqlEPClient.Client, auth)
-	c.msSqlElasticPoolsClient = MsSqlEPClient
-
-	sqlSrvClient := sql.NewServersClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&sqlSrvClient.Client, auth)
-	c.sqlServersClient = sqlSrvClient
-
-	sqlADClient := sql.NewServerAzureADAdministratorsClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&sqlADClient.Client, auth)
-	c.sqlServerAzureADAdministratorsClient = sqlADClient
-
-	sqlVNRClient := sql.NewVirtualNetworkRulesClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&sqlVNRClient.Client, auth)
-	c.sqlVirtualNetworkRulesClient = sqlVNRClient
-}
-
-func (c *ArmClient) registerDataFactoryClients(endpoint, subscriptionId string, auth autorest.Authorizer) {
-	factoriesClient := datafactorySvc.NewFactoriesClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&factoriesClient.Client, auth)
-
-	datasetsClient := datafactorySvc.NewDatasetsClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&datasetsClient.Client, auth)
-
-	linkedServicesClient := datafactorySvc.NewLinkedServicesClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&linkedServicesClient.Client, auth)
-
-	pipelinesClient := datafactorySvc.NewPipelinesClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&pipelinesClient.Client, auth)
-
-	c.dataFactory = &datafactory.Client{
-		FactoriesClient:     factoriesClient,
-		DatasetClient:       datasetsClient,
-		LinkedServiceClient: linkedServicesClient,
-		PipelinesClient:     pipelinesClient,
-	}
-}
-
-func (c *ArmClient) registerDataLakeStoreClients(endpoint, subscriptionId string, auth autorest.Authorizer) {
-	storeAccountClient := storeAccount.NewAccountsClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&storeAccountClient.Client, auth)
-	c.dataLakeStoreAccountClient = storeAccountClient
-
-	storeFirewallRulesClient := storeAccount.NewFirewallRulesClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&storeFirewallRulesClient.Client, auth)
-	c.dataLakeStoreFirewallRulesClient = storeFirewallRulesClient
-
-	analyticsAccountClient := analyticsAccount.NewAccountsClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&analyticsAccountClient.Client, auth)
-	c.dataLakeAnalyticsAccountClient = analyticsAccountClient
-
-	filesClient := filesystem.NewClient()
-	c.configureClient(&filesClient.Client, auth)
-	c.dataLakeStoreFilesClient = filesClient
-
-	analyticsFirewallRulesClient := analyticsAccount.NewFirewallRulesClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&analyticsFirewallRulesClient.Client, auth)
-	c.dataLakeAnalyticsFirewallRulesClient = analyticsFirewallRulesClient
-}
-
-func (c *ArmClient) registerDevTestClients(endpoint, subscriptionId string, auth autorest.Authorizer) {
-	labsClient := dtl.NewLabsClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&labsClient.Client, auth)
-	c.devTestLabsClient = labsClient
-
-	devTestPoliciesClient := dtl.NewPoliciesClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&devTestPoliciesClient.Client, auth)
-	c.devTestPoliciesClient = devTestPoliciesClient
-
-	devTestVirtualMachinesClient := dtl.NewVirtualMachinesClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&devTestVirtualMachinesClient.Client, auth)
-	c.devTestVirtualMachinesClient = devTestVirtualMachinesClient
-
-	devTestVirtualNetworksClient := dtl.NewVirtualNetworksClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&devTestVirtualNetworksClient.Client, auth)
-	c.devTestVirtualNetworksClient = devTestVirtualNetworksClient
-}
-
-func (c *ArmClient) registerDevSpaceClients(endpoint, subscriptionId string, auth autorest.Authorizer) {
-	controllersClient := devspaces.NewControllersClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&controllersClient.Client, auth)
-	c.devSpace = &devspace.Client{
-		ControllersClient: controllersClient,
-	}
-}
-
-func (c *ArmClient) registerDNSClients(endpoint, subscriptionId string, auth autorest.Authorizer) {
-	recordSetsClient := dnsSvc.NewRecordSetsClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&recordSetsClient.Client, auth)
-
-	zonesClient := dnsSvc.NewZonesClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&zonesClient.Client, auth)
-
-	c.dns = &dns.Client{
-		RecordSetsClient: recordSetsClient,
-		ZonesClient:      zonesClient,
-	}
-}
-
-func (c *ArmClient) registerEventGridClients(endpoint, subscriptionId string, auth autorest.Authorizer) {
-	domainsClient := eventGridSvc.NewDomainsClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&domainsClient.Client, auth)
-
-	eventSubscriptionsClient := eventGridSvc.NewEventSubscriptionsClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&eventSubscriptionsClient.Client, auth)
-
-	topicsClient := eventGridSvc.NewTopicsClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&topicsClient.Client, auth)
-
-	c.eventGrid = &eventgrid.Client{
-		DomainsClient:            domainsClient,
-		EventSubscriptionsClient: eventSubscriptionsClient,
-		TopicsClient:             topicsClient,
-	}
-}
-
-func (c *ArmClient) registerEventHubClients(endpoint, subscriptionId string, auth autorest.Authorizer) {
-	eventHubsClient := eventHubSvc.NewEventHubsClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&eventHubsClient.Client, auth)
-
-	groupsClient := eventHubSvc.NewConsumerGroupsClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&groupsClient.Client, auth)
-
-	namespacesClient := eventHubSvc.NewNamespacesClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&namespacesClient.Client, auth)
-
-	c.eventhub = &eventhub.Client{
-		ConsumerGroupClient: groupsClient,
-		EventHubsClient:     eventHubsClient,
-		NamespacesClient:    namespacesClient,
-	}
-}
-
-func (c *ArmClient) registerHDInsightsClients(endpoint, subscriptionId string, auth autorest.Authorizer) {
-	applicationsClient := hdinsightSvc.NewApplicationsClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&applicationsClient.Client, auth)
-
-	clustersClient := hdinsightSvc.NewClustersClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&clustersClient.Client, auth)
-
-	configurationsClient := hdinsightSvc.NewConfigurationsClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&configurationsClient.Client, auth)
-
-	c.hdinsight = &hdinsight.Client{
-		ApplicationsClient:   applicationsClient,
-		ClustersClient:       clustersClient,
-		ConfigurationsClient: configurationsClient,
-	}
-}
-func (c *ArmClient) registerIoTHubClients(endpoint, subscriptionId string, auth autorest.Authorizer) {
-	iotClient := iotHubSvc.NewIotHubResourceClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&iotClient.Client, auth)
-
-	c.iothub = &iothub.Client{
-		ResourceClient: iotClient,
-	}
-}
-
-func (c *ArmClient) registerKeyVaultClients(endpoint, subscriptionId string, auth autorest.Authorizer, keyVaultAuth autorest.Authorizer) {
-	keyVaultClient := keyvault.NewVaultsClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&keyVaultClient.Client, auth)
-	c.keyVaultClient = keyVaultClient
-
-	keyVaultManagementClient := keyVault.New()
-	c.configureClient(&keyVaultManagementClient.Client, keyVaultAuth)
-	c.keyVaultManagementClient = keyVaultManagementClient
-}
-
-func (c *ArmClient) registerLogicClients(endpoint, subscriptionId string, auth autorest.Authorizer) {
-	workflowsClient := logic.NewWorkflowsClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&workflowsClient.Client, auth)
-	c.logicWorkflowsClient = workflowsClient
-}
-
-func (c *ArmClient) registerMonitorClients(endpoint, subscriptionId string, auth autorest.Authorizer) {
-	agc := insights.NewActionGroupsClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&agc.Client, auth)
-	c.monitorActionGroupsClient = agc
-
-	alac := insights.NewActivityLogAlertsClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&alac.Client, auth)
-	c.monitorActivityLogAlertsClient = alac
-
-	arc := insights.NewAlertRulesClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&arc.Client, auth)
-	c.monitorAlertRulesClient = arc
-
-	monitorLogProfilesClient := insights.NewLogProfilesClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&monitorLogProfilesClient.Client, auth)
-	c.monitorLogProfilesClient = monitorLogProfilesClient
-
-	mac := insights.NewMetricAlertsClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&mac.Client, auth)
-	c.monitorMetricAlertsClient = mac
-
-	autoscaleSettingsClient := insights.NewAutoscaleSettingsClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&autoscaleSettingsClient.Client, auth)
-	c.autoscaleSettingsClient = autoscaleSettingsClient
-
-	monitoringInsightsClient := insights.NewDiagnosticSettingsClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&monitoringInsightsClient.Client, auth)
-	c.monitorDiagnosticSettingsClient = monitoringInsightsClient
-
-	monitoringCategorySettingsClient := insights.NewDiagnosticSettingsCategoryClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&monitoringCategorySettingsClient.Client, auth)
-	c.monitorDiagnosticSettingsCategoryClient = monitoringCategorySettingsClient
-}
-
-func (c *ArmClient) registerMSIClient(endpoint, subscriptionId string, auth autorest.Authorizer) {
-	userAssignedIdentitiesClient := msiSvc.NewUserAssignedIdentitiesClientWithBaseURI(endpoint, subscriptionId)
-
-	c.msi = &msi.Client{
-		UserAssignedIdentitiesClient: userAssignedIdentitiesClient,
-	}
-}
-
-func (c *ArmClient) registerNetworkingClients(endpoint, subscriptionId string, auth autorest.Authorizer) {
-	applicationGatewaysClient := network.NewApplicationGatewaysClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&applicationGatewaysClient.Client, auth)
-	c.applicationGatewayClient = applicationGatewaysClient
-
-	appSecurityGroupsClient := network.NewApplicationSecurityGroupsClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&appSecurityGroupsClient.Client, auth)
-	c.applicationSecurityGroupsClient = appSecurityGroupsClient
-
-	azureFirewallsClient := network.NewAzureFirewallsClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&azureFirewallsClient.Client, auth)
-	c.azureFirewallsClient = azureFirewallsClient
-
-	connectionMonitorsClient := network.NewConnectionMonitorsClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&connectionMonitorsClient.Client, auth)
-	c.connectionMonitorsClient = connectionMonitorsClient
-
-	ddosProtectionPlanClient := network.NewDdosProtectionPlansClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&ddosProtectionPlanClient.Client, auth)
-	c.ddosProtectionPlanClient = ddosProtectionPlanClient
-
-	expressRouteAuthsClient := network.NewExpressRouteCircuitAuthorizationsClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&expressRouteAuthsClient.Client, auth)
-	c.expressRouteAuthsClient = expressRouteAuthsClient
-
-	expressRouteCircuitsClient := network.NewExpressRouteCircuitsClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&expressRouteCircuitsClient.Client, auth)
-	c.expressRouteCircuitClient = expressRouteCircuitsClient
-
-	expressRoutePeeringsClient := network.NewExpressRouteCircuitPeeringsClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&expressRoutePeeringsClient.Client, auth)
-	c.expressRoutePeeringsClient = expressRoutePeeringsClient
-
-	interfacesClient := network.NewInterfacesClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&interfacesClient.Client, auth)
-	c.ifaceClient = interfacesClient
-
-	loadBalancersClient := network.NewLoadBalancersClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&loadBalancersClient.Client, auth)
-	c.loadBalancerClient = loadBalancersClient
-
-	localNetworkGatewaysClient := network.NewLocalNetworkGatewaysClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&localNetworkGatewaysClient.Client, auth)
-	c.localNetConnClient = localNetworkGatewaysClient
-
-	gatewaysClient := network.NewVirtualNetworkGatewaysClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&gatewaysClient.Client, auth)
-	c.vnetGatewayClient = gatewaysClient
-
-	gatewayConnectionsClient := network.NewVirtualNetworkGatewayConnectionsClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&gatewayConnectionsClient.Client, auth)
-	c.vnetGatewayConnectionsClient = gatewayConnectionsClient
-
-	netProfileClient := network.NewProfilesClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&netProfileClient.Client, auth)
-	c.netProfileClient = netProfileClient
-
-	networksClient := network.NewVirtualNetworksClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&networksClient.Client, auth)
-	c.vnetClient = networksClient
-
-	packetCapturesClient := network.NewPacketCapturesClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&packetCapturesClient.Client, auth)
-	c.packetCapturesClient = packetCapturesClient
-
-	peeringsClient := network.NewVirtualNetworkPeeringsClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&peeringsClient.Client, auth)
-	c.vnetPeeringsClient = peeringsClient
-
-	publicIPAddressesClient := network.NewPublicIPAddressesClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&publicIPAddressesClient.Client, auth)
-	c.publicIPClient = publicIPAddressesClient
-
-	publicIPPrefixesClient := network.NewPublicIPPrefixesClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&publicIPPrefixesClient.Client, auth)
-	c.publicIPPrefixClient = publicIPPrefixesClient
-
-	routesClient := network.NewRoutesClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&routesClient.Client, auth)
-	c.routesClient = routesClient
-
-	routeTablesClient := network.NewRouteTablesClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&routeTablesClient.Client, auth)
-	c.routeTablesClient = routeTablesClient
-
-	securityGroupsClient := network.NewSecurityGroupsClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&securityGroupsClient.Client, auth)
-	c.secGroupClient = securityGroupsClient
-
-	securityRulesClient := network.NewSecurityRulesClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&securityRulesClient.Client, auth)
-	c.secRuleClient = securityRulesClient
-
-	subnetsClient := network.NewSubnetsClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&subnetsClient.Client, auth)
-	c.subnetClient = subnetsClient
-
-	watchersClient := network.NewWatchersClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&watchersClient.Client, auth)
-	c.watcherClient = watchersClient
-}
-
-func (c *ArmClient) registerNotificationHubsClient(endpoint, subscriptionId string, auth autorest.Authorizer) {
-	namespacesClient := notificationHubsSvc.NewNamespacesClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&namespacesClient.Client, auth)
-
-	notificationHubsClient := notificationHubsSvc.NewClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&notificationHubsClient.Client, auth)
-
-	c.notificationHubs = &notificationhub.Client{
-		HubsClient:       notificationHubsClient,
-		NamespacesClient: namespacesClient,
-	}
-}
-
-func (c *ArmClient) registerOperationalInsightsClients(endpoint, subscriptionId string, auth autorest.Authorizer) {
-	workspacesClient := operationalinsights.NewWorkspacesClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&workspacesClient.Client, auth)
-
-	solutionsClient := operationsmanagement.NewSolutionsClientWithBaseURI(endpoint, subscriptionId, "Microsoft.OperationsManagement", "solutions", "testing")
-	c.configureClient(&solutionsClient.Client, auth)
-
-	linkedServicesClient := operationalinsights.NewLinkedServicesClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&linkedServicesClient.Client, auth)
-
-	c.logAnalytics = &loganalytics.Client{
-		LinkedServicesClient: linkedServicesClient,
-		SolutionsClient:      solutionsClient,
-		WorkspacesClient:     workspacesClient,
-	}
-}
-
-func (c *ArmClient) registerRecoveryServiceClients(endpoint, subscriptionId string, auth autorest.Authorizer) {
-	vaultsClient := recoveryservices.NewVaultsClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&vaultsClient.Client, auth)
-	c.recoveryServicesVaultsClient = vaultsClient
-
-	protectedItemsClient := backup.NewProtectedItemsGroupClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&protectedItemsClient.Client, auth)
-	c.recoveryServicesProtectedItemsClient = protectedItemsClient
-
-	protectionPoliciesClient := backup.NewProtectionPoliciesClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&protectionPoliciesClient.Client, auth)
-	c.recoveryServicesProtectionPoliciesClient = protectionPoliciesClient
-}
-
-func (c *ArmClient) registerRedisClients(endpoint, subscriptionId string, auth autorest.Authorizer) {
-	cacheClient := redisSvc.NewClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&cacheClient.Client, auth)
-
-	firewallRuleClient := redisSvc.NewFirewallRulesClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&firewallRuleClient.Client, auth)
-
-	patchSchedulesClient := redisSvc.NewPatchSchedulesClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&patchSchedulesClient.Client, auth)
-
-	c.redis = &redis.Client{
-		Client:               cacheClient,
-		FirewallRulesClient:  firewallRuleClient,
-		PatchSchedulesClient: patchSchedulesClient,
-	}
-}
-
-func (c *ArmClient) registerRelayClients(endpoint, subscriptionId string, auth autorest.Authorizer) {
-	namespacesClient := relaySvc.NewNamespacesClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&namespacesClient.Client, auth)
-
-	c.relay = &relay.Client{
-		NamespacesClient: namespacesClient,
-	}
-}
-
-func (c *ArmClient) registerResourcesClients(endpoint, subscriptionId string, auth autorest.Authorizer) {
-	locksClient := locks.NewManagementLocksClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&locksClient.Client, auth)
-	c.managementLocksClient = locksClient
-
-	deploymentsClient := resources.NewDeploymentsGroupClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&deploymentsClient.Client, auth)
-	c.deploymentsClient = deploymentsClient
-
-	resourcesClient := resources.NewGroupClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&resourcesClient.Client, auth)
-	c.resourcesClient = resourcesClient
-
-	resourceGroupsClient := resources.NewGroupsGroupClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&resourceGroupsClient.Client, auth)
-	c.resourceGroupsClient = resourceGroupsClient
-
-	subscriptionsClient := subscriptions.NewGroupClientWithBaseURI(endpoint)
-	c.configureClient(&subscriptionsClient.Client, auth)
-	c.subscriptionsClient = subscriptionsClient
-
-	// this has to come from the Profile since this is shared with Stack
-	providersClient := resourcesprofile.NewProvidersClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&providersClient.Client, auth)
-	c.providersClient = providersClient
-}
-
-func (c *ArmClient) registerSchedulerClients(endpoint, subscriptionId string, auth autorest.Authorizer) {
-	jobCollectionsClient := schedulerSvc.NewJobCollectionsClientWithBaseURI(endpoint, subscriptionId) //nolint: megacheck
-	c.configureClient(&jobCollectionsClient.Client, auth)
-
-	jobsClient := schedulerSvc.NewJobsClientWithBaseURI(endpoint, subscriptionId) //nolint: megacheck
-	c.configureClient(&jobsClient.Client, auth)
-
-	c.scheduler = &scheduler.Client{
-		JobCollectionsClient: jobCollectionsClient,
-		JobsClient:           jobsClient,
+	// Storage Endpoints
+	if storageUseAzureAD {
+		storageAuth, err := c.GetAuthorizationToken(sender, oauthConfig, azure.StorageAzureADResource(*env))
+		if err != nil {
+			return nil, err
+		}
+		client.storageAuthorizer = storageAuth
 	}
-}
 
-func (c *ArmClient) registerSearchClients(endpoint, subscriptionId string, auth autorest.Authorizer) {
-	searchAdminKeysClient := searchSvc.NewAdminKeysClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&searchAdminKeysClient.Client, auth)
+	// Auxiliary Tenants, used for operations spanning tenants (e.g. Virtual Network Peerings)
+	auxiliaryTenantsAuth := autorest.Authorizer(auth)
+	if len(auxiliaryTenantIds) > 0 {
+		if !c.AuthenticatedAsAServicePrincipal {
+			return nil, fmt.Errorf("`auxiliary_tenant_ids` can only be used when authenticating as a Service Principal")
+		}
 
-	servicesClient := searchSvc.NewServicesClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&servicesClient.Client, auth)
+		auxiliaryAuths := make([]autorest.Authorizer, 0, len(auxiliaryTenantIds))
+		for _, tenantId := range auxiliaryTenantIds {
+			auxiliaryOAuthConfig, err := adal.NewOAuthConfig(env.ActiveDirectoryEndpoint, tenantId)
+			if err != nil {
+				return nil, fmt.Errorf("Error configuring OAuthConfig for auxiliary tenant %q: %+v", tenantId, err)
+			}
+
+			auxiliaryAuth, err := c.GetAuthorizationToken(sender, auxiliaryOAuthConfig, env.TokenAudience)
+			if err != nil {
+				return nil, fmt.Errorf("Error acquiring token for auxiliary tenant %q: %+v", tenantId, err)
+			}
+			auxiliaryAuths = append(auxiliaryAuths, auxiliaryAuth)
+		}
 
-	c.search = &search.Client{
-		AdminKeysClient: searchAdminKeysClient,
-		ServicesClient:  servicesClient,
+		auxiliaryTenantsAuth = azure.NewAuxiliaryTenantsAuthorizer(auth, auxiliaryAuths)
 	}
-}
-
-func (c *ArmClient) registerSecurityCenterClients(endpoint, subscriptionId string, auth autorest.Authorizer) {
-	ascLocation := "Global"
 
-	securityCenterPricingClient := security.NewPricingsClientWithBaseURI(endpoint, subscriptionId, ascLocation)
-	c.configureClient(&securityCenterPricingClient.Client, auth)
-	c.securityCenterPricingClient = securityCenterPricingClient
+	client.clientOptions = &common.ClientOptions{
+		SubscriptionId: c.SubscriptionID,
+		TenantId:       c.TenantID,
+		PartnerId:      partnerId,
 
-	securityCenterContactsClient := security.NewContactsClientWithBaseURI(endpoint, subscriptionId, ascLocation)
-	c.configureClient(&securityCenterContactsClient.Client, auth)
-	c.securityCenterContactsClient = securityCenterContactsClient
+		GraphAuthorizer:           graphAuth,
+		GraphEndpoint:             env.GraphEndpoint,
+		KeyVaultAuthorizer:        keyVaultAuth,
+		ResourceManagerAuthorizer: auth,
+		ResourceManagerEndpoint:   env.ResourceManagerEndpoint,
 
-	securityCenterWorkspaceClient := security.NewWorkspaceSettingsClientWithBaseURI(endpoint, subscriptionId, ascLocation)
-	c.configureClient(&securityCenterWorkspaceClient.Client, auth)
-	c.securityCenterWorkspaceClient = securityCenterWorkspaceClient
-}
+		AuxiliaryTenantsAuthorizer: auxiliaryTenantsAuth,
 
-func (c *ArmClient) registerServiceBusClients(endpoint, subscriptionId string, auth autorest.Authorizer) {
-	queuesClient := servicebusSvc.NewQueuesClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&queuesClient.Client, auth)
-
-	namespacesClient := servicebusSvc.NewNamespacesClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&namespacesClient.Client, auth)
-
-	topicsClient := servicebusSvc.NewTopicsClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&topicsClient.Client, auth)
-
-	subscriptionsClient := servicebusSvc.NewSubscriptionsClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&subscriptionsClient.Client, auth)
-
-	subscriptionRulesClient := servicebusSvc.NewRulesClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&subscriptionRulesClient.Client, auth)
-
-	c.servicebus = &servicebus.Client{
-		QueuesClient:            queuesClient,
-		NamespacesClient:        namespacesClient,
-		TopicsClient:            topicsClient,
-		SubscriptionsClient:     subscriptionsClient,
-		SubscriptionRulesClient: subscriptionRulesClient,
+		SkipProviderReg: skipProviderRegistration,
+		RetryOptions:    retryOptions,
+		LogRedaction:    logRedaction,
 	}
-}
-
-func (c *ArmClient) registerServiceFabricClients(endpoint, subscriptionId string, auth autorest.Authorizer) {
-	clustersClient := servicefabric.NewClustersClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&clustersClient.Client, auth)
-	c.serviceFabricClustersClient = clustersClient
-}
-
-func (c *ArmClient) registerSignalRClients(endpoint, subscriptionId string, auth autorest.Authorizer) {
-	client := signalrSvc.NewClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&client.Client, auth)
-
-	c.signalr = &signalr.Client{
-		Client: client,
-	}
-}
-
-func (c *ArmClient) registerStorageClients(endpoint, subscriptionId string, auth autorest.Authorizer) {
-	accountsClient := storage.NewAccountsClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&accountsClient.Client, auth)
-	c.storageServiceClient = accountsClient
-
-	usageClient := storage.NewUsageClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&usageClient.Client, auth)
-	c.storageUsageClient = usageClient
-}
-
-func (c *ArmClient) registerStreamAnalyticsClients(endpoint, subscriptionId string, auth autorest.Authorizer) {
-	functionsClient := streamanalytics.NewFunctionsClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&functionsClient.Client, auth)
-	c.streamAnalyticsFunctionsClient = functionsClient
-
-	jobsClient := streamanalytics.NewStreamingJobsClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&jobsClient.Client, auth)
-	c.streamAnalyticsJobsClient = jobsClient
-
-	inputsClient := streamanalytics.NewInputsClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&inputsClient.Client, auth)
-	c.streamAnalyticsInputsClient = inputsClient
 
-	outputsClient := streamanalytics.NewOutputsClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&outputsClient.Client, auth)
-	c.streamAnalyticsOutputsClient = outputsClient
+	if !skipProviderRegistration {
+		// the Registrar uses its own Providers Client, since registering a Resource Provider doesn't require registration
+		providersClient := resourcesprofile.NewProvidersClientWithBaseURI(env.ResourceManagerEndpoint, c.SubscriptionID)
+		client.clientOptions.ConfigureClient(&providersClient.Client, auth)
 
-	transformationsClient := streamanalytics.NewTransformationsClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&transformationsClient.Client, auth)
-	c.streamAnalyticsTransformationsClient = transformationsClient
-}
-
-func (c *ArmClient) registerTrafficManagerClients(endpoint, subscriptionId string, auth autorest.Authorizer) {
-	endpointsClient := trafficmanager.NewEndpointsClientWithBaseURI(endpoint, c.subscriptionId)
-	c.configureClient(&endpointsClient.Client, auth)
-	c.trafficManagerEndpointsClient = endpointsClient
-
-	geographicalHierarchiesClient := trafficmanager.NewGeographicHierarchiesClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&geographicalHierarchiesClient.Client, auth)
-	c.trafficManagerGeographialHierarchiesClient = geographicalHierarchiesClient
-
-	profilesClient := trafficmanager.NewProfilesClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&profilesClient.Client, auth)
-	c.trafficManagerProfilesClient = profilesClient
-}
-
-func (c *ArmClient) registerWebClients(endpoint, subscriptionId string, auth autorest.Authorizer) {
-	appServicePlansClient := web.NewAppServicePlansClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&appServicePlansClient.Client, auth)
-	c.appServicePlansClient = appServicePlansClient
-
-	appsClient := web.NewAppsClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&appsClient.Client, auth)
-	c.appServicesClient = appsClient
-}
-
-func (c *ArmClient) registerPolicyClients(endpoint, subscriptionId string, auth autorest.Authorizer) {
-	assignmentsClient := policySvc.NewAssignmentsClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&assignmentsClient.Client, auth)
-
-	definitionsClient := policySvc.NewDefinitionsClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&definitionsClient.Client, auth)
-
-	setDefinitionsClient := policySvc.NewSetDefinitionsClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&setDefinitionsClient.Client, auth)
-
-	c.policy = &policy.Client{
-		AssignmentsClient:    assignmentsClient,
-		DefinitionsClient:    definitionsClient,
-		SetDefinitionsClient: setDefinitionsClient,
+		registrar, err := resourceproviders.NewRegistrar(providersClient, env.ResourceManagerEndpoint)
+		if err != nil {
+			return nil, err
+		}
+		client.clientOptions.ResourceProviderRegistrar = registrar
 	}
-}
-
-func (c *ArmClient) registerManagementGroupClients(endpoint string, auth autorest.Authorizer) {
-	managementGroupsClient := managementgroups.NewClientWithBaseURI(endpoint)
-	c.configureClient(&managementGroupsClient.Client, auth)
-	c.managementGroupsClient = managementGroupsClient
 
-	managementGroupsSubscriptionClient := managementgroups.NewSubscriptionsClientWithBaseURI(endpoint)
-	c.configureClient(&managementGroupsSubscriptionClient.Client, auth)
-	c.managementGroupsSubscriptionClient = managementGroupsSubscriptionClient
+	return &client, nil
 }
 
 var (
@@ -1430,7 +297,7 @@ func (c *ArmClient) getKeyForStorageAccount(ctx context.Context, resourceGroupNa
 	defer storageKeyCacheMu.Unlock()
 	key, ok = storageKeyCache[cacheIndex]
 	if !ok {
-		accountKeys, err := c.storageServiceClient.ListKeys(ctx, resourceGroupName, storageAccountName)
+		accountKeys, err := c.Storage().AccountsClient.ListKeys(ctx, resourceGroupName, storageAccountName)
 		if utils.ResponseWasNotFound(accountKeys.Response) {
 			return "", false, nil
 		}
@@ -1461,7 +328,22 @@ func (c *ArmClient) getKeyForStorageAccount(ctx context.Context, resourceGroupNa
 	return key, true, nil
 }
 
-func (c *ArmClient) getBlobStorageClientForStorageAccount(ctx context.Context, resourceGroupName, storageAccountName string) (*mainStorage.BlobStorageClient, bool, error) {
+// getStorageClientForStorageAccount returns a Storage Data Plane client for the specified Storage Account.
+//
+// When `storage_use_azuread` is enabled and the service supports it, requests are authorized using an Azure AD
+// token - otherwise the Account Key is used, which for services that don't support Azure AD requires
+// `storage_use_key_fallback` to be enabled. The bool return value is false if the Storage Account doesn't exist.
+func (c *ArmClient) getStorageClientForStorageAccount(ctx context.Context, resourceGroupName, storageAccountName string, serviceName string, supportsAzureAD bool) (*mainStorage.Client, bool, error) {
+	if c.storageUseAzureAD {
+		if supportsAzureAD {
+			return c.getAzureADStorageClientForStorageAccount(ctx, resourceGroupName, storageAccountName)
+		}
+
+		if !c.storageUseKeyFallback {
+			return nil, false, fmt.Errorf("%s don't support Azure AD authorization - `storage_use_key_fallback` must be enabled to use the Access Key for Storage Account %q", serviceName, storageAccountName)
+		}
+	}
+
 	key, accountExists, err := c.getKeyForStorageAccount(ctx, resourceGroupName, storageAccountName)
 	if err != nil {
 		return nil, accountExists, err
@@ -1476,42 +358,60 @@ func (c *ArmClient) getBlobStorageClientForStorageAccount(ctx context.Context, r
 		return nil, true, fmt.Errorf("Error creating storage client for storage storeAccount %q: %s", storageAccountName, err)
 	}
 
-	blobClient := storageClient.GetBlobService()
-	return &blobClient, true, nil
+	return &storageClient, true, nil
 }
 
-func (c *ArmClient) getFileServiceClientForStorageAccount(ctx context.Context, resourceGroupName, storageAccountName string) (*mainStorage.FileServiceClient, bool, error) {
-	key, accountExists, err := c.getKeyForStorageAccount(ctx, resourceGroupName, storageAccountName)
+// storageAzureADPlaceholderKey is used to construct Storage clients authorized using Azure AD, since the SDK
+// requires an Account Key - the Shared Key signature computed from it is replaced by the Bearer Token when sent
+const storageAzureADPlaceholderKey = "YXp1cmVhZA=="
+
+func (c *ArmClient) getAzureADStorageClientForStorageAccount(ctx context.Context, resourceGroupName, storageAccountName string) (*mainStorage.Client, bool, error) {
+	// since the keys aren't retrieved, check the Storage Account exists
+	account, err := c.Storage().AccountsClient.GetProperties(ctx, resourceGroupName, storageAccountName)
 	if err != nil {
-		return nil, accountExists, err
-	}
-	if !accountExists {
-		return nil, false, nil
+		if utils.ResponseWasNotFound(account.Response) {
+			return nil, false, nil
+		}
+
+		return nil, true, fmt.Errorf("Error retrieving storage storeAccount %q: %s", storageAccountName, err)
 	}
 
-	storageClient, err := mainStorage.NewClient(storageAccountName, key, c.environment.StorageEndpointSuffix,
-		mainStorage.DefaultAPIVersion, true)
+	storageClient, err := mainStorage.NewClient(storageAccountName, storageAzureADPlaceholderKey, c.environment.StorageEndpointSuffix,
+		azure.StorageAzureADAPIVersion, true)
 	if err != nil {
 		return nil, true, fmt.Errorf("Error creating storage client for storage storeAccount %q: %s", storageAccountName, err)
 	}
+	storageClient.Sender = azure.NewStorageBearerTokenSender(c.storageAuthorizer, azure.BuildSender(c.retryOptions, c.logRedaction))
 
-	fileClient := storageClient.GetFileService()
-	return &fileClient, true, nil
+	return &storageClient, true, nil
 }
 
-func (c *ArmClient) getTableServiceClientForStorageAccount(ctx context.Context, resourceGroupName, storageAccountName string) (*mainStorage.TableServiceClient, bool, error) {
-	key, accountExists, err := c.getKeyForStorageAccount(ctx, resourceGroupName, storageAccountName)
-	if err != nil {
+func (c *ArmClient) getBlobStorageClientForStorageAccount(ctx context.Context, resourceGroupName, storageAccountName string) (*mainStorage.BlobStorageClient, bool, error) {
+	storageClient, accountExists, err := c.getStorageClientForStorageAccount(ctx, resourceGroupName, storageAccountName, "Blobs", true)
+	if err != nil || !accountExists {
 		return nil, accountExists, err
 	}
-	if !accountExists {
-		return nil, false, nil
+
+	blobClient := storageClient.GetBlobService()
+	return &blobClient, true, nil
+}
+
+func (c *ArmClient) getFileServiceClientForStorageAccount(ctx context.Context, resourceGroupName, storageAccountName string) (*mainStorage.FileServiceClient, bool, error) {
+	// Azure AD authorization isn't supported for File Shares, so these can only use the Account Key
+	storageClient, accountExists, err := c.getStorageClientForStorageAccount(ctx, resourceGroupName, storageAccountName, "File Shares", false)
+	if err != nil || !accountExists {
+		return nil, accountExists, err
 	}
 
-	storageClient, err := mainStorage.NewClient(storageAccountName, key, c.environment.StorageEndpointSuffix,
-		mainStorage.DefaultAPIVersion, true)
-	if err != nil {
-		return nil, true, fmt.Errorf("Error creating storage client for storage storeAccount %q: %s", storageAccountName, err)
+	fileClient := storageClient.GetFileService()
+	return &fileClient, true, nil
+}
+
+func (c *ArmClient) getTableServiceClientForStorageAccount(ctx context.Context, resourceGroupName, storageAccountName string) (*mainStorage.TableServiceClient, bool, error) {
+	// Azure AD authorization isn't supported for Tables, so these can only use the Account Key
+	storageClient, accountExists, err := c.getStorageClientForStorageAccount(ctx, resourceGroupName, storageAccountName, "Tables", false)
+	if err != nil || !accountExists {
+		return nil, accountExists, err
 	}
 
 	tableClient := storageClient.GetTableService()
@@ -1519,19 +419,10 @@ func (c *ArmClient) getTableServiceClientForStorageAccount(ctx context.Context,
 }
 
 func (c *ArmClient) getQueueServiceClientForStorageAccount(ctx context.Context, resourceGroupName, storageAccountName string) (*mainStorage.QueueServiceClient, bool, error) {
-	key, accountExists, err := c.getKeyForStorageAccount(ctx, resourceGroupName, storageAccountName)
-	if err != nil {
+	storageClient, accountExists, err := c.getStorageClientForStorageAccount(ctx, resourceGroupName, storageAccountName, "Queues", true)
+	if err != nil || !accountExists {
 		return nil, accountExists, err
 	}
-	if !accountExists {
-		return nil, false, nil
-	}
-
-	storageClient, err := mainStorage.NewClient(storageAccountName, key, c.environment.StorageEndpointSuffix,
-		mainStorage.DefaultAPIVersion, true)
-	if err != nil {
-		return nil, true, fmt.Errorf("Error creating storage client for storage storeAccount %q: %s", storageAccountName, err)
-	}
 
 	queueClient := storageClient.GetQueueService()
 	return &queueClient, true, nil
@@ -88,7 +88,7 @@ func dataSourceArmLoadBalancerRead(d *schema.ResourceData, meta interface{}) err
 	name := d.Get("name").(string)
 	resourceGroup := d.Get("resource_group_name").(string)
 
-	client := meta.(*ArmClient).loadBalancerClient
+	client := meta.(*ArmClient).Network().LoadBalancersClient
 	ctx := meta.(*ArmClient).StopContext
 
 	resp, err := client.Get(ctx, resourceGroup, name, "")
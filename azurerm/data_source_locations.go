@@ -0,0 +1,97 @@
+package azurerm
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	resourceSvc "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/resource"
+)
+
+func dataSourceArmLocations() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceArmLocationsRead,
+
+		Schema: map[string]*schema.Schema{
+			"locations": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"display_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"regional_display_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"paired_regions": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceArmLocationsRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).Resource()
+	ctx := meta.(*ArmClient).StopContext
+
+	locations, err := client.LocationsCache.Locations(ctx, client.LocationsClient)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(time.Now().UTC().String())
+
+	if err := d.Set("locations", flattenDataSourceLocations(locations)); err != nil {
+		return fmt.Errorf("Error setting `locations`: %+v", err)
+	}
+
+	return nil
+}
+
+func flattenDataSourceLocations(input []resourceSvc.Location) []interface{} {
+	results := make([]interface{}, 0)
+
+	for _, location := range input {
+		output := make(map[string]interface{})
+
+		if location.Name != nil {
+			output["name"] = *location.Name
+		}
+
+		if location.DisplayName != nil {
+			output["display_name"] = *location.DisplayName
+		}
+
+		if location.RegionalDisplayName != nil {
+			output["regional_display_name"] = *location.RegionalDisplayName
+		}
+
+		pairedRegions := make([]interface{}, 0)
+		if metadata := location.Metadata; metadata != nil && metadata.PairedRegion != nil {
+			for _, v := range *metadata.PairedRegion {
+				if v.Name != nil {
+					pairedRegions = append(pairedRegions, *v.Name)
+				}
+			}
+		}
+		output["paired_regions"] = pairedRegions
+
+		results = append(results, output)
+	}
+
+	return results
+}
@@ -0,0 +1,59 @@
+package azurerm
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+)
+
+func TestAccDataSourceAzureRMLocations_basic(t *testing.T) {
+	dataSourceName := "data.azurerm_locations.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAzureRMLocations_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "locations.#"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "locations.0.name"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "locations.0.display_name"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMLocations_invalidLocation(t *testing.T) {
+	ri := tf.AccRandTimeInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAzureRMLocations_invalidLocation(ri),
+				ExpectError: regexp.MustCompile(`did you mean "westeurope"`),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAzureRMLocations_basic() string {
+	return `
+data "azurerm_locations" "test" {}
+`
+}
+
+func testAccAzureRMLocations_invalidLocation(rInt int) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "westeurope2"
+}
+`, rInt)
+}
@@ -0,0 +1,82 @@
+package azurerm
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	resourceSvc "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/resource"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func dataSourceArmResource() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceArmResourceRead,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"parent_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateGenericResourceType,
+			},
+
+			"api_version": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"body": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceArmResourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).Resource().ResourcesByIDClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id := resourceSvc.GenericResourceID{
+		ParentID: d.Get("parent_id").(string),
+		Type:     d.Get("type").(string),
+		Name:     d.Get("name").(string),
+	}
+	resourceId, err := id.ID()
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.GetByID(ctx, resourceId, d.Get("api_version").(string))
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return fmt.Errorf("Error: Resource %q was not found", resourceId)
+		}
+
+		return fmt.Errorf("Error retrieving Resource %q: %+v", resourceId, err)
+	}
+
+	d.SetId(resourceId)
+
+	body, err := json.Marshal(resp.Body)
+	if err != nil {
+		return fmt.Errorf("Error serializing `body`: %+v", err)
+	}
+	d.Set("body", string(body))
+
+	return nil
+}
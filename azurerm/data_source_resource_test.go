@@ -0,0 +1,43 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+)
+
+func TestAccDataSourceAzureRMResource_basic(t *testing.T) {
+	dataSourceName := "data.azurerm_resource.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAzureRMResource_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "id", "azurerm_resource.test", "id"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "body"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAzureRMResource_basic(rInt int, location string) string {
+	template := testAccAzureRMResource_basic(rInt, location, "10.0.0.0/16")
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_resource" "test" {
+  name        = "${azurerm_resource.test.name}"
+  parent_id   = "${azurerm_resource.test.parent_id}"
+  type        = "${azurerm_resource.test.type}"
+  api_version = "${azurerm_resource.test.api_version}"
+}
+`, template)
+}
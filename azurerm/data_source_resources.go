@@ -0,0 +1,191 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2018-05-01/resources"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+func dataSourceArmResources() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceArmResourcesRead,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"resource_group_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateGenericResourceType,
+			},
+
+			"required_tags": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
+			"resources": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"location": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"tags": {
+							Type:     schema.TypeMap,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceArmResourcesRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).Resource().ResourcesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	resourceGroup := d.Get("resource_group_name").(string)
+	requiredTags := d.Get("required_tags").(map[string]interface{})
+
+	// the API only allows filtering by a single Tag (which can't be combined with other filters)
+	// so the Tags are filtered once the Resources have been retrieved
+	filter := buildResourcesFilter(d.Get("type").(string), d.Get("name").(string))
+
+	var iterator resources.ListResultIterator
+	var err error
+	if resourceGroup != "" {
+		log.Printf("[DEBUG] Listing Resources in Resource Group %q with filter %q", resourceGroup, filter)
+		iterator, err = client.ListByResourceGroupComplete(ctx, resourceGroup, filter, "", nil)
+	} else {
+		log.Printf("[DEBUG] Listing Resources with filter %q", filter)
+		iterator, err = client.ListComplete(ctx, filter, "", nil)
+	}
+	if err != nil {
+		return fmt.Errorf("Error listing Resources: %+v", err)
+	}
+
+	results := make([]interface{}, 0)
+	for iterator.NotDone() {
+		element := iterator.Value()
+
+		if resourceHasRequiredTags(element.Tags, requiredTags) {
+			results = append(results, flattenDataSourceResource(element))
+		}
+
+		if err := iterator.NextWithContext(ctx); err != nil {
+			return fmt.Errorf("Error listing Resources: %+v", err)
+		}
+	}
+
+	d.SetId(time.Now().UTC().String())
+
+	if err := d.Set("resources", results); err != nil {
+		return fmt.Errorf("Error setting `resources`: %+v", err)
+	}
+
+	return nil
+}
+
+func buildResourcesFilter(resourceType string, name string) string {
+	filters := make([]string, 0)
+
+	if resourceType != "" {
+		filters = append(filters, fmt.Sprintf("resourceType eq '%s'", escapeODataString(resourceType)))
+	}
+
+	if name != "" {
+		filters = append(filters, fmt.Sprintf("name eq '%s'", escapeODataString(name)))
+	}
+
+	return strings.Join(filters, " and ")
+}
+
+// escapeODataString escapes a value for use within a quoted string in an OData filter, where a single quote
+// is escaped by doubling it
+func escapeODataString(input string) string {
+	return strings.Replace(input, "'", "''", -1)
+}
+
+func resourceHasRequiredTags(tags map[string]*string, requiredTags map[string]interface{}) bool {
+	for requiredKey, requiredValue := range requiredTags {
+		found := false
+
+		for key, value := range tags {
+			if strings.EqualFold(key, requiredKey) && value != nil && *value == requiredValue.(string) {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			return false
+		}
+	}
+
+	return true
+}
+
+func flattenDataSourceResource(input resources.GenericResource) map[string]interface{} {
+	output := make(map[string]interface{})
+
+	if input.ID != nil {
+		output["id"] = *input.ID
+	}
+
+	if input.Name != nil {
+		output["name"] = *input.Name
+	}
+
+	if input.Type != nil {
+		output["type"] = *input.Type
+	}
+
+	if input.Location != nil {
+		output["location"] = azure.NormalizeLocation(*input.Location)
+	}
+
+	tags := make(map[string]interface{})
+	for k, v := range input.Tags {
+		if v != nil {
+			tags[k] = *v
+		}
+	}
+	output["tags"] = tags
+
+	return output
+}
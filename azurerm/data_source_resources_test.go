@@ -0,0 +1,288 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestResourcesFilter_build(t *testing.T) {
+	testData := []struct {
+		Type     string
+		Name     string
+		Expected string
+	}{
+		{
+			Expected: "",
+		},
+		{
+			Type:     "Microsoft.Network/virtualNetworks",
+			Expected: "resourceType eq 'Microsoft.Network/virtualNetworks'",
+		},
+		{
+			Name:     "network1",
+			Expected: "name eq 'network1'",
+		},
+		{
+			Type:     "Microsoft.Network/virtualNetworks",
+			Name:     "network1",
+			Expected: "resourceType eq 'Microsoft.Network/virtualNetworks' and name eq 'network1'",
+		},
+		{
+			Type:     "Microsoft.Network/virtualNetworks' or resourceType eq 'Microsoft.Storage/storageAccounts",
+			Name:     "o'brien",
+			Expected: "resourceType eq 'Microsoft.Network/virtualNetworks'' or resourceType eq ''Microsoft.Storage/storageAccounts' and name eq 'o''brien'",
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q / %q", v.Type, v.Name)
+
+		actual := buildResourcesFilter(v.Type, v.Name)
+		if actual != v.Expected {
+			t.Fatalf("Expected %q but got %q", v.Expected, actual)
+		}
+	}
+}
+
+func TestResourcesRequiredTags(t *testing.T) {
+	tags := map[string]*string{
+		"env":   utils.String("prod"),
+		"Owner": utils.String("team1"),
+	}
+
+	testData := []struct {
+		Name         string
+		RequiredTags map[string]interface{}
+		Expected     bool
+	}{
+		{
+			Name:         "No Required Tags",
+			RequiredTags: map[string]interface{}{},
+			Expected:     true,
+		},
+		{
+			Name: "Matching Tag",
+			RequiredTags: map[string]interface{}{
+				"env": "prod",
+			},
+			Expected: true,
+		},
+		{
+			Name: "Tag Keys are case-insensitive",
+			RequiredTags: map[string]interface{}{
+				"owner": "team1",
+				"ENV":   "prod",
+			},
+			Expected: true,
+		},
+		{
+			Name: "Different Value",
+			RequiredTags: map[string]interface{}{
+				"env": "dev",
+			},
+			Expected: false,
+		},
+		{
+			Name: "Missing Tag",
+			RequiredTags: map[string]interface{}{
+				"env":  "prod",
+				"cost": "123",
+			},
+			Expected: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		actual := resourceHasRequiredTags(tags, v.RequiredTags)
+		if actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
+func TestAccDataSourceAzureRMResources_byResourceGroup(t *testing.T) {
+	dataSourceName := "data.azurerm_resources.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAzureRMResources_template(ri, location),
+			},
+			{
+				Config: testAccDataSourceAzureRMResources_byResourceGroup(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "resources.#", "3"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceAzureRMResources_byType(t *testing.T) {
+	dataSourceName := "data.azurerm_resources.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAzureRMResources_template(ri, location),
+			},
+			{
+				Config: testAccDataSourceAzureRMResources_byType(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "resources.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "resources.0.type", "Microsoft.Network/virtualNetworks"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceAzureRMResources_byName(t *testing.T) {
+	dataSourceName := "data.azurerm_resources.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAzureRMResources_template(ri, location),
+			},
+			{
+				Config: testAccDataSourceAzureRMResources_byName(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "resources.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "resources.0.name", fmt.Sprintf("acctestvirtnet%d-prod", ri)),
+					resource.TestCheckResourceAttr(dataSourceName, "resources.0.tags.%", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceAzureRMResources_byRequiredTags(t *testing.T) {
+	dataSourceName := "data.azurerm_resources.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAzureRMResources_template(ri, location),
+			},
+			{
+				Config: testAccDataSourceAzureRMResources_byRequiredTags(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "resources.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAzureRMResources_template(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_virtual_network" "prod" {
+  name                = "acctestvirtnet%d-prod"
+  address_space       = ["10.0.0.0/16"]
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  tags = {
+    env = "prod"
+  }
+}
+
+resource "azurerm_virtual_network" "dev" {
+  name                = "acctestvirtnet%d-dev"
+  address_space       = ["10.1.0.0/16"]
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  tags = {
+    env = "dev"
+  }
+}
+
+resource "azurerm_network_security_group" "prod" {
+  name                = "acctestnsg%d-prod"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  tags = {
+    env = "prod"
+  }
+}
+`, rInt, location, rInt, rInt, rInt)
+}
+
+func testAccDataSourceAzureRMResources_byResourceGroup(rInt int, location string) string {
+	template := testAccDataSourceAzureRMResources_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_resources" "test" {
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+`, template)
+}
+
+func testAccDataSourceAzureRMResources_byType(rInt int, location string) string {
+	template := testAccDataSourceAzureRMResources_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_resources" "test" {
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  type                = "Microsoft.Network/virtualNetworks"
+}
+`, template)
+}
+
+func testAccDataSourceAzureRMResources_byName(rInt int, location string) string {
+	template := testAccDataSourceAzureRMResources_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_resources" "test" {
+  name = "${azurerm_virtual_network.prod.name}"
+}
+`, template)
+}
+
+func testAccDataSourceAzureRMResources_byRequiredTags(rInt int, location string) string {
+	template := testAccDataSourceAzureRMResources_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_resources" "test" {
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  required_tags = {
+    env = "prod"
+  }
+}
+`, template)
+}
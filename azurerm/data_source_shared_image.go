@@ -82,7 +82,7 @@ func dataSourceArmSharedImage() *schema.Resource {
 	}
 }
 func dataSourceArmSharedImageRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).galleryImagesClient
+	client := meta.(*ArmClient).Compute().GalleryImagesClient
 	ctx := meta.(*ArmClient).StopContext
 
 	name := d.Get("name").(string)
@@ -46,7 +46,7 @@ func dataSourceSqlServer() *schema.Resource {
 }
 
 func dataSourceArmSqlServerRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).sqlServersClient
+	client := meta.(*ArmClient).Sql().ServersClient
 	ctx := meta.(*ArmClient).StopContext
 
 	name := d.Get("name").(string)
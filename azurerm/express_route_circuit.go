@@ -1,6 +1,7 @@
 package azurerm
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-12-01/network"
@@ -16,9 +17,8 @@ func extractResourceGroupAndErcName(resourceId string) (resourceGroup string, na
 	return id.ResourceGroup, id.Path["expressRouteCircuits"], err
 }
 
-func retrieveErcByResourceId(resourceId string, meta interface{}) (erc *network.ExpressRouteCircuit, resourceGroup string, e error) {
-	ercClient := meta.(*ArmClient).expressRouteCircuitClient
-	ctx := meta.(*ArmClient).StopContext
+func retrieveErcByResourceId(ctx context.Context, resourceId string, meta interface{}) (erc *network.ExpressRouteCircuit, resourceGroup string, e error) {
+	ercClient := meta.(*ArmClient).Network().ExpressRouteCircuitsClient
 
 	resGroup, name, err := extractResourceGroupAndErcName(resourceId)
 	if err != nil {
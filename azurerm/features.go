@@ -0,0 +1,198 @@
+package azurerm
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
+)
+
+func schemaFeatures() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"compute_capacity": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"validate_during_plan": {
+								Type:     schema.TypeBool,
+								Optional: true,
+								Default:  false,
+							},
+						},
+					},
+				},
+
+				"existing_resources": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"require_import": {
+								Type:        schema.TypeBool,
+								Optional:    true,
+								DefaultFunc: schema.EnvDefaultFunc("ARM_PROVIDER_STRICT", false),
+							},
+						},
+					},
+				},
+
+				"key_vault": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"purge_soft_delete_on_destroy": {
+								Type:     schema.TypeBool,
+								Optional: true,
+								Default:  false,
+							},
+						},
+					},
+				},
+
+				"template_deployment": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"delete_nested_items_during_deletion": {
+								Type:     schema.TypeBool,
+								Optional: true,
+								Default:  false,
+							},
+						},
+					},
+				},
+
+				"virtual_machine": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"delete_os_disk_on_deletion": {
+								Type:     schema.TypeBool,
+								Optional: true,
+								Default:  false,
+							},
+
+							"delete_data_disks_on_deletion": {
+								Type:     schema.TypeBool,
+								Optional: true,
+								Default:  false,
+							},
+						},
+					},
+				},
+
+				"virtual_machine_scale_set": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"roll_instances_when_required": {
+								Type:     schema.TypeBool,
+								Optional: true,
+								Default:  false,
+							},
+
+							"wait_for_rolling_upgrades": {
+								Type:     schema.TypeBool,
+								Optional: true,
+								Default:  false,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func expandFeatures(input []interface{}) features.UserFeatures {
+	// these are the defaults if omitted from the config
+	output := features.Default()
+
+	if len(input) == 0 || input[0] == nil {
+		return output
+	}
+
+	val := input[0].(map[string]interface{})
+
+	if raw, ok := val["compute_capacity"]; ok {
+		items := raw.([]interface{})
+		if len(items) > 0 && items[0] != nil {
+			computeCapacityRaw := items[0].(map[string]interface{})
+			if v, ok := computeCapacityRaw["validate_during_plan"]; ok {
+				output.ComputeCapacity.ValidateDuringPlan = v.(bool)
+			}
+		}
+	}
+
+	if raw, ok := val["existing_resources"]; ok {
+		items := raw.([]interface{})
+		if len(items) > 0 && items[0] != nil {
+			existingResourcesRaw := items[0].(map[string]interface{})
+			if v, ok := existingResourcesRaw["require_import"]; ok {
+				output.ExistingResources.RequireImport = v.(bool)
+			}
+		}
+	}
+
+	if raw, ok := val["key_vault"]; ok {
+		items := raw.([]interface{})
+		if len(items) > 0 && items[0] != nil {
+			keyVaultRaw := items[0].(map[string]interface{})
+			if v, ok := keyVaultRaw["purge_soft_delete_on_destroy"]; ok {
+				output.KeyVault.PurgeSoftDeleteOnDestroy = v.(bool)
+			}
+		}
+	}
+
+	if raw, ok := val["template_deployment"]; ok {
+		items := raw.([]interface{})
+		if len(items) > 0 && items[0] != nil {
+			templateDeploymentRaw := items[0].(map[string]interface{})
+			if v, ok := templateDeploymentRaw["delete_nested_items_during_deletion"]; ok {
+				output.TemplateDeployment.DeleteNestedItemsDuringDeletion = v.(bool)
+			}
+		}
+	}
+
+	if raw, ok := val["virtual_machine"]; ok {
+		items := raw.([]interface{})
+		if len(items) > 0 && items[0] != nil {
+			virtualMachinesRaw := items[0].(map[string]interface{})
+			if v, ok := virtualMachinesRaw["delete_os_disk_on_deletion"]; ok {
+				output.VirtualMachine.DeleteOSDiskOnDeletion = v.(bool)
+			}
+			if v, ok := virtualMachinesRaw["delete_data_disks_on_deletion"]; ok {
+				output.VirtualMachine.DeleteDataDisksOnDeletion = v.(bool)
+			}
+		}
+	}
+
+	if raw, ok := val["virtual_machine_scale_set"]; ok {
+		items := raw.([]interface{})
+		if len(items) > 0 && items[0] != nil {
+			scaleSetRaw := items[0].(map[string]interface{})
+			if v, ok := scaleSetRaw["roll_instances_when_required"]; ok {
+				output.VirtualMachineScaleSet.RollInstancesWhenRequired = v.(bool)
+			}
+			if v, ok := scaleSetRaw["wait_for_rolling_upgrades"]; ok {
+				output.VirtualMachineScaleSet.WaitForRollingUpgrades = v.(bool)
+			}
+		}
+	}
+
+	return output
+}
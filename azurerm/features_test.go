@@ -0,0 +1,130 @@
+package azurerm
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
+)
+
+func TestExpandFeatures(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    []interface{}
+		Expected features.UserFeatures
+	}{
+		{
+			Name:     "Empty Block",
+			Input:    []interface{}{},
+			Expected: features.Default(),
+		},
+		{
+			Name: "Complete Enabled",
+			Input: []interface{}{
+				map[string]interface{}{
+					"compute_capacity": []interface{}{
+						map[string]interface{}{
+							"validate_during_plan": true,
+						},
+					},
+					"existing_resources": []interface{}{
+						map[string]interface{}{
+							"require_import": true,
+						},
+					},
+					"key_vault": []interface{}{
+						map[string]interface{}{
+							"purge_soft_delete_on_destroy": true,
+						},
+					},
+					"template_deployment": []interface{}{
+						map[string]interface{}{
+							"delete_nested_items_during_deletion": true,
+						},
+					},
+					"virtual_machine": []interface{}{
+						map[string]interface{}{
+							"delete_os_disk_on_deletion":    true,
+							"delete_data_disks_on_deletion": true,
+						},
+					},
+					"virtual_machine_scale_set": []interface{}{
+						map[string]interface{}{
+							"roll_instances_when_required": true,
+							"wait_for_rolling_upgrades":    true,
+						},
+					},
+				},
+			},
+			Expected: features.UserFeatures{
+				ComputeCapacity: features.ComputeCapacityFeatures{
+					ValidateDuringPlan: true,
+				},
+				ExistingResources: features.ExistingResourcesFeatures{
+					RequireImport: true,
+				},
+				KeyVault: features.KeyVaultFeatures{
+					PurgeSoftDeleteOnDestroy: true,
+				},
+				TemplateDeployment: features.TemplateDeploymentFeatures{
+					DeleteNestedItemsDuringDeletion: true,
+				},
+				VirtualMachine: features.VirtualMachineFeatures{
+					DeleteOSDiskOnDeletion:    true,
+					DeleteDataDisksOnDeletion: true,
+				},
+				VirtualMachineScaleSet: features.VirtualMachineScaleSetFeatures{
+					RollInstancesWhenRequired: true,
+					WaitForRollingUpgrades:    true,
+				},
+			},
+		},
+		{
+			Name: "Partially Specified",
+			Input: []interface{}{
+				map[string]interface{}{
+					"existing_resources": []interface{}{
+						map[string]interface{}{
+							"require_import": false,
+						},
+					},
+					"virtual_machine": []interface{}{
+						map[string]interface{}{
+							"delete_os_disk_on_deletion": true,
+						},
+					},
+				},
+			},
+			Expected: features.UserFeatures{
+				ComputeCapacity: features.ComputeCapacityFeatures{
+					ValidateDuringPlan: false,
+				},
+				ExistingResources: features.ExistingResourcesFeatures{
+					RequireImport: false,
+				},
+				KeyVault: features.KeyVaultFeatures{
+					PurgeSoftDeleteOnDestroy: false,
+				},
+				TemplateDeployment: features.TemplateDeploymentFeatures{
+					DeleteNestedItemsDuringDeletion: false,
+				},
+				VirtualMachine: features.VirtualMachineFeatures{
+					DeleteOSDiskOnDeletion:    true,
+					DeleteDataDisksOnDeletion: false,
+				},
+				VirtualMachineScaleSet: features.VirtualMachineScaleSetFeatures{
+					RollInstancesWhenRequired: false,
+					WaitForRollingUpgrades:    false,
+				},
+			},
+		},
+	}
+
+	for _, testCase := range testData {
+		t.Logf("[DEBUG] Test Case: %q", testCase.Name)
+		result := expandFeatures(testCase.Input)
+		if !reflect.DeepEqual(result, testCase.Expected) {
+			t.Fatalf("Expected %+v but got %+v", testCase.Expected, result)
+		}
+	}
+}
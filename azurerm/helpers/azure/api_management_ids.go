@@ -0,0 +1,641 @@
+package azure
+
+import (
+	"fmt"
+)
+
+// ApiManagementID is a parsed API Management Service Resource ID
+type ApiManagementID struct {
+	ResourceGroup string
+	Name          string
+}
+
+func (id ApiManagementID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.ApiManagement/service/%s", subscriptionId, id.ResourceGroup, id.Name)
+}
+
+func ParseApiManagementID(input string) (*ApiManagementID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.ApiManagement", "API Management Service", "service")
+	if err != nil {
+		return nil, err
+	}
+
+	return &ApiManagementID{
+		ResourceGroup: id.ResourceGroup,
+		Name:          segments[0],
+	}, nil
+}
+
+func ValidateApiManagementID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseApiManagementID(input)
+		return err
+	})
+}
+
+// ApiManagementApiID is a parsed API Management API Resource ID
+type ApiManagementApiID struct {
+	ResourceGroup string
+	ServiceName   string
+	Name          string
+}
+
+func (id ApiManagementApiID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.ApiManagement/service/%s/apis/%s", subscriptionId, id.ResourceGroup, id.ServiceName, id.Name)
+}
+
+func ParseApiManagementApiID(input string) (*ApiManagementApiID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.ApiManagement", "API Management API", "service", "apis")
+	if err != nil {
+		return nil, err
+	}
+
+	return &ApiManagementApiID{
+		ResourceGroup: id.ResourceGroup,
+		ServiceName:   segments[0],
+		Name:          segments[1],
+	}, nil
+}
+
+func ValidateApiManagementApiID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseApiManagementApiID(input)
+		return err
+	})
+}
+
+// ApiManagementApiOperationID is a parsed API Management API Operation Resource ID
+type ApiManagementApiOperationID struct {
+	ResourceGroup string
+	ServiceName   string
+	ApiName       string
+	Name          string
+}
+
+func (id ApiManagementApiOperationID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.ApiManagement/service/%s/apis/%s/operations/%s", subscriptionId, id.ResourceGroup, id.ServiceName, id.ApiName, id.Name)
+}
+
+func ParseApiManagementApiOperationID(input string) (*ApiManagementApiOperationID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.ApiManagement", "API Management API Operation", "service", "apis", "operations")
+	if err != nil {
+		return nil, err
+	}
+
+	return &ApiManagementApiOperationID{
+		ResourceGroup: id.ResourceGroup,
+		ServiceName:   segments[0],
+		ApiName:       segments[1],
+		Name:          segments[2],
+	}, nil
+}
+
+func ValidateApiManagementApiOperationID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseApiManagementApiOperationID(input)
+		return err
+	})
+}
+
+// ApiManagementApiOperationPolicyID is a parsed API Management API Operation Policy Resource ID
+type ApiManagementApiOperationPolicyID struct {
+	ResourceGroup string
+	ServiceName   string
+	ApiName       string
+	OperationName string
+	Name          string
+}
+
+func (id ApiManagementApiOperationPolicyID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.ApiManagement/service/%s/apis/%s/operations/%s/policies/%s", subscriptionId, id.ResourceGroup, id.ServiceName, id.ApiName, id.OperationName, id.Name)
+}
+
+func ParseApiManagementApiOperationPolicyID(input string) (*ApiManagementApiOperationPolicyID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.ApiManagement", "API Management API Operation Policy", "service", "apis", "operations", "policies")
+	if err != nil {
+		return nil, err
+	}
+
+	return &ApiManagementApiOperationPolicyID{
+		ResourceGroup: id.ResourceGroup,
+		ServiceName:   segments[0],
+		ApiName:       segments[1],
+		OperationName: segments[2],
+		Name:          segments[3],
+	}, nil
+}
+
+func ValidateApiManagementApiOperationPolicyID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseApiManagementApiOperationPolicyID(input)
+		return err
+	})
+}
+
+// ApiManagementApiPolicyID is a parsed API Management API Policy Resource ID
+type ApiManagementApiPolicyID struct {
+	ResourceGroup string
+	ServiceName   string
+	ApiName       string
+	Name          string
+}
+
+func (id ApiManagementApiPolicyID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.ApiManagement/service/%s/apis/%s/policies/%s", subscriptionId, id.ResourceGroup, id.ServiceName, id.ApiName, id.Name)
+}
+
+func ParseApiManagementApiPolicyID(input string) (*ApiManagementApiPolicyID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.ApiManagement", "API Management API Policy", "service", "apis", "policies")
+	if err != nil {
+		return nil, err
+	}
+
+	return &ApiManagementApiPolicyID{
+		ResourceGroup: id.ResourceGroup,
+		ServiceName:   segments[0],
+		ApiName:       segments[1],
+		Name:          segments[2],
+	}, nil
+}
+
+func ValidateApiManagementApiPolicyID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseApiManagementApiPolicyID(input)
+		return err
+	})
+}
+
+// ApiManagementApiSchemaID is a parsed API Management API Schema Resource ID
+type ApiManagementApiSchemaID struct {
+	ResourceGroup string
+	ServiceName   string
+	ApiName       string
+	Name          string
+}
+
+func (id ApiManagementApiSchemaID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.ApiManagement/service/%s/apis/%s/schemas/%s", subscriptionId, id.ResourceGroup, id.ServiceName, id.ApiName, id.Name)
+}
+
+func ParseApiManagementApiSchemaID(input string) (*ApiManagementApiSchemaID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.ApiManagement", "API Management API Schema", "service", "apis", "schemas")
+	if err != nil {
+		return nil, err
+	}
+
+	return &ApiManagementApiSchemaID{
+		ResourceGroup: id.ResourceGroup,
+		ServiceName:   segments[0],
+		ApiName:       segments[1],
+		Name:          segments[2],
+	}, nil
+}
+
+func ValidateApiManagementApiSchemaID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseApiManagementApiSchemaID(input)
+		return err
+	})
+}
+
+// ApiManagementApiVersionSetID is a parsed API Management API Version Set Resource ID
+type ApiManagementApiVersionSetID struct {
+	ResourceGroup string
+	ServiceName   string
+	Name          string
+}
+
+func (id ApiManagementApiVersionSetID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.ApiManagement/service/%s/api-version-sets/%s", subscriptionId, id.ResourceGroup, id.ServiceName, id.Name)
+}
+
+func ParseApiManagementApiVersionSetID(input string) (*ApiManagementApiVersionSetID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.ApiManagement", "API Management API Version Set", "service", "api-version-sets")
+	if err != nil {
+		return nil, err
+	}
+
+	return &ApiManagementApiVersionSetID{
+		ResourceGroup: id.ResourceGroup,
+		ServiceName:   segments[0],
+		Name:          segments[1],
+	}, nil
+}
+
+func ValidateApiManagementApiVersionSetID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseApiManagementApiVersionSetID(input)
+		return err
+	})
+}
+
+// ApiManagementAuthorizationServerID is a parsed API Management Authorization Server Resource ID
+type ApiManagementAuthorizationServerID struct {
+	ResourceGroup string
+	ServiceName   string
+	Name          string
+}
+
+func (id ApiManagementAuthorizationServerID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.ApiManagement/service/%s/authorizationServers/%s", subscriptionId, id.ResourceGroup, id.ServiceName, id.Name)
+}
+
+func ParseApiManagementAuthorizationServerID(input string) (*ApiManagementAuthorizationServerID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.ApiManagement", "API Management Authorization Server", "service", "authorizationServers")
+	if err != nil {
+		return nil, err
+	}
+
+	return &ApiManagementAuthorizationServerID{
+		ResourceGroup: id.ResourceGroup,
+		ServiceName:   segments[0],
+		Name:          segments[1],
+	}, nil
+}
+
+func ValidateApiManagementAuthorizationServerID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseApiManagementAuthorizationServerID(input)
+		return err
+	})
+}
+
+// ApiManagementCertificateID is a parsed API Management Certificate Resource ID
+type ApiManagementCertificateID struct {
+	ResourceGroup string
+	ServiceName   string
+	Name          string
+}
+
+func (id ApiManagementCertificateID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.ApiManagement/service/%s/certificates/%s", subscriptionId, id.ResourceGroup, id.ServiceName, id.Name)
+}
+
+func ParseApiManagementCertificateID(input string) (*ApiManagementCertificateID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.ApiManagement", "API Management Certificate", "service", "certificates")
+	if err != nil {
+		return nil, err
+	}
+
+	return &ApiManagementCertificateID{
+		ResourceGroup: id.ResourceGroup,
+		ServiceName:   segments[0],
+		Name:          segments[1],
+	}, nil
+}
+
+func ValidateApiManagementCertificateID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseApiManagementCertificateID(input)
+		return err
+	})
+}
+
+// ApiManagementGroupID is a parsed API Management Group Resource ID
+type ApiManagementGroupID struct {
+	ResourceGroup string
+	ServiceName   string
+	Name          string
+}
+
+func (id ApiManagementGroupID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.ApiManagement/service/%s/groups/%s", subscriptionId, id.ResourceGroup, id.ServiceName, id.Name)
+}
+
+func ParseApiManagementGroupID(input string) (*ApiManagementGroupID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.ApiManagement", "API Management Group", "service", "groups")
+	if err != nil {
+		return nil, err
+	}
+
+	return &ApiManagementGroupID{
+		ResourceGroup: id.ResourceGroup,
+		ServiceName:   segments[0],
+		Name:          segments[1],
+	}, nil
+}
+
+func ValidateApiManagementGroupID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseApiManagementGroupID(input)
+		return err
+	})
+}
+
+// ApiManagementGroupUserID is a parsed API Management Group User Resource ID
+type ApiManagementGroupUserID struct {
+	ResourceGroup string
+	ServiceName   string
+	GroupName     string
+	Name          string
+}
+
+func (id ApiManagementGroupUserID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.ApiManagement/service/%s/groups/%s/users/%s", subscriptionId, id.ResourceGroup, id.ServiceName, id.GroupName, id.Name)
+}
+
+func ParseApiManagementGroupUserID(input string) (*ApiManagementGroupUserID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.ApiManagement", "API Management Group User", "service", "groups", "users")
+	if err != nil {
+		return nil, err
+	}
+
+	return &ApiManagementGroupUserID{
+		ResourceGroup: id.ResourceGroup,
+		ServiceName:   segments[0],
+		GroupName:     segments[1],
+		Name:          segments[2],
+	}, nil
+}
+
+func ValidateApiManagementGroupUserID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseApiManagementGroupUserID(input)
+		return err
+	})
+}
+
+// ApiManagementLoggerID is a parsed API Management Logger Resource ID
+type ApiManagementLoggerID struct {
+	ResourceGroup string
+	ServiceName   string
+	Name          string
+}
+
+func (id ApiManagementLoggerID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.ApiManagement/service/%s/loggers/%s", subscriptionId, id.ResourceGroup, id.ServiceName, id.Name)
+}
+
+func ParseApiManagementLoggerID(input string) (*ApiManagementLoggerID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.ApiManagement", "API Management Logger", "service", "loggers")
+	if err != nil {
+		return nil, err
+	}
+
+	return &ApiManagementLoggerID{
+		ResourceGroup: id.ResourceGroup,
+		ServiceName:   segments[0],
+		Name:          segments[1],
+	}, nil
+}
+
+func ValidateApiManagementLoggerID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseApiManagementLoggerID(input)
+		return err
+	})
+}
+
+// ApiManagementOpenIDConnectProviderID is a parsed API Management OpenID Connect Provider Resource ID
+type ApiManagementOpenIDConnectProviderID struct {
+	ResourceGroup string
+	ServiceName   string
+	Name          string
+}
+
+func (id ApiManagementOpenIDConnectProviderID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.ApiManagement/service/%s/openidConnectProviders/%s", subscriptionId, id.ResourceGroup, id.ServiceName, id.Name)
+}
+
+func ParseApiManagementOpenIDConnectProviderID(input string) (*ApiManagementOpenIDConnectProviderID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.ApiManagement", "API Management OpenID Connect Provider", "service", "openidConnectProviders")
+	if err != nil {
+		return nil, err
+	}
+
+	return &ApiManagementOpenIDConnectProviderID{
+		ResourceGroup: id.ResourceGroup,
+		ServiceName:   segments[0],
+		Name:          segments[1],
+	}, nil
+}
+
+func ValidateApiManagementOpenIDConnectProviderID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseApiManagementOpenIDConnectProviderID(input)
+		return err
+	})
+}
+
+// ApiManagementProductID is a parsed API Management Product Resource ID
+type ApiManagementProductID struct {
+	ResourceGroup string
+	ServiceName   string
+	Name          string
+}
+
+func (id ApiManagementProductID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.ApiManagement/service/%s/products/%s", subscriptionId, id.ResourceGroup, id.ServiceName, id.Name)
+}
+
+func ParseApiManagementProductID(input string) (*ApiManagementProductID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.ApiManagement", "API Management Product", "service", "products")
+	if err != nil {
+		return nil, err
+	}
+
+	return &ApiManagementProductID{
+		ResourceGroup: id.ResourceGroup,
+		ServiceName:   segments[0],
+		Name:          segments[1],
+	}, nil
+}
+
+func ValidateApiManagementProductID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseApiManagementProductID(input)
+		return err
+	})
+}
+
+// ApiManagementProductApiID is a parsed API Management Product API Resource ID
+type ApiManagementProductApiID struct {
+	ResourceGroup string
+	ServiceName   string
+	ProductName   string
+	Name          string
+}
+
+func (id ApiManagementProductApiID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.ApiManagement/service/%s/products/%s/apis/%s", subscriptionId, id.ResourceGroup, id.ServiceName, id.ProductName, id.Name)
+}
+
+func ParseApiManagementProductApiID(input string) (*ApiManagementProductApiID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.ApiManagement", "API Management Product API", "service", "products", "apis")
+	if err != nil {
+		return nil, err
+	}
+
+	return &ApiManagementProductApiID{
+		ResourceGroup: id.ResourceGroup,
+		ServiceName:   segments[0],
+		ProductName:   segments[1],
+		Name:          segments[2],
+	}, nil
+}
+
+func ValidateApiManagementProductApiID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseApiManagementProductApiID(input)
+		return err
+	})
+}
+
+// ApiManagementProductGroupID is a parsed API Management Product Group Resource ID
+type ApiManagementProductGroupID struct {
+	ResourceGroup string
+	ServiceName   string
+	ProductName   string
+	Name          string
+}
+
+func (id ApiManagementProductGroupID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.ApiManagement/service/%s/products/%s/groups/%s", subscriptionId, id.ResourceGroup, id.ServiceName, id.ProductName, id.Name)
+}
+
+func ParseApiManagementProductGroupID(input string) (*ApiManagementProductGroupID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.ApiManagement", "API Management Product Group", "service", "products", "groups")
+	if err != nil {
+		return nil, err
+	}
+
+	return &ApiManagementProductGroupID{
+		ResourceGroup: id.ResourceGroup,
+		ServiceName:   segments[0],
+		ProductName:   segments[1],
+		Name:          segments[2],
+	}, nil
+}
+
+func ValidateApiManagementProductGroupID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseApiManagementProductGroupID(input)
+		return err
+	})
+}
+
+// ApiManagementProductPolicyID is a parsed API Management Product Policy Resource ID
+type ApiManagementProductPolicyID struct {
+	ResourceGroup string
+	ServiceName   string
+	ProductName   string
+	Name          string
+}
+
+func (id ApiManagementProductPolicyID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.ApiManagement/service/%s/products/%s/policies/%s", subscriptionId, id.ResourceGroup, id.ServiceName, id.ProductName, id.Name)
+}
+
+func ParseApiManagementProductPolicyID(input string) (*ApiManagementProductPolicyID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.ApiManagement", "API Management Product Policy", "service", "products", "policies")
+	if err != nil {
+		return nil, err
+	}
+
+	return &ApiManagementProductPolicyID{
+		ResourceGroup: id.ResourceGroup,
+		ServiceName:   segments[0],
+		ProductName:   segments[1],
+		Name:          segments[2],
+	}, nil
+}
+
+func ValidateApiManagementProductPolicyID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseApiManagementProductPolicyID(input)
+		return err
+	})
+}
+
+// ApiManagementPropertyID is a parsed API Management Property Resource ID
+type ApiManagementPropertyID struct {
+	ResourceGroup string
+	ServiceName   string
+	Name          string
+}
+
+func (id ApiManagementPropertyID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.ApiManagement/service/%s/properties/%s", subscriptionId, id.ResourceGroup, id.ServiceName, id.Name)
+}
+
+func ParseApiManagementPropertyID(input string) (*ApiManagementPropertyID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.ApiManagement", "API Management Property", "service", "properties")
+	if err != nil {
+		return nil, err
+	}
+
+	return &ApiManagementPropertyID{
+		ResourceGroup: id.ResourceGroup,
+		ServiceName:   segments[0],
+		Name:          segments[1],
+	}, nil
+}
+
+func ValidateApiManagementPropertyID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseApiManagementPropertyID(input)
+		return err
+	})
+}
+
+// ApiManagementSubscriptionID is a parsed API Management Subscription Resource ID
+type ApiManagementSubscriptionID struct {
+	ResourceGroup string
+	ServiceName   string
+	Name          string
+}
+
+func (id ApiManagementSubscriptionID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.ApiManagement/service/%s/subscriptions/%s", subscriptionId, id.ResourceGroup, id.ServiceName, id.Name)
+}
+
+func ParseApiManagementSubscriptionID(input string) (*ApiManagementSubscriptionID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.ApiManagement", "API Management Subscription", "service", "subscriptions")
+	if err != nil {
+		return nil, err
+	}
+
+	return &ApiManagementSubscriptionID{
+		ResourceGroup: id.ResourceGroup,
+		ServiceName:   segments[0],
+		Name:          segments[1],
+	}, nil
+}
+
+func ValidateApiManagementSubscriptionID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseApiManagementSubscriptionID(input)
+		return err
+	})
+}
+
+// ApiManagementUserID is a parsed API Management User Resource ID
+type ApiManagementUserID struct {
+	ResourceGroup string
+	ServiceName   string
+	Name          string
+}
+
+func (id ApiManagementUserID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.ApiManagement/service/%s/users/%s", subscriptionId, id.ResourceGroup, id.ServiceName, id.Name)
+}
+
+func ParseApiManagementUserID(input string) (*ApiManagementUserID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.ApiManagement", "API Management User", "service", "users")
+	if err != nil {
+		return nil, err
+	}
+
+	return &ApiManagementUserID{
+		ResourceGroup: id.ResourceGroup,
+		ServiceName:   segments[0],
+		Name:          segments[1],
+	}, nil
+}
+
+func ValidateApiManagementUserID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseApiManagementUserID(input)
+		return err
+	})
+}
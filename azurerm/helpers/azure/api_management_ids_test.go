@@ -0,0 +1,150 @@
+package azure
+
+import (
+	"testing"
+)
+
+func TestApiManagementResourceIDs(t *testing.T) {
+	testResourceIDs(t, []resourceIDTestCase{
+		{
+			Name:  "API Management Service",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.ApiManagement/service/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParseApiManagementID(input)
+			},
+		},
+		{
+			Name:  "API Management API",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.ApiManagement/service/service1/apis/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParseApiManagementApiID(input)
+			},
+		},
+		{
+			Name:  "API Management API Operation",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.ApiManagement/service/service1/apis/api1/operations/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParseApiManagementApiOperationID(input)
+			},
+		},
+		{
+			Name:  "API Management API Operation Policy",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.ApiManagement/service/service1/apis/api1/operations/operation1/policies/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParseApiManagementApiOperationPolicyID(input)
+			},
+		},
+		{
+			Name:  "API Management API Policy",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.ApiManagement/service/service1/apis/api1/policies/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParseApiManagementApiPolicyID(input)
+			},
+		},
+		{
+			Name:  "API Management API Schema",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.ApiManagement/service/service1/apis/api1/schemas/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParseApiManagementApiSchemaID(input)
+			},
+		},
+		{
+			Name:  "API Management API Version Set",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.ApiManagement/service/service1/api-version-sets/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParseApiManagementApiVersionSetID(input)
+			},
+		},
+		{
+			Name:  "API Management Authorization Server",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.ApiManagement/service/service1/authorizationServers/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParseApiManagementAuthorizationServerID(input)
+			},
+		},
+		{
+			Name:  "API Management Certificate",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.ApiManagement/service/service1/certificates/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParseApiManagementCertificateID(input)
+			},
+		},
+		{
+			Name:  "API Management Group",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.ApiManagement/service/service1/groups/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParseApiManagementGroupID(input)
+			},
+		},
+		{
+			Name:  "API Management Group User",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.ApiManagement/service/service1/groups/group1/users/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParseApiManagementGroupUserID(input)
+			},
+		},
+		{
+			Name:  "API Management Logger",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.ApiManagement/service/service1/loggers/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParseApiManagementLoggerID(input)
+			},
+		},
+		{
+			Name:  "API Management OpenID Connect Provider",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.ApiManagement/service/service1/openidConnectProviders/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParseApiManagementOpenIDConnectProviderID(input)
+			},
+		},
+		{
+			Name:  "API Management Product",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.ApiManagement/service/service1/products/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParseApiManagementProductID(input)
+			},
+		},
+		{
+			Name:  "API Management Product API",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.ApiManagement/service/service1/products/product1/apis/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParseApiManagementProductApiID(input)
+			},
+		},
+		{
+			Name:  "API Management Product Group",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.ApiManagement/service/service1/products/product1/groups/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParseApiManagementProductGroupID(input)
+			},
+		},
+		{
+			Name:  "API Management Product Policy",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.ApiManagement/service/service1/products/product1/policies/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParseApiManagementProductPolicyID(input)
+			},
+		},
+		{
+			Name:  "API Management Property",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.ApiManagement/service/service1/properties/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParseApiManagementPropertyID(input)
+			},
+		},
+		{
+			Name:  "API Management Subscription",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.ApiManagement/service/service1/subscriptions/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParseApiManagementSubscriptionID(input)
+			},
+		},
+		{
+			Name:  "API Management User",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.ApiManagement/service/service1/users/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParseApiManagementUserID(input)
+			},
+		},
+	})
+}
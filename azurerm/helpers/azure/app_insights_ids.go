@@ -0,0 +1,94 @@
+package azure
+
+import (
+	"fmt"
+)
+
+// ApplicationInsightsID is a parsed Application Insights Resource ID
+type ApplicationInsightsID struct {
+	ResourceGroup string
+	Name          string
+}
+
+func (id ApplicationInsightsID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/microsoft.insights/components/%s", subscriptionId, id.ResourceGroup, id.Name)
+}
+
+func ParseApplicationInsightsID(input string) (*ApplicationInsightsID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.Insights", "Application Insights", "components")
+	if err != nil {
+		return nil, err
+	}
+
+	return &ApplicationInsightsID{
+		ResourceGroup: id.ResourceGroup,
+		Name:          segments[0],
+	}, nil
+}
+
+func ValidateApplicationInsightsID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseApplicationInsightsID(input)
+		return err
+	})
+}
+
+// ApplicationInsightsAPIKeyID is a parsed Application Insights API Key Resource ID
+type ApplicationInsightsAPIKeyID struct {
+	ResourceGroup           string
+	ApplicationInsightsName string
+	Name                    string
+}
+
+func (id ApplicationInsightsAPIKeyID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/microsoft.insights/components/%s/apikeys/%s", subscriptionId, id.ResourceGroup, id.ApplicationInsightsName, id.Name)
+}
+
+func ParseApplicationInsightsAPIKeyID(input string) (*ApplicationInsightsAPIKeyID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.Insights", "Application Insights API Key", "components", "apikeys")
+	if err != nil {
+		return nil, err
+	}
+
+	return &ApplicationInsightsAPIKeyID{
+		ResourceGroup:           id.ResourceGroup,
+		ApplicationInsightsName: segments[0],
+		Name:                    segments[1],
+	}, nil
+}
+
+func ValidateApplicationInsightsAPIKeyID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseApplicationInsightsAPIKeyID(input)
+		return err
+	})
+}
+
+// ApplicationInsightsWebTestID is a parsed Application Insights Web Test Resource ID
+type ApplicationInsightsWebTestID struct {
+	ResourceGroup string
+	Name          string
+}
+
+func (id ApplicationInsightsWebTestID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/microsoft.insights/webtests/%s", subscriptionId, id.ResourceGroup, id.Name)
+}
+
+func ParseApplicationInsightsWebTestID(input string) (*ApplicationInsightsWebTestID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.Insights", "Application Insights Web Test", "webtests")
+	if err != nil {
+		return nil, err
+	}
+
+	return &ApplicationInsightsWebTestID{
+		ResourceGroup: id.ResourceGroup,
+		Name:          segments[0],
+	}, nil
+}
+
+func ValidateApplicationInsightsWebTestID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseApplicationInsightsWebTestID(input)
+		return err
+	})
+}
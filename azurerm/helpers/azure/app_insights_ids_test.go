@@ -0,0 +1,31 @@
+package azure
+
+import (
+	"testing"
+)
+
+func TestAppInsightsResourceIDs(t *testing.T) {
+	testResourceIDs(t, []resourceIDTestCase{
+		{
+			Name:  "Application Insights",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/microsoft.insights/components/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParseApplicationInsightsID(input)
+			},
+		},
+		{
+			Name:  "Application Insights API Key",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/microsoft.insights/components/applicationinsights1/apikeys/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParseApplicationInsightsAPIKeyID(input)
+			},
+		},
+		{
+			Name:  "Application Insights Web Test",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/microsoft.insights/webtests/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParseApplicationInsightsWebTestID(input)
+			},
+		},
+	})
+}
@@ -0,0 +1,125 @@
+package azure
+
+import (
+	"fmt"
+)
+
+// AppServiceID is a parsed App Service Resource ID
+type AppServiceID struct {
+	ResourceGroup string
+	Name          string
+}
+
+func (id AppServiceID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Web/sites/%s", subscriptionId, id.ResourceGroup, id.Name)
+}
+
+func ParseAppServiceID(input string) (*AppServiceID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.Web", "App Service", "sites")
+	if err != nil {
+		return nil, err
+	}
+
+	return &AppServiceID{
+		ResourceGroup: id.ResourceGroup,
+		Name:          segments[0],
+	}, nil
+}
+
+func ValidateAppServiceID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseAppServiceID(input)
+		return err
+	})
+}
+
+// AppServiceSlotID is a parsed App Service Slot Resource ID
+type AppServiceSlotID struct {
+	ResourceGroup  string
+	AppServiceName string
+	Name           string
+}
+
+func (id AppServiceSlotID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Web/sites/%s/slots/%s", subscriptionId, id.ResourceGroup, id.AppServiceName, id.Name)
+}
+
+func ParseAppServiceSlotID(input string) (*AppServiceSlotID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.Web", "App Service Slot", "sites", "slots")
+	if err != nil {
+		return nil, err
+	}
+
+	return &AppServiceSlotID{
+		ResourceGroup:  id.ResourceGroup,
+		AppServiceName: segments[0],
+		Name:           segments[1],
+	}, nil
+}
+
+func ValidateAppServiceSlotID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseAppServiceSlotID(input)
+		return err
+	})
+}
+
+// AppServiceCustomHostnameBindingID is a parsed App Service Custom Hostname Binding Resource ID
+type AppServiceCustomHostnameBindingID struct {
+	ResourceGroup  string
+	AppServiceName string
+	Name           string
+}
+
+func (id AppServiceCustomHostnameBindingID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Web/sites/%s/hostNameBindings/%s", subscriptionId, id.ResourceGroup, id.AppServiceName, id.Name)
+}
+
+func ParseAppServiceCustomHostnameBindingID(input string) (*AppServiceCustomHostnameBindingID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.Web", "App Service Custom Hostname Binding", "sites", "hostNameBindings")
+	if err != nil {
+		return nil, err
+	}
+
+	return &AppServiceCustomHostnameBindingID{
+		ResourceGroup:  id.ResourceGroup,
+		AppServiceName: segments[0],
+		Name:           segments[1],
+	}, nil
+}
+
+func ValidateAppServiceCustomHostnameBindingID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseAppServiceCustomHostnameBindingID(input)
+		return err
+	})
+}
+
+// AppServicePlanID is a parsed App Service Plan Resource ID
+type AppServicePlanID struct {
+	ResourceGroup string
+	Name          string
+}
+
+func (id AppServicePlanID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Web/serverfarms/%s", subscriptionId, id.ResourceGroup, id.Name)
+}
+
+func ParseAppServicePlanID(input string) (*AppServicePlanID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.Web", "App Service Plan", "serverfarms")
+	if err != nil {
+		return nil, err
+	}
+
+	return &AppServicePlanID{
+		ResourceGroup: id.ResourceGroup,
+		Name:          segments[0],
+	}, nil
+}
+
+func ValidateAppServicePlanID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseAppServicePlanID(input)
+		return err
+	})
+}
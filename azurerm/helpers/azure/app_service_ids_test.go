@@ -0,0 +1,38 @@
+package azure
+
+import (
+	"testing"
+)
+
+func TestAppServiceResourceIDs(t *testing.T) {
+	testResourceIDs(t, []resourceIDTestCase{
+		{
+			Name:  "App Service",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Web/sites/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParseAppServiceID(input)
+			},
+		},
+		{
+			Name:  "App Service Slot",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Web/sites/appservice1/slots/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParseAppServiceSlotID(input)
+			},
+		},
+		{
+			Name:  "App Service Custom Hostname Binding",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Web/sites/appservice1/hostNameBindings/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParseAppServiceCustomHostnameBindingID(input)
+			},
+		},
+		{
+			Name:  "App Service Plan",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Web/serverfarms/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParseAppServicePlanID(input)
+			},
+		},
+	})
+}
@@ -0,0 +1,251 @@
+package azure
+
+import (
+	"fmt"
+)
+
+// AutomationAccountID is a parsed Automation Account Resource ID
+type AutomationAccountID struct {
+	ResourceGroup string
+	Name          string
+}
+
+func (id AutomationAccountID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Automation/automationAccounts/%s", subscriptionId, id.ResourceGroup, id.Name)
+}
+
+func ParseAutomationAccountID(input string) (*AutomationAccountID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.Automation", "Automation Account", "automationAccounts")
+	if err != nil {
+		return nil, err
+	}
+
+	return &AutomationAccountID{
+		ResourceGroup: id.ResourceGroup,
+		Name:          segments[0],
+	}, nil
+}
+
+func ValidateAutomationAccountID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseAutomationAccountID(input)
+		return err
+	})
+}
+
+// AutomationCredentialID is a parsed Automation Credential Resource ID
+type AutomationCredentialID struct {
+	ResourceGroup string
+	AccountName   string
+	Name          string
+}
+
+func (id AutomationCredentialID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Automation/automationAccounts/%s/credentials/%s", subscriptionId, id.ResourceGroup, id.AccountName, id.Name)
+}
+
+func ParseAutomationCredentialID(input string) (*AutomationCredentialID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.Automation", "Automation Credential", "automationAccounts", "credentials")
+	if err != nil {
+		return nil, err
+	}
+
+	return &AutomationCredentialID{
+		ResourceGroup: id.ResourceGroup,
+		AccountName:   segments[0],
+		Name:          segments[1],
+	}, nil
+}
+
+func ValidateAutomationCredentialID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseAutomationCredentialID(input)
+		return err
+	})
+}
+
+// AutomationDscConfigurationID is a parsed Automation DSC Configuration Resource ID
+type AutomationDscConfigurationID struct {
+	ResourceGroup string
+	AccountName   string
+	Name          string
+}
+
+func (id AutomationDscConfigurationID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Automation/automationAccounts/%s/configurations/%s", subscriptionId, id.ResourceGroup, id.AccountName, id.Name)
+}
+
+func ParseAutomationDscConfigurationID(input string) (*AutomationDscConfigurationID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.Automation", "Automation DSC Configuration", "automationAccounts", "configurations")
+	if err != nil {
+		return nil, err
+	}
+
+	return &AutomationDscConfigurationID{
+		ResourceGroup: id.ResourceGroup,
+		AccountName:   segments[0],
+		Name:          segments[1],
+	}, nil
+}
+
+func ValidateAutomationDscConfigurationID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseAutomationDscConfigurationID(input)
+		return err
+	})
+}
+
+// AutomationDscNodeConfigurationID is a parsed Automation DSC Node Configuration Resource ID
+type AutomationDscNodeConfigurationID struct {
+	ResourceGroup string
+	AccountName   string
+	Name          string
+}
+
+func (id AutomationDscNodeConfigurationID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Automation/automationAccounts/%s/nodeConfigurations/%s", subscriptionId, id.ResourceGroup, id.AccountName, id.Name)
+}
+
+func ParseAutomationDscNodeConfigurationID(input string) (*AutomationDscNodeConfigurationID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.Automation", "Automation DSC Node Configuration", "automationAccounts", "nodeConfigurations")
+	if err != nil {
+		return nil, err
+	}
+
+	return &AutomationDscNodeConfigurationID{
+		ResourceGroup: id.ResourceGroup,
+		AccountName:   segments[0],
+		Name:          segments[1],
+	}, nil
+}
+
+func ValidateAutomationDscNodeConfigurationID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseAutomationDscNodeConfigurationID(input)
+		return err
+	})
+}
+
+// AutomationModuleID is a parsed Automation Module Resource ID
+type AutomationModuleID struct {
+	ResourceGroup string
+	AccountName   string
+	Name          string
+}
+
+func (id AutomationModuleID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Automation/automationAccounts/%s/modules/%s", subscriptionId, id.ResourceGroup, id.AccountName, id.Name)
+}
+
+func ParseAutomationModuleID(input string) (*AutomationModuleID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.Automation", "Automation Module", "automationAccounts", "modules")
+	if err != nil {
+		return nil, err
+	}
+
+	return &AutomationModuleID{
+		ResourceGroup: id.ResourceGroup,
+		AccountName:   segments[0],
+		Name:          segments[1],
+	}, nil
+}
+
+func ValidateAutomationModuleID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseAutomationModuleID(input)
+		return err
+	})
+}
+
+// AutomationRunbookID is a parsed Automation Runbook Resource ID
+type AutomationRunbookID struct {
+	ResourceGroup string
+	AccountName   string
+	Name          string
+}
+
+func (id AutomationRunbookID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Automation/automationAccounts/%s/runbooks/%s", subscriptionId, id.ResourceGroup, id.AccountName, id.Name)
+}
+
+func ParseAutomationRunbookID(input string) (*AutomationRunbookID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.Automation", "Automation Runbook", "automationAccounts", "runbooks")
+	if err != nil {
+		return nil, err
+	}
+
+	return &AutomationRunbookID{
+		ResourceGroup: id.ResourceGroup,
+		AccountName:   segments[0],
+		Name:          segments[1],
+	}, nil
+}
+
+func ValidateAutomationRunbookID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseAutomationRunbookID(input)
+		return err
+	})
+}
+
+// AutomationScheduleID is a parsed Automation Schedule Resource ID
+type AutomationScheduleID struct {
+	ResourceGroup string
+	AccountName   string
+	Name          string
+}
+
+func (id AutomationScheduleID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Automation/automationAccounts/%s/schedules/%s", subscriptionId, id.ResourceGroup, id.AccountName, id.Name)
+}
+
+func ParseAutomationScheduleID(input string) (*AutomationScheduleID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.Automation", "Automation Schedule", "automationAccounts", "schedules")
+	if err != nil {
+		return nil, err
+	}
+
+	return &AutomationScheduleID{
+		ResourceGroup: id.ResourceGroup,
+		AccountName:   segments[0],
+		Name:          segments[1],
+	}, nil
+}
+
+func ValidateAutomationScheduleID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseAutomationScheduleID(input)
+		return err
+	})
+}
+
+// AutomationVariableID is a parsed Automation Variable Resource ID
+type AutomationVariableID struct {
+	ResourceGroup string
+	AccountName   string
+	Name          string
+}
+
+func (id AutomationVariableID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Automation/automationAccounts/%s/variables/%s", subscriptionId, id.ResourceGroup, id.AccountName, id.Name)
+}
+
+func ParseAutomationVariableID(input string) (*AutomationVariableID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.Automation", "Automation Variable", "automationAccounts", "variables")
+	if err != nil {
+		return nil, err
+	}
+
+	return &AutomationVariableID{
+		ResourceGroup: id.ResourceGroup,
+		AccountName:   segments[0],
+		Name:          segments[1],
+	}, nil
+}
+
+func ValidateAutomationVariableID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseAutomationVariableID(input)
+		return err
+	})
+}
@@ -0,0 +1,66 @@
+package azure
+
+import (
+	"testing"
+)
+
+func TestAutomationResourceIDs(t *testing.T) {
+	testResourceIDs(t, []resourceIDTestCase{
+		{
+			Name:  "Automation Account",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Automation/automationAccounts/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParseAutomationAccountID(input)
+			},
+		},
+		{
+			Name:  "Automation Credential",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Automation/automationAccounts/account1/credentials/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParseAutomationCredentialID(input)
+			},
+		},
+		{
+			Name:  "Automation DSC Configuration",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Automation/automationAccounts/account1/configurations/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParseAutomationDscConfigurationID(input)
+			},
+		},
+		{
+			Name:  "Automation DSC Node Configuration",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Automation/automationAccounts/account1/nodeConfigurations/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParseAutomationDscNodeConfigurationID(input)
+			},
+		},
+		{
+			Name:  "Automation Module",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Automation/automationAccounts/account1/modules/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParseAutomationModuleID(input)
+			},
+		},
+		{
+			Name:  "Automation Runbook",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Automation/automationAccounts/account1/runbooks/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParseAutomationRunbookID(input)
+			},
+		},
+		{
+			Name:  "Automation Schedule",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Automation/automationAccounts/account1/schedules/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParseAutomationScheduleID(input)
+			},
+		},
+		{
+			Name:  "Automation Variable",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Automation/automationAccounts/account1/variables/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParseAutomationVariableID(input)
+			},
+		},
+	})
+}
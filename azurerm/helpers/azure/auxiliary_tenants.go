@@ -0,0 +1,58 @@
+package azure
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+// AuxiliaryTenantsHeader is the header used to send tokens for additional tenants, which allows
+// Resource Manager to perform operations spanning multiple tenants (such as Virtual Network Peerings)
+const AuxiliaryTenantsHeader = "x-ms-authorization-auxiliary"
+
+// AuxiliaryTenantsMax is the maximum number of auxiliary tenants supported by Resource Manager
+const AuxiliaryTenantsMax = 3
+
+type auxiliaryTenantsAuthorizer struct {
+	primary   autorest.Authorizer
+	auxiliary []autorest.Authorizer
+}
+
+// NewAuxiliaryTenantsAuthorizer returns an Authorizer which authorizes requests using the primary Authorizer
+// and sends the tokens from each of the auxiliary Authorizers in the `x-ms-authorization-auxiliary` header
+func NewAuxiliaryTenantsAuthorizer(primary autorest.Authorizer, auxiliary []autorest.Authorizer) autorest.Authorizer {
+	return auxiliaryTenantsAuthorizer{
+		primary:   primary,
+		auxiliary: auxiliary,
+	}
+}
+
+func (a auxiliaryTenantsAuthorizer) WithAuthorization() autorest.PrepareDecorator {
+	return func(p autorest.Preparer) autorest.Preparer {
+		return autorest.PreparerFunc(func(r *http.Request) (*http.Request, error) {
+			r, err := a.primary.WithAuthorization()(p).Prepare(r)
+			if err != nil {
+				return r, err
+			}
+
+			tokens := make([]string, 0, len(a.auxiliary))
+			for _, auxiliary := range a.auxiliary {
+				// the token is retrieved (and refreshed where needed) by authorizing an empty request
+				scratch, err := autorest.Prepare((&http.Request{Header: make(http.Header)}).WithContext(r.Context()), auxiliary.WithAuthorization())
+				if err != nil {
+					return r, fmt.Errorf("Error retrieving token for auxiliary tenant: %+v", err)
+				}
+
+				tokens = append(tokens, scratch.Header.Get("Authorization"))
+			}
+
+			if len(tokens) == 0 {
+				return r, nil
+			}
+
+			return autorest.Prepare(r, autorest.WithHeader(AuxiliaryTenantsHeader, strings.Join(tokens, ", ")))
+		})
+	}
+}
@@ -0,0 +1,56 @@
+package azure
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/adal"
+)
+
+func TestAuxiliaryTenantsAuthorizer(t *testing.T) {
+	cases := []struct {
+		Name          string
+		Auxiliary     []string
+		ExpectedValue string
+	}{
+		{
+			Name:          "No Auxiliary Tenants",
+			Auxiliary:     []string{},
+			ExpectedValue: "",
+		},
+		{
+			Name:          "Single Auxiliary Tenant",
+			Auxiliary:     []string{"first"},
+			ExpectedValue: "Bearer first",
+		},
+		{
+			Name:          "Multiple Auxiliary Tenants",
+			Auxiliary:     []string{"first", "second"},
+			ExpectedValue: "Bearer first, Bearer second",
+		},
+	}
+
+	for _, v := range cases {
+		t.Run(v.Name, func(t *testing.T) {
+			auxiliary := make([]autorest.Authorizer, 0)
+			for _, token := range v.Auxiliary {
+				auxiliary = append(auxiliary, autorest.NewBearerAuthorizer(&adal.Token{AccessToken: token}))
+			}
+			authorizer := NewAuxiliaryTenantsAuthorizer(autorest.NewBearerAuthorizer(&adal.Token{AccessToken: "primary"}), auxiliary)
+
+			req, err := autorest.Prepare(&http.Request{Header: make(http.Header)}, authorizer.WithAuthorization())
+			if err != nil {
+				t.Fatalf("Expected no error but got: %+v", err)
+			}
+
+			if actual := req.Header.Get("Authorization"); actual != "Bearer primary" {
+				t.Fatalf("Expected the Authorization header to be %q but got %q", "Bearer primary", actual)
+			}
+
+			if actual := req.Header.Get(AuxiliaryTenantsHeader); actual != v.ExpectedValue {
+				t.Fatalf("Expected the %s header to be %q but got %q", AuxiliaryTenantsHeader, v.ExpectedValue, actual)
+			}
+		})
+	}
+}
@@ -0,0 +1,96 @@
+package azure
+
+import (
+	"fmt"
+)
+
+// BatchAccountID is a parsed Batch Account Resource ID
+type BatchAccountID struct {
+	ResourceGroup string
+	Name          string
+}
+
+func (id BatchAccountID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Batch/batchAccounts/%s", subscriptionId, id.ResourceGroup, id.Name)
+}
+
+func ParseBatchAccountID(input string) (*BatchAccountID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.Batch", "Batch Account", "batchAccounts")
+	if err != nil {
+		return nil, err
+	}
+
+	return &BatchAccountID{
+		ResourceGroup: id.ResourceGroup,
+		Name:          segments[0],
+	}, nil
+}
+
+func ValidateBatchAccountID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseBatchAccountID(input)
+		return err
+	})
+}
+
+// BatchCertificateID is a parsed Batch Certificate Resource ID
+type BatchCertificateID struct {
+	ResourceGroup string
+	AccountName   string
+	Name          string
+}
+
+func (id BatchCertificateID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Batch/batchAccounts/%s/certificates/%s", subscriptionId, id.ResourceGroup, id.AccountName, id.Name)
+}
+
+func ParseBatchCertificateID(input string) (*BatchCertificateID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.Batch", "Batch Certificate", "batchAccounts", "certificates")
+	if err != nil {
+		return nil, err
+	}
+
+	return &BatchCertificateID{
+		ResourceGroup: id.ResourceGroup,
+		AccountName:   segments[0],
+		Name:          segments[1],
+	}, nil
+}
+
+func ValidateBatchCertificateID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseBatchCertificateID(input)
+		return err
+	})
+}
+
+// BatchPoolID is a parsed Batch Pool Resource ID
+type BatchPoolID struct {
+	ResourceGroup string
+	AccountName   string
+	Name          string
+}
+
+func (id BatchPoolID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Batch/batchAccounts/%s/pools/%s", subscriptionId, id.ResourceGroup, id.AccountName, id.Name)
+}
+
+func ParseBatchPoolID(input string) (*BatchPoolID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.Batch", "Batch Pool", "batchAccounts", "pools")
+	if err != nil {
+		return nil, err
+	}
+
+	return &BatchPoolID{
+		ResourceGroup: id.ResourceGroup,
+		AccountName:   segments[0],
+		Name:          segments[1],
+	}, nil
+}
+
+func ValidateBatchPoolID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseBatchPoolID(input)
+		return err
+	})
+}
@@ -0,0 +1,31 @@
+package azure
+
+import (
+	"testing"
+)
+
+func TestBatchResourceIDs(t *testing.T) {
+	testResourceIDs(t, []resourceIDTestCase{
+		{
+			Name:  "Batch Account",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Batch/batchAccounts/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParseBatchAccountID(input)
+			},
+		},
+		{
+			Name:  "Batch Certificate",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Batch/batchAccounts/account1/certificates/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParseBatchCertificateID(input)
+			},
+		},
+		{
+			Name:  "Batch Pool",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Batch/batchAccounts/account1/pools/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParseBatchPoolID(input)
+			},
+		},
+	})
+}
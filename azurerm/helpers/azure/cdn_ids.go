@@ -0,0 +1,65 @@
+package azure
+
+import (
+	"fmt"
+)
+
+// CdnProfileID is a parsed CDN Profile Resource ID
+type CdnProfileID struct {
+	ResourceGroup string
+	Name          string
+}
+
+func (id CdnProfileID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Cdn/profiles/%s", subscriptionId, id.ResourceGroup, id.Name)
+}
+
+func ParseCdnProfileID(input string) (*CdnProfileID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.Cdn", "CDN Profile", "profiles")
+	if err != nil {
+		return nil, err
+	}
+
+	return &CdnProfileID{
+		ResourceGroup: id.ResourceGroup,
+		Name:          segments[0],
+	}, nil
+}
+
+func ValidateCdnProfileID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseCdnProfileID(input)
+		return err
+	})
+}
+
+// CdnEndpointID is a parsed CDN Endpoint Resource ID
+type CdnEndpointID struct {
+	ResourceGroup string
+	ProfileName   string
+	Name          string
+}
+
+func (id CdnEndpointID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Cdn/profiles/%s/endpoints/%s", subscriptionId, id.ResourceGroup, id.ProfileName, id.Name)
+}
+
+func ParseCdnEndpointID(input string) (*CdnEndpointID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.Cdn", "CDN Endpoint", "profiles", "endpoints")
+	if err != nil {
+		return nil, err
+	}
+
+	return &CdnEndpointID{
+		ResourceGroup: id.ResourceGroup,
+		ProfileName:   segments[0],
+		Name:          segments[1],
+	}, nil
+}
+
+func ValidateCdnEndpointID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseCdnEndpointID(input)
+		return err
+	})
+}
@@ -0,0 +1,24 @@
+package azure
+
+import (
+	"testing"
+)
+
+func TestCdnResourceIDs(t *testing.T) {
+	testResourceIDs(t, []resourceIDTestCase{
+		{
+			Name:  "CDN Profile",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Cdn/profiles/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParseCdnProfileID(input)
+			},
+		},
+		{
+			Name:  "CDN Endpoint",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Cdn/profiles/profile1/endpoints/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParseCdnEndpointID(input)
+			},
+		},
+	})
+}
@@ -0,0 +1,34 @@
+package azure
+
+import (
+	"fmt"
+)
+
+// CognitiveAccountID is a parsed Cognitive Services Account Resource ID
+type CognitiveAccountID struct {
+	ResourceGroup string
+	Name          string
+}
+
+func (id CognitiveAccountID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.CognitiveServices/accounts/%s", subscriptionId, id.ResourceGroup, id.Name)
+}
+
+func ParseCognitiveAccountID(input string) (*CognitiveAccountID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.CognitiveServices", "Cognitive Services Account", "accounts")
+	if err != nil {
+		return nil, err
+	}
+
+	return &CognitiveAccountID{
+		ResourceGroup: id.ResourceGroup,
+		Name:          segments[0],
+	}, nil
+}
+
+func ValidateCognitiveAccountID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseCognitiveAccountID(input)
+		return err
+	})
+}
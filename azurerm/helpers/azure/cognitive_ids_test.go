@@ -0,0 +1,17 @@
+package azure
+
+import (
+	"testing"
+)
+
+func TestCognitiveResourceIDs(t *testing.T) {
+	testResourceIDs(t, []resourceIDTestCase{
+		{
+			Name:  "Cognitive Services Account",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.CognitiveServices/accounts/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParseCognitiveAccountID(input)
+			},
+		},
+	})
+}
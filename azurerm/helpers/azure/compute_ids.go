@@ -0,0 +1,365 @@
+package azure
+
+import (
+	"fmt"
+)
+
+// AvailabilitySetID is a parsed Availability Set Resource ID
+type AvailabilitySetID struct {
+	ResourceGroup string
+	Name          string
+}
+
+func (id AvailabilitySetID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Compute/availabilitySets/%s", subscriptionId, id.ResourceGroup, id.Name)
+}
+
+func ParseAvailabilitySetID(input string) (*AvailabilitySetID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.Compute", "Availability Set", "availabilitySets")
+	if err != nil {
+		return nil, err
+	}
+
+	return &AvailabilitySetID{
+		ResourceGroup: id.ResourceGroup,
+		Name:          segments[0],
+	}, nil
+}
+
+func ValidateAvailabilitySetID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseAvailabilitySetID(input)
+		return err
+	})
+}
+
+// ImageID is a parsed Image Resource ID
+type ImageID struct {
+	ResourceGroup string
+	Name          string
+}
+
+func (id ImageID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Compute/images/%s", subscriptionId, id.ResourceGroup, id.Name)
+}
+
+func ParseImageID(input string) (*ImageID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.Compute", "Image", "images")
+	if err != nil {
+		return nil, err
+	}
+
+	return &ImageID{
+		ResourceGroup: id.ResourceGroup,
+		Name:          segments[0],
+	}, nil
+}
+
+func ValidateImageID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseImageID(input)
+		return err
+	})
+}
+
+// ManagedDiskID is a parsed Managed Disk Resource ID
+type ManagedDiskID struct {
+	ResourceGroup string
+	Name          string
+}
+
+func (id ManagedDiskID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Compute/disks/%s", subscriptionId, id.ResourceGroup, id.Name)
+}
+
+func ParseManagedDiskID(input string) (*ManagedDiskID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.Compute", "Managed Disk", "disks")
+	if err != nil {
+		return nil, err
+	}
+
+	return &ManagedDiskID{
+		ResourceGroup: id.ResourceGroup,
+		Name:          segments[0],
+	}, nil
+}
+
+func ValidateManagedDiskID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseManagedDiskID(input)
+		return err
+	})
+}
+
+// SharedImageGalleryID is a parsed Shared Image Gallery Resource ID
+type SharedImageGalleryID struct {
+	ResourceGroup string
+	Name          string
+}
+
+func (id SharedImageGalleryID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Compute/galleries/%s", subscriptionId, id.ResourceGroup, id.Name)
+}
+
+func ParseSharedImageGalleryID(input string) (*SharedImageGalleryID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.Compute", "Shared Image Gallery", "galleries")
+	if err != nil {
+		return nil, err
+	}
+
+	return &SharedImageGalleryID{
+		ResourceGroup: id.ResourceGroup,
+		Name:          segments[0],
+	}, nil
+}
+
+func ValidateSharedImageGalleryID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseSharedImageGalleryID(input)
+		return err
+	})
+}
+
+// SharedImageID is a parsed Shared Image Resource ID
+type SharedImageID struct {
+	ResourceGroup string
+	GalleryName   string
+	Name          string
+}
+
+func (id SharedImageID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Compute/galleries/%s/images/%s", subscriptionId, id.ResourceGroup, id.GalleryName, id.Name)
+}
+
+func ParseSharedImageID(input string) (*SharedImageID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.Compute", "Shared Image", "galleries", "images")
+	if err != nil {
+		return nil, err
+	}
+
+	return &SharedImageID{
+		ResourceGroup: id.ResourceGroup,
+		GalleryName:   segments[0],
+		Name:          segments[1],
+	}, nil
+}
+
+func ValidateSharedImageID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseSharedImageID(input)
+		return err
+	})
+}
+
+// SharedImageVersionID is a parsed Shared Image Version Resource ID
+type SharedImageVersionID struct {
+	ResourceGroup string
+	GalleryName   string
+	ImageName     string
+	Name          string
+}
+
+func (id SharedImageVersionID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Compute/galleries/%s/images/%s/versions/%s", subscriptionId, id.ResourceGroup, id.GalleryName, id.ImageName, id.Name)
+}
+
+func ParseSharedImageVersionID(input string) (*SharedImageVersionID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.Compute", "Shared Image Version", "galleries", "images", "versions")
+	if err != nil {
+		return nil, err
+	}
+
+	return &SharedImageVersionID{
+		ResourceGroup: id.ResourceGroup,
+		GalleryName:   segments[0],
+		ImageName:     segments[1],
+		Name:          segments[2],
+	}, nil
+}
+
+func ValidateSharedImageVersionID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseSharedImageVersionID(input)
+		return err
+	})
+}
+
+// SnapshotID is a parsed Snapshot Resource ID
+type SnapshotID struct {
+	ResourceGroup string
+	Name          string
+}
+
+func (id SnapshotID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Compute/snapshots/%s", subscriptionId, id.ResourceGroup, id.Name)
+}
+
+func ParseSnapshotID(input string) (*SnapshotID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.Compute", "Snapshot", "snapshots")
+	if err != nil {
+		return nil, err
+	}
+
+	return &SnapshotID{
+		ResourceGroup: id.ResourceGroup,
+		Name:          segments[0],
+	}, nil
+}
+
+func ValidateSnapshotID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseSnapshotID(input)
+		return err
+	})
+}
+
+// VirtualMachineID is a parsed Virtual Machine Resource ID
+type VirtualMachineID struct {
+	ResourceGroup string
+	Name          string
+}
+
+func (id VirtualMachineID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Compute/virtualMachines/%s", subscriptionId, id.ResourceGroup, id.Name)
+}
+
+func ParseVirtualMachineID(input string) (*VirtualMachineID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.Compute", "Virtual Machine", "virtualMachines")
+	if err != nil {
+		return nil, err
+	}
+
+	return &VirtualMachineID{
+		ResourceGroup: id.ResourceGroup,
+		Name:          segments[0],
+	}, nil
+}
+
+func ValidateVirtualMachineID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseVirtualMachineID(input)
+		return err
+	})
+}
+
+// VirtualMachineDataDiskAttachmentID is a parsed Virtual Machine Data Disk Attachment Resource ID
+type VirtualMachineDataDiskAttachmentID struct {
+	ResourceGroup      string
+	VirtualMachineName string
+	Name               string
+}
+
+func (id VirtualMachineDataDiskAttachmentID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Compute/virtualMachines/%s/dataDisks/%s", subscriptionId, id.ResourceGroup, id.VirtualMachineName, id.Name)
+}
+
+func ParseVirtualMachineDataDiskAttachmentID(input string) (*VirtualMachineDataDiskAttachmentID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.Compute", "Virtual Machine Data Disk Attachment", "virtualMachines", "dataDisks")
+	if err != nil {
+		return nil, err
+	}
+
+	return &VirtualMachineDataDiskAttachmentID{
+		ResourceGroup:      id.ResourceGroup,
+		VirtualMachineName: segments[0],
+		Name:               segments[1],
+	}, nil
+}
+
+func ValidateVirtualMachineDataDiskAttachmentID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseVirtualMachineDataDiskAttachmentID(input)
+		return err
+	})
+}
+
+// VirtualMachineExtensionID is a parsed Virtual Machine Extension Resource ID
+type VirtualMachineExtensionID struct {
+	ResourceGroup      string
+	VirtualMachineName string
+	Name               string
+}
+
+func (id VirtualMachineExtensionID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Compute/virtualMachines/%s/extensions/%s", subscriptionId, id.ResourceGroup, id.VirtualMachineName, id.Name)
+}
+
+func ParseVirtualMachineExtensionID(input string) (*VirtualMachineExtensionID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.Compute", "Virtual Machine Extension", "virtualMachines", "extensions")
+	if err != nil {
+		return nil, err
+	}
+
+	return &VirtualMachineExtensionID{
+		ResourceGroup:      id.ResourceGroup,
+		VirtualMachineName: segments[0],
+		Name:               segments[1],
+	}, nil
+}
+
+func ValidateVirtualMachineExtensionID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseVirtualMachineExtensionID(input)
+		return err
+	})
+}
+
+// VirtualMachineRunCommandID is a parsed Virtual Machine Run Command Resource ID
+type VirtualMachineRunCommandID struct {
+	ResourceGroup      string
+	VirtualMachineName string
+	Name               string
+}
+
+func (id VirtualMachineRunCommandID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Compute/virtualMachines/%s/runCommands/%s", subscriptionId, id.ResourceGroup, id.VirtualMachineName, id.Name)
+}
+
+func ParseVirtualMachineRunCommandID(input string) (*VirtualMachineRunCommandID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.Compute", "Virtual Machine Run Command", "virtualMachines", "runCommands")
+	if err != nil {
+		return nil, err
+	}
+
+	return &VirtualMachineRunCommandID{
+		ResourceGroup:      id.ResourceGroup,
+		VirtualMachineName: segments[0],
+		Name:               segments[1],
+	}, nil
+}
+
+func ValidateVirtualMachineRunCommandID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseVirtualMachineRunCommandID(input)
+		return err
+	})
+}
+
+// VirtualMachineScaleSetID is a parsed Virtual Machine Scale Set Resource ID
+type VirtualMachineScaleSetID struct {
+	ResourceGroup string
+	Name          string
+}
+
+func (id VirtualMachineScaleSetID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Compute/virtualMachineScaleSets/%s", subscriptionId, id.ResourceGroup, id.Name)
+}
+
+func ParseVirtualMachineScaleSetID(input string) (*VirtualMachineScaleSetID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.Compute", "Virtual Machine Scale Set", "virtualMachineScaleSets")
+	if err != nil {
+		return nil, err
+	}
+
+	return &VirtualMachineScaleSetID{
+		ResourceGroup: id.ResourceGroup,
+		Name:          segments[0],
+	}, nil
+}
+
+func ValidateVirtualMachineScaleSetID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseVirtualMachineScaleSetID(input)
+		return err
+	})
+}
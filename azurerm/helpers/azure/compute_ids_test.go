@@ -0,0 +1,94 @@
+package azure
+
+import (
+	"testing"
+)
+
+func TestComputeResourceIDs(t *testing.T) {
+	testResourceIDs(t, []resourceIDTestCase{
+		{
+			Name:  "Availability Set",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Compute/availabilitySets/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParseAvailabilitySetID(input)
+			},
+		},
+		{
+			Name:  "Image",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Compute/images/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParseImageID(input)
+			},
+		},
+		{
+			Name:  "Managed Disk",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Compute/disks/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParseManagedDiskID(input)
+			},
+		},
+		{
+			Name:  "Shared Image Gallery",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Compute/galleries/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParseSharedImageGalleryID(input)
+			},
+		},
+		{
+			Name:  "Shared Image",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Compute/galleries/gallery1/images/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParseSharedImageID(input)
+			},
+		},
+		{
+			Name:  "Shared Image Version",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Compute/galleries/gallery1/images/image1/versions/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParseSharedImageVersionID(input)
+			},
+		},
+		{
+			Name:  "Snapshot",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Compute/snapshots/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParseSnapshotID(input)
+			},
+		},
+		{
+			Name:  "Virtual Machine",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Compute/virtualMachines/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParseVirtualMachineID(input)
+			},
+		},
+		{
+			Name:  "Virtual Machine Data Disk Attachment",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Compute/virtualMachines/virtualmachine1/dataDisks/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParseVirtualMachineDataDiskAttachmentID(input)
+			},
+		},
+		{
+			Name:  "Virtual Machine Extension",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Compute/virtualMachines/virtualmachine1/extensions/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParseVirtualMachineExtensionID(input)
+			},
+		},
+		{
+			Name:  "Virtual Machine Run Command",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Compute/virtualMachines/virtualmachine1/runCommands/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParseVirtualMachineRunCommandID(input)
+			},
+		},
+		{
+			Name:  "Virtual Machine Scale Set",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Compute/virtualMachineScaleSets/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParseVirtualMachineScaleSetID(input)
+			},
+		},
+	})
+}
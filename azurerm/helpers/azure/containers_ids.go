@@ -0,0 +1,121 @@
+package azure
+
+import (
+	"fmt"
+)
+
+// ContainerGroupID is a parsed Container Group Resource ID
+type ContainerGroupID struct {
+	ResourceGroup string
+	Name          string
+}
+
+func (id ContainerGroupID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.ContainerInstance/containerGroups/%s", subscriptionId, id.ResourceGroup, id.Name)
+}
+
+func ParseContainerGroupID(input string) (*ContainerGroupID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.ContainerInstance", "Container Group", "containerGroups")
+	if err != nil {
+		return nil, err
+	}
+
+	return &ContainerGroupID{
+		ResourceGroup: id.ResourceGroup,
+		Name:          segments[0],
+	}, nil
+}
+
+func ValidateContainerGroupID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseContainerGroupID(input)
+		return err
+	})
+}
+
+// ContainerRegistryID is a parsed Container Registry Resource ID
+type ContainerRegistryID struct {
+	ResourceGroup string
+	Name          string
+}
+
+func (id ContainerRegistryID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.ContainerRegistry/registries/%s", subscriptionId, id.ResourceGroup, id.Name)
+}
+
+func ParseContainerRegistryID(input string) (*ContainerRegistryID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.ContainerRegistry", "Container Registry", "registries")
+	if err != nil {
+		return nil, err
+	}
+
+	return &ContainerRegistryID{
+		ResourceGroup: id.ResourceGroup,
+		Name:          segments[0],
+	}, nil
+}
+
+func ValidateContainerRegistryID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseContainerRegistryID(input)
+		return err
+	})
+}
+
+// ContainerServiceID is a parsed Container Service Resource ID
+type ContainerServiceID struct {
+	ResourceGroup string
+	Name          string
+}
+
+func (id ContainerServiceID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.ContainerService/containerServices/%s", subscriptionId, id.ResourceGroup, id.Name)
+}
+
+func ParseContainerServiceID(input string) (*ContainerServiceID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.ContainerService", "Container Service", "containerServices")
+	if err != nil {
+		return nil, err
+	}
+
+	return &ContainerServiceID{
+		ResourceGroup: id.ResourceGroup,
+		Name:          segments[0],
+	}, nil
+}
+
+func ValidateContainerServiceID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseContainerServiceID(input)
+		return err
+	})
+}
+
+// KubernetesClusterID is a parsed Kubernetes Cluster Resource ID
+type KubernetesClusterID struct {
+	ResourceGroup string
+	Name          string
+}
+
+func (id KubernetesClusterID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.ContainerService/managedClusters/%s", subscriptionId, id.ResourceGroup, id.Name)
+}
+
+func ParseKubernetesClusterID(input string) (*KubernetesClusterID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.ContainerService", "Kubernetes Cluster", "managedClusters")
+	if err != nil {
+		return nil, err
+	}
+
+	return &KubernetesClusterID{
+		ResourceGroup: id.ResourceGroup,
+		Name:          segments[0],
+	}, nil
+}
+
+func ValidateKubernetesClusterID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseKubernetesClusterID(input)
+		return err
+	})
+}
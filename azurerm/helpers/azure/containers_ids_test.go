@@ -0,0 +1,38 @@
+package azure
+
+import (
+	"testing"
+)
+
+func TestContainersResourceIDs(t *testing.T) {
+	testResourceIDs(t, []resourceIDTestCase{
+		{
+			Name:  "Container Group",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.ContainerInstance/containerGroups/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParseContainerGroupID(input)
+			},
+		},
+		{
+			Name:  "Container Registry",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.ContainerRegistry/registries/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParseContainerRegistryID(input)
+			},
+		},
+		{
+			Name:  "Container Service",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.ContainerService/containerServices/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParseContainerServiceID(input)
+			},
+		},
+		{
+			Name:  "Kubernetes Cluster",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.ContainerService/managedClusters/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParseKubernetesClusterID(input)
+			},
+		},
+	})
+}
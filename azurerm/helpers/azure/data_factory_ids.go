@@ -0,0 +1,127 @@
+package azure
+
+import (
+	"fmt"
+)
+
+// DataFactoryID is a parsed Data Factory Resource ID
+type DataFactoryID struct {
+	ResourceGroup string
+	Name          string
+}
+
+func (id DataFactoryID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.DataFactory/factories/%s", subscriptionId, id.ResourceGroup, id.Name)
+}
+
+func ParseDataFactoryID(input string) (*DataFactoryID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.DataFactory", "Data Factory", "factories")
+	if err != nil {
+		return nil, err
+	}
+
+	return &DataFactoryID{
+		ResourceGroup: id.ResourceGroup,
+		Name:          segments[0],
+	}, nil
+}
+
+func ValidateDataFactoryID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseDataFactoryID(input)
+		return err
+	})
+}
+
+// DataFactoryDatasetID is a parsed Data Factory Dataset Resource ID
+type DataFactoryDatasetID struct {
+	ResourceGroup   string
+	DataFactoryName string
+	Name            string
+}
+
+func (id DataFactoryDatasetID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.DataFactory/factories/%s/datasets/%s", subscriptionId, id.ResourceGroup, id.DataFactoryName, id.Name)
+}
+
+func ParseDataFactoryDatasetID(input string) (*DataFactoryDatasetID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.DataFactory", "Data Factory Dataset", "factories", "datasets")
+	if err != nil {
+		return nil, err
+	}
+
+	return &DataFactoryDatasetID{
+		ResourceGroup:   id.ResourceGroup,
+		DataFactoryName: segments[0],
+		Name:            segments[1],
+	}, nil
+}
+
+func ValidateDataFactoryDatasetID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseDataFactoryDatasetID(input)
+		return err
+	})
+}
+
+// DataFactoryLinkedServiceID is a parsed Data Factory Linked Service Resource ID
+type DataFactoryLinkedServiceID struct {
+	ResourceGroup   string
+	DataFactoryName string
+	Name            string
+}
+
+func (id DataFactoryLinkedServiceID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.DataFactory/factories/%s/linkedservices/%s", subscriptionId, id.ResourceGroup, id.DataFactoryName, id.Name)
+}
+
+func ParseDataFactoryLinkedServiceID(input string) (*DataFactoryLinkedServiceID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.DataFactory", "Data Factory Linked Service", "factories", "linkedservices")
+	if err != nil {
+		return nil, err
+	}
+
+	return &DataFactoryLinkedServiceID{
+		ResourceGroup:   id.ResourceGroup,
+		DataFactoryName: segments[0],
+		Name:            segments[1],
+	}, nil
+}
+
+func ValidateDataFactoryLinkedServiceID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseDataFactoryLinkedServiceID(input)
+		return err
+	})
+}
+
+// DataFactoryPipelineID is a parsed Data Factory Pipeline Resource ID
+type DataFactoryPipelineID struct {
+	ResourceGroup   string
+	DataFactoryName string
+	Name            string
+}
+
+func (id DataFactoryPipelineID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.DataFactory/factories/%s/pipelines/%s", subscriptionId, id.ResourceGroup, id.DataFactoryName, id.Name)
+}
+
+func ParseDataFactoryPipelineID(input string) (*DataFactoryPipelineID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.DataFactory", "Data Factory Pipeline", "factories", "pipelines")
+	if err != nil {
+		return nil, err
+	}
+
+	return &DataFactoryPipelineID{
+		ResourceGroup:   id.ResourceGroup,
+		DataFactoryName: segments[0],
+		Name:            segments[1],
+	}, nil
+}
+
+func ValidateDataFactoryPipelineID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseDataFactoryPipelineID(input)
+		return err
+	})
+}
@@ -0,0 +1,38 @@
+package azure
+
+import (
+	"testing"
+)
+
+func TestDataFactoryResourceIDs(t *testing.T) {
+	testResourceIDs(t, []resourceIDTestCase{
+		{
+			Name:  "Data Factory",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.DataFactory/factories/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParseDataFactoryID(input)
+			},
+		},
+		{
+			Name:  "Data Factory Dataset",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.DataFactory/factories/datafactory1/datasets/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParseDataFactoryDatasetID(input)
+			},
+		},
+		{
+			Name:  "Data Factory Linked Service",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.DataFactory/factories/datafactory1/linkedservices/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParseDataFactoryLinkedServiceID(input)
+			},
+		},
+		{
+			Name:  "Data Factory Pipeline",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.DataFactory/factories/datafactory1/pipelines/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParseDataFactoryPipelineID(input)
+			},
+		},
+	})
+}
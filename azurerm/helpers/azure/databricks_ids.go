@@ -0,0 +1,34 @@
+package azure
+
+import (
+	"fmt"
+)
+
+// DatabricksWorkspaceID is a parsed Databricks Workspace Resource ID
+type DatabricksWorkspaceID struct {
+	ResourceGroup string
+	Name          string
+}
+
+func (id DatabricksWorkspaceID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Databricks/workspaces/%s", subscriptionId, id.ResourceGroup, id.Name)
+}
+
+func ParseDatabricksWorkspaceID(input string) (*DatabricksWorkspaceID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.Databricks", "Databricks Workspace", "workspaces")
+	if err != nil {
+		return nil, err
+	}
+
+	return &DatabricksWorkspaceID{
+		ResourceGroup: id.ResourceGroup,
+		Name:          segments[0],
+	}, nil
+}
+
+func ValidateDatabricksWorkspaceID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseDatabricksWorkspaceID(input)
+		return err
+	})
+}
@@ -0,0 +1,17 @@
+package azure
+
+import (
+	"testing"
+)
+
+func TestDatabricksResourceIDs(t *testing.T) {
+	testResourceIDs(t, []resourceIDTestCase{
+		{
+			Name:  "Databricks Workspace",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Databricks/workspaces/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParseDatabricksWorkspaceID(input)
+			},
+		},
+	})
+}
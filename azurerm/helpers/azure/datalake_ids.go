@@ -0,0 +1,125 @@
+package azure
+
+import (
+	"fmt"
+)
+
+// DataLakeAnalyticsAccountID is a parsed Data Lake Analytics Account Resource ID
+type DataLakeAnalyticsAccountID struct {
+	ResourceGroup string
+	Name          string
+}
+
+func (id DataLakeAnalyticsAccountID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.DataLakeAnalytics/accounts/%s", subscriptionId, id.ResourceGroup, id.Name)
+}
+
+func ParseDataLakeAnalyticsAccountID(input string) (*DataLakeAnalyticsAccountID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.DataLakeAnalytics", "Data Lake Analytics Account", "accounts")
+	if err != nil {
+		return nil, err
+	}
+
+	return &DataLakeAnalyticsAccountID{
+		ResourceGroup: id.ResourceGroup,
+		Name:          segments[0],
+	}, nil
+}
+
+func ValidateDataLakeAnalyticsAccountID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseDataLakeAnalyticsAccountID(input)
+		return err
+	})
+}
+
+// DataLakeAnalyticsFirewallRuleID is a parsed Data Lake Analytics Firewall Rule Resource ID
+type DataLakeAnalyticsFirewallRuleID struct {
+	ResourceGroup string
+	AccountName   string
+	Name          string
+}
+
+func (id DataLakeAnalyticsFirewallRuleID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.DataLakeAnalytics/accounts/%s/firewallRules/%s", subscriptionId, id.ResourceGroup, id.AccountName, id.Name)
+}
+
+func ParseDataLakeAnalyticsFirewallRuleID(input string) (*DataLakeAnalyticsFirewallRuleID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.DataLakeAnalytics", "Data Lake Analytics Firewall Rule", "accounts", "firewallRules")
+	if err != nil {
+		return nil, err
+	}
+
+	return &DataLakeAnalyticsFirewallRuleID{
+		ResourceGroup: id.ResourceGroup,
+		AccountName:   segments[0],
+		Name:          segments[1],
+	}, nil
+}
+
+func ValidateDataLakeAnalyticsFirewallRuleID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseDataLakeAnalyticsFirewallRuleID(input)
+		return err
+	})
+}
+
+// DataLakeStoreID is a parsed Data Lake Store Resource ID
+type DataLakeStoreID struct {
+	ResourceGroup string
+	Name          string
+}
+
+func (id DataLakeStoreID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.DataLakeStore/accounts/%s", subscriptionId, id.ResourceGroup, id.Name)
+}
+
+func ParseDataLakeStoreID(input string) (*DataLakeStoreID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.DataLakeStore", "Data Lake Store", "accounts")
+	if err != nil {
+		return nil, err
+	}
+
+	return &DataLakeStoreID{
+		ResourceGroup: id.ResourceGroup,
+		Name:          segments[0],
+	}, nil
+}
+
+func ValidateDataLakeStoreID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseDataLakeStoreID(input)
+		return err
+	})
+}
+
+// DataLakeStoreFirewallRuleID is a parsed Data Lake Store Firewall Rule Resource ID
+type DataLakeStoreFirewallRuleID struct {
+	ResourceGroup string
+	AccountName   string
+	Name          string
+}
+
+func (id DataLakeStoreFirewallRuleID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.DataLakeStore/accounts/%s/firewallRules/%s", subscriptionId, id.ResourceGroup, id.AccountName, id.Name)
+}
+
+func ParseDataLakeStoreFirewallRuleID(input string) (*DataLakeStoreFirewallRuleID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.DataLakeStore", "Data Lake Store Firewall Rule", "accounts", "firewallRules")
+	if err != nil {
+		return nil, err
+	}
+
+	return &DataLakeStoreFirewallRuleID{
+		ResourceGroup: id.ResourceGroup,
+		AccountName:   segments[0],
+		Name:          segments[1],
+	}, nil
+}
+
+func ValidateDataLakeStoreFirewallRuleID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseDataLakeStoreFirewallRuleID(input)
+		return err
+	})
+}
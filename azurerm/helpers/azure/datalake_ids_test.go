@@ -0,0 +1,38 @@
+package azure
+
+import (
+	"testing"
+)
+
+func TestDatalakeResourceIDs(t *testing.T) {
+	testResourceIDs(t, []resourceIDTestCase{
+		{
+			Name:  "Data Lake Analytics Account",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.DataLakeAnalytics/accounts/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParseDataLakeAnalyticsAccountID(input)
+			},
+		},
+		{
+			Name:  "Data Lake Analytics Firewall Rule",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.DataLakeAnalytics/accounts/account1/firewallRules/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParseDataLakeAnalyticsFirewallRuleID(input)
+			},
+		},
+		{
+			Name:  "Data Lake Store",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.DataLakeStore/accounts/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParseDataLakeStoreID(input)
+			},
+		},
+		{
+			Name:  "Data Lake Store Firewall Rule",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.DataLakeStore/accounts/account1/firewallRules/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParseDataLakeStoreFirewallRuleID(input)
+			},
+		},
+	})
+}
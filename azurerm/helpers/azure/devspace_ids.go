@@ -0,0 +1,34 @@
+package azure
+
+import (
+	"fmt"
+)
+
+// DevSpaceControllerID is a parsed DevSpace Controller Resource ID
+type DevSpaceControllerID struct {
+	ResourceGroup string
+	Name          string
+}
+
+func (id DevSpaceControllerID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.DevSpaces/controllers/%s", subscriptionId, id.ResourceGroup, id.Name)
+}
+
+func ParseDevSpaceControllerID(input string) (*DevSpaceControllerID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.DevSpaces", "DevSpace Controller", "controllers")
+	if err != nil {
+		return nil, err
+	}
+
+	return &DevSpaceControllerID{
+		ResourceGroup: id.ResourceGroup,
+		Name:          segments[0],
+	}, nil
+}
+
+func ValidateDevSpaceControllerID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseDevSpaceControllerID(input)
+		return err
+	})
+}
@@ -0,0 +1,17 @@
+package azure
+
+import (
+	"testing"
+)
+
+func TestDevspaceResourceIDs(t *testing.T) {
+	testResourceIDs(t, []resourceIDTestCase{
+		{
+			Name:  "DevSpace Controller",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.DevSpaces/controllers/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParseDevSpaceControllerID(input)
+			},
+		},
+	})
+}
@@ -0,0 +1,129 @@
+package azure
+
+import (
+	"fmt"
+)
+
+// DevTestLabID is a parsed Dev Test Lab Resource ID
+type DevTestLabID struct {
+	ResourceGroup string
+	Name          string
+}
+
+func (id DevTestLabID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.DevTestLab/labs/%s", subscriptionId, id.ResourceGroup, id.Name)
+}
+
+func ParseDevTestLabID(input string) (*DevTestLabID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.DevTestLab", "Dev Test Lab", "labs")
+	if err != nil {
+		return nil, err
+	}
+
+	return &DevTestLabID{
+		ResourceGroup: id.ResourceGroup,
+		Name:          segments[0],
+	}, nil
+}
+
+func ValidateDevTestLabID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseDevTestLabID(input)
+		return err
+	})
+}
+
+// DevTestPolicyID is a parsed Dev Test Policy Resource ID
+type DevTestPolicyID struct {
+	ResourceGroup string
+	LabName       string
+	PolicySetName string
+	Name          string
+}
+
+func (id DevTestPolicyID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.DevTestLab/labs/%s/policysets/%s/policies/%s", subscriptionId, id.ResourceGroup, id.LabName, id.PolicySetName, id.Name)
+}
+
+func ParseDevTestPolicyID(input string) (*DevTestPolicyID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.DevTestLab", "Dev Test Policy", "labs", "policysets", "policies")
+	if err != nil {
+		return nil, err
+	}
+
+	return &DevTestPolicyID{
+		ResourceGroup: id.ResourceGroup,
+		LabName:       segments[0],
+		PolicySetName: segments[1],
+		Name:          segments[2],
+	}, nil
+}
+
+func ValidateDevTestPolicyID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseDevTestPolicyID(input)
+		return err
+	})
+}
+
+// DevTestVirtualMachineID is a parsed Dev Test Virtual Machine Resource ID
+type DevTestVirtualMachineID struct {
+	ResourceGroup string
+	LabName       string
+	Name          string
+}
+
+func (id DevTestVirtualMachineID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.DevTestLab/labs/%s/virtualmachines/%s", subscriptionId, id.ResourceGroup, id.LabName, id.Name)
+}
+
+func ParseDevTestVirtualMachineID(input string) (*DevTestVirtualMachineID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.DevTestLab", "Dev Test Virtual Machine", "labs", "virtualmachines")
+	if err != nil {
+		return nil, err
+	}
+
+	return &DevTestVirtualMachineID{
+		ResourceGroup: id.ResourceGroup,
+		LabName:       segments[0],
+		Name:          segments[1],
+	}, nil
+}
+
+func ValidateDevTestVirtualMachineID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseDevTestVirtualMachineID(input)
+		return err
+	})
+}
+
+// DevTestVirtualNetworkID is a parsed Dev Test Virtual Network Resource ID
+type DevTestVirtualNetworkID struct {
+	ResourceGroup string
+	LabName       string
+	Name          string
+}
+
+func (id DevTestVirtualNetworkID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.DevTestLab/labs/%s/virtualnetworks/%s", subscriptionId, id.ResourceGroup, id.LabName, id.Name)
+}
+
+func ParseDevTestVirtualNetworkID(input string) (*DevTestVirtualNetworkID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.DevTestLab", "Dev Test Virtual Network", "labs", "virtualnetworks")
+	if err != nil {
+		return nil, err
+	}
+
+	return &DevTestVirtualNetworkID{
+		ResourceGroup: id.ResourceGroup,
+		LabName:       segments[0],
+		Name:          segments[1],
+	}, nil
+}
+
+func ValidateDevTestVirtualNetworkID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseDevTestVirtualNetworkID(input)
+		return err
+	})
+}
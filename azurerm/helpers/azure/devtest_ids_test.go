@@ -0,0 +1,38 @@
+package azure
+
+import (
+	"testing"
+)
+
+func TestDevtestResourceIDs(t *testing.T) {
+	testResourceIDs(t, []resourceIDTestCase{
+		{
+			Name:  "Dev Test Lab",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.DevTestLab/labs/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParseDevTestLabID(input)
+			},
+		},
+		{
+			Name:  "Dev Test Policy",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.DevTestLab/labs/lab1/policysets/policyset1/policies/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParseDevTestPolicyID(input)
+			},
+		},
+		{
+			Name:  "Dev Test Virtual Machine",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.DevTestLab/labs/lab1/virtualmachines/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParseDevTestVirtualMachineID(input)
+			},
+		},
+		{
+			Name:  "Dev Test Virtual Network",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.DevTestLab/labs/lab1/virtualnetworks/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParseDevTestVirtualNetworkID(input)
+			},
+		},
+	})
+}
@@ -0,0 +1,313 @@
+package azure
+
+import (
+	"fmt"
+)
+
+// DnsZoneID is a parsed DNS Zone Resource ID
+type DnsZoneID struct {
+	ResourceGroup string
+	Name          string
+}
+
+func (id DnsZoneID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/dnszones/%s", subscriptionId, id.ResourceGroup, id.Name)
+}
+
+func ParseDnsZoneID(input string) (*DnsZoneID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.Network", "DNS Zone", "dnszones")
+	if err != nil {
+		return nil, err
+	}
+
+	return &DnsZoneID{
+		ResourceGroup: id.ResourceGroup,
+		Name:          segments[0],
+	}, nil
+}
+
+func ValidateDnsZoneID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseDnsZoneID(input)
+		return err
+	})
+}
+
+// DnsARecordID is a parsed DNS A Record Resource ID
+type DnsARecordID struct {
+	ResourceGroup string
+	ZoneName      string
+	Name          string
+}
+
+func (id DnsARecordID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/dnszones/%s/A/%s", subscriptionId, id.ResourceGroup, id.ZoneName, id.Name)
+}
+
+func ParseDnsARecordID(input string) (*DnsARecordID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.Network", "DNS A Record", "dnszones", "A")
+	if err != nil {
+		return nil, err
+	}
+
+	return &DnsARecordID{
+		ResourceGroup: id.ResourceGroup,
+		ZoneName:      segments[0],
+		Name:          segments[1],
+	}, nil
+}
+
+func ValidateDnsARecordID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseDnsARecordID(input)
+		return err
+	})
+}
+
+// DnsAaaaRecordID is a parsed DNS AAAA Record Resource ID
+type DnsAaaaRecordID struct {
+	ResourceGroup string
+	ZoneName      string
+	Name          string
+}
+
+func (id DnsAaaaRecordID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/dnszones/%s/AAAA/%s", subscriptionId, id.ResourceGroup, id.ZoneName, id.Name)
+}
+
+func ParseDnsAaaaRecordID(input string) (*DnsAaaaRecordID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.Network", "DNS AAAA Record", "dnszones", "AAAA")
+	if err != nil {
+		return nil, err
+	}
+
+	return &DnsAaaaRecordID{
+		ResourceGroup: id.ResourceGroup,
+		ZoneName:      segments[0],
+		Name:          segments[1],
+	}, nil
+}
+
+func ValidateDnsAaaaRecordID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseDnsAaaaRecordID(input)
+		return err
+	})
+}
+
+// DnsCaaRecordID is a parsed DNS CAA Record Resource ID
+type DnsCaaRecordID struct {
+	ResourceGroup string
+	ZoneName      string
+	Name          string
+}
+
+func (id DnsCaaRecordID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/dnszones/%s/CAA/%s", subscriptionId, id.ResourceGroup, id.ZoneName, id.Name)
+}
+
+func ParseDnsCaaRecordID(input string) (*DnsCaaRecordID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.Network", "DNS CAA Record", "dnszones", "CAA")
+	if err != nil {
+		return nil, err
+	}
+
+	return &DnsCaaRecordID{
+		ResourceGroup: id.ResourceGroup,
+		ZoneName:      segments[0],
+		Name:          segments[1],
+	}, nil
+}
+
+func ValidateDnsCaaRecordID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseDnsCaaRecordID(input)
+		return err
+	})
+}
+
+// DnsCnameRecordID is a parsed DNS CNAME Record Resource ID
+type DnsCnameRecordID struct {
+	ResourceGroup string
+	ZoneName      string
+	Name          string
+}
+
+func (id DnsCnameRecordID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/dnszones/%s/CNAME/%s", subscriptionId, id.ResourceGroup, id.ZoneName, id.Name)
+}
+
+func ParseDnsCnameRecordID(input string) (*DnsCnameRecordID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.Network", "DNS CNAME Record", "dnszones", "CNAME")
+	if err != nil {
+		return nil, err
+	}
+
+	return &DnsCnameRecordID{
+		ResourceGroup: id.ResourceGroup,
+		ZoneName:      segments[0],
+		Name:          segments[1],
+	}, nil
+}
+
+func ValidateDnsCnameRecordID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseDnsCnameRecordID(input)
+		return err
+	})
+}
+
+// DnsMxRecordID is a parsed DNS MX Record Resource ID
+type DnsMxRecordID struct {
+	ResourceGroup string
+	ZoneName      string
+	Name          string
+}
+
+func (id DnsMxRecordID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/dnszones/%s/MX/%s", subscriptionId, id.ResourceGroup, id.ZoneName, id.Name)
+}
+
+func ParseDnsMxRecordID(input string) (*DnsMxRecordID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.Network", "DNS MX Record", "dnszones", "MX")
+	if err != nil {
+		return nil, err
+	}
+
+	return &DnsMxRecordID{
+		ResourceGroup: id.ResourceGroup,
+		ZoneName:      segments[0],
+		Name:          segments[1],
+	}, nil
+}
+
+func ValidateDnsMxRecordID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseDnsMxRecordID(input)
+		return err
+	})
+}
+
+// DnsNsRecordID is a parsed DNS NS Record Resource ID
+type DnsNsRecordID struct {
+	ResourceGroup string
+	ZoneName      string
+	Name          string
+}
+
+func (id DnsNsRecordID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/dnszones/%s/NS/%s", subscriptionId, id.ResourceGroup, id.ZoneName, id.Name)
+}
+
+func ParseDnsNsRecordID(input string) (*DnsNsRecordID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.Network", "DNS NS Record", "dnszones", "NS")
+	if err != nil {
+		return nil, err
+	}
+
+	return &DnsNsRecordID{
+		ResourceGroup: id.ResourceGroup,
+		ZoneName:      segments[0],
+		Name:          segments[1],
+	}, nil
+}
+
+func ValidateDnsNsRecordID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseDnsNsRecordID(input)
+		return err
+	})
+}
+
+// DnsPtrRecordID is a parsed DNS PTR Record Resource ID
+type DnsPtrRecordID struct {
+	ResourceGroup string
+	ZoneName      string
+	Name          string
+}
+
+func (id DnsPtrRecordID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/dnszones/%s/PTR/%s", subscriptionId, id.ResourceGroup, id.ZoneName, id.Name)
+}
+
+func ParseDnsPtrRecordID(input string) (*DnsPtrRecordID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.Network", "DNS PTR Record", "dnszones", "PTR")
+	if err != nil {
+		return nil, err
+	}
+
+	return &DnsPtrRecordID{
+		ResourceGroup: id.ResourceGroup,
+		ZoneName:      segments[0],
+		Name:          segments[1],
+	}, nil
+}
+
+func ValidateDnsPtrRecordID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseDnsPtrRecordID(input)
+		return err
+	})
+}
+
+// DnsSrvRecordID is a parsed DNS SRV Record Resource ID
+type DnsSrvRecordID struct {
+	ResourceGroup string
+	ZoneName      string
+	Name          string
+}
+
+func (id DnsSrvRecordID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/dnszones/%s/SRV/%s", subscriptionId, id.ResourceGroup, id.ZoneName, id.Name)
+}
+
+func ParseDnsSrvRecordID(input string) (*DnsSrvRecordID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.Network", "DNS SRV Record", "dnszones", "SRV")
+	if err != nil {
+		return nil, err
+	}
+
+	return &DnsSrvRecordID{
+		ResourceGroup: id.ResourceGroup,
+		ZoneName:      segments[0],
+		Name:          segments[1],
+	}, nil
+}
+
+func ValidateDnsSrvRecordID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseDnsSrvRecordID(input)
+		return err
+	})
+}
+
+// DnsTxtRecordID is a parsed DNS TXT Record Resource ID
+type DnsTxtRecordID struct {
+	ResourceGroup string
+	ZoneName      string
+	Name          string
+}
+
+func (id DnsTxtRecordID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/dnszones/%s/TXT/%s", subscriptionId, id.ResourceGroup, id.ZoneName, id.Name)
+}
+
+func ParseDnsTxtRecordID(input string) (*DnsTxtRecordID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.Network", "DNS TXT Record", "dnszones", "TXT")
+	if err != nil {
+		return nil, err
+	}
+
+	return &DnsTxtRecordID{
+		ResourceGroup: id.ResourceGroup,
+		ZoneName:      segments[0],
+		Name:          segments[1],
+	}, nil
+}
+
+func ValidateDnsTxtRecordID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseDnsTxtRecordID(input)
+		return err
+	})
+}
@@ -0,0 +1,80 @@
+package azure
+
+import (
+	"testing"
+)
+
+func TestDnsResourceIDs(t *testing.T) {
+	testResourceIDs(t, []resourceIDTestCase{
+		{
+			Name:  "DNS Zone",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/dnszones/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParseDnsZoneID(input)
+			},
+		},
+		{
+			Name:  "DNS A Record",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/dnszones/zone1/A/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParseDnsARecordID(input)
+			},
+		},
+		{
+			Name:  "DNS AAAA Record",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/dnszones/zone1/AAAA/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParseDnsAaaaRecordID(input)
+			},
+		},
+		{
+			Name:  "DNS CAA Record",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/dnszones/zone1/CAA/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParseDnsCaaRecordID(input)
+			},
+		},
+		{
+			Name:  "DNS CNAME Record",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/dnszones/zone1/CNAME/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParseDnsCnameRecordID(input)
+			},
+		},
+		{
+			Name:  "DNS MX Record",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/dnszones/zone1/MX/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParseDnsMxRecordID(input)
+			},
+		},
+		{
+			Name:  "DNS NS Record",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/dnszones/zone1/NS/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParseDnsNsRecordID(input)
+			},
+		},
+		{
+			Name:  "DNS PTR Record",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/dnszones/zone1/PTR/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParseDnsPtrRecordID(input)
+			},
+		},
+		{
+			Name:  "DNS SRV Record",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/dnszones/zone1/SRV/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParseDnsSrvRecordID(input)
+			},
+		},
+		{
+			Name:  "DNS TXT Record",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/dnszones/zone1/TXT/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParseDnsTxtRecordID(input)
+			},
+		},
+	})
+}
@@ -0,0 +1,74 @@
+package azure
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/go-azure-helpers/authentication"
+)
+
+// EnvironmentOptions determines where the endpoints for an Azure Environment are loaded from, which allows
+// connecting to Azure Environments other than the well-known clouds (for example Azure Stack)
+type EnvironmentOptions struct {
+	// MetadataHost is the hostname of the Resource Manager endpoint which serves the Metadata for the
+	// Environment (e.g. `management.local.azurestack.external`)
+	MetadataHost string
+
+	// EnvironmentFile is the path to a JSON file on disk containing the endpoints for the Environment
+	EnvironmentFile string
+}
+
+// LoadEnvironment returns the Azure Environment from the Environment File or the Metadata Host if either
+// is specified, falling back to the well-known Azure Environment with the specified name
+func LoadEnvironment(name string, options EnvironmentOptions) (*azure.Environment, error) {
+	if options.EnvironmentFile != "" && options.MetadataHost != "" {
+		return nil, fmt.Errorf("only one of `environment_file` and `metadata_host` can be specified")
+	}
+
+	if options.EnvironmentFile != "" {
+		env, err := azure.EnvironmentFromFile(options.EnvironmentFile)
+		if err != nil {
+			return nil, fmt.Errorf("Error loading the Azure Environment from the file %q: %+v", options.EnvironmentFile, err)
+		}
+
+		if err := validateEnvironment(env); err != nil {
+			return nil, fmt.Errorf("Error loading the Azure Environment from the file %q: %+v", options.EnvironmentFile, err)
+		}
+
+		return &env, nil
+	}
+
+	if options.MetadataHost != "" {
+		return authentication.LoadEnvironmentFromUrl(metadataEndpoint(options.MetadataHost))
+	}
+
+	return authentication.DetermineEnvironment(name)
+}
+
+// metadataEndpoint returns the Resource Manager endpoint for the specified Metadata Host, which can optionally
+// include the scheme
+func metadataEndpoint(host string) string {
+	endpoint := strings.TrimSuffix(host, "/")
+	if !strings.HasPrefix(endpoint, "https://") && !strings.HasPrefix(endpoint, "http://") {
+		endpoint = fmt.Sprintf("https://%s", endpoint)
+	}
+
+	return endpoint
+}
+
+func validateEnvironment(env azure.Environment) error {
+	if env.ResourceManagerEndpoint == "" {
+		return fmt.Errorf("`resourceManagerEndpoint` must be specified")
+	}
+
+	if env.ActiveDirectoryEndpoint == "" {
+		return fmt.Errorf("`activeDirectoryEndpoint` must be specified")
+	}
+
+	if env.TokenAudience == "" {
+		return fmt.Errorf("`tokenAudience` must be specified")
+	}
+
+	return nil
+}
@@ -0,0 +1,144 @@
+package azure
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+)
+
+func TestLoadEnvironmentFromName(t *testing.T) {
+	env, err := LoadEnvironment("public", EnvironmentOptions{})
+	if err != nil {
+		t.Fatalf("Expected no error but got: %+v", err)
+	}
+
+	if env.StorageEndpointSuffix != "core.windows.net" {
+		t.Fatalf("Expected the Storage Endpoint Suffix to be `core.windows.net` but got %q", env.StorageEndpointSuffix)
+	}
+}
+
+func TestLoadEnvironmentFromFile(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Contents string
+		Error    bool
+	}{
+		{
+			Name:     "Invalid JSON",
+			Contents: "{",
+			Error:    true,
+		},
+		{
+			Name:     "Missing Endpoints",
+			Contents: `{"name": "AzureStackCloud", "storageEndpointSuffix": "local.azurestack.external"}`,
+			Error:    true,
+		},
+		{
+			Name: "Valid",
+			Contents: `{
+  "name": "AzureStackCloud",
+  "resourceManagerEndpoint": "https://management.local.azurestack.external/",
+  "activeDirectoryEndpoint": "https://login.microsoftonline.com/",
+  "tokenAudience": "https://management.example.onmicrosoft.com/00000000-0000-0000-0000-000000000000",
+  "storageEndpointSuffix": "local.azurestack.external",
+  "keyVaultDNSSuffix": "vault.local.azurestack.external"
+}`,
+			Error: false,
+		},
+	}
+
+	for _, v := range cases {
+		t.Run(v.Name, func(t *testing.T) {
+			file, err := ioutil.TempFile("", "environment")
+			if err != nil {
+				t.Fatalf("Error creating temporary file: %+v", err)
+			}
+			defer os.Remove(file.Name())
+
+			if _, err := file.WriteString(v.Contents); err != nil {
+				t.Fatalf("Error writing temporary file: %+v", err)
+			}
+			file.Close()
+
+			env, err := LoadEnvironment("public", EnvironmentOptions{EnvironmentFile: file.Name()})
+			if v.Error {
+				if err == nil {
+					t.Fatalf("Expected an error but didn't get one")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Expected no error but got: %+v", err)
+			}
+
+			if env.StorageEndpointSuffix != "local.azurestack.external" {
+				t.Fatalf("Expected the Storage Endpoint Suffix to be `local.azurestack.external` but got %q", env.StorageEndpointSuffix)
+			}
+		})
+	}
+}
+
+func TestLoadEnvironmentFromMetadataHost(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/metadata/endpoints" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		fmt.Fprint(w, `{
+  "galleryEndpoint": "https://providers.local.azurestack.external:30016/",
+  "graphEndpoint": "https://graph.windows.net/",
+  "portalEndpoint": "https://portal.local.azurestack.external/",
+  "authentication": {
+    "loginEndpoint": "https://login.microsoftonline.com/",
+    "audiences": ["https://management.example.onmicrosoft.com/00000000-0000-0000-0000-000000000000"]
+  }
+}`)
+	}))
+	defer server.Close()
+
+	env, err := LoadEnvironment("public", EnvironmentOptions{MetadataHost: server.URL})
+	if err != nil {
+		t.Fatalf("Expected no error but got: %+v", err)
+	}
+
+	if env.ResourceManagerEndpoint != server.URL {
+		t.Fatalf("Expected the Resource Manager Endpoint to be %q but got %q", server.URL, env.ResourceManagerEndpoint)
+	}
+
+	if env.TokenAudience != "https://management.example.onmicrosoft.com/00000000-0000-0000-0000-000000000000" {
+		t.Fatalf("Expected the Token Audience to be loaded from the Metadata but got %q", env.TokenAudience)
+	}
+
+	if env.ActiveDirectoryEndpoint != "https://login.microsoftonline.com/" {
+		t.Fatalf("Expected the Active Directory Endpoint to be loaded from the Metadata but got %q", env.ActiveDirectoryEndpoint)
+	}
+}
+
+func TestLoadEnvironmentConflictingOptions(t *testing.T) {
+	options := EnvironmentOptions{
+		EnvironmentFile: "environment.json",
+		MetadataHost:    "management.local.azurestack.external",
+	}
+	if _, err := LoadEnvironment("public", options); err == nil {
+		t.Fatalf("Expected an error but didn't get one")
+	}
+}
+
+func TestMetadataEndpoint(t *testing.T) {
+	cases := map[string]string{
+		"management.local.azurestack.external":          "https://management.local.azurestack.external",
+		"https://management.local.azurestack.external/": "https://management.local.azurestack.external",
+		"http://localhost:8080":                         "http://localhost:8080",
+	}
+
+	for input, expected := range cases {
+		if actual := metadataEndpoint(input); actual != expected {
+			t.Fatalf("Expected %q for %q but got %q", expected, input, actual)
+		}
+	}
+}
@@ -0,0 +1,63 @@
+package azure
+
+import (
+	"fmt"
+)
+
+// EventGridDomainID is a parsed EventGrid Domain Resource ID
+type EventGridDomainID struct {
+	ResourceGroup string
+	Name          string
+}
+
+func (id EventGridDomainID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.EventGrid/domains/%s", subscriptionId, id.ResourceGroup, id.Name)
+}
+
+func ParseEventGridDomainID(input string) (*EventGridDomainID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.EventGrid", "EventGrid Domain", "domains")
+	if err != nil {
+		return nil, err
+	}
+
+	return &EventGridDomainID{
+		ResourceGroup: id.ResourceGroup,
+		Name:          segments[0],
+	}, nil
+}
+
+func ValidateEventGridDomainID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseEventGridDomainID(input)
+		return err
+	})
+}
+
+// EventGridTopicID is a parsed EventGrid Topic Resource ID
+type EventGridTopicID struct {
+	ResourceGroup string
+	Name          string
+}
+
+func (id EventGridTopicID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.EventGrid/topics/%s", subscriptionId, id.ResourceGroup, id.Name)
+}
+
+func ParseEventGridTopicID(input string) (*EventGridTopicID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.EventGrid", "EventGrid Topic", "topics")
+	if err != nil {
+		return nil, err
+	}
+
+	return &EventGridTopicID{
+		ResourceGroup: id.ResourceGroup,
+		Name:          segments[0],
+	}, nil
+}
+
+func ValidateEventGridTopicID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseEventGridTopicID(input)
+		return err
+	})
+}
@@ -0,0 +1,24 @@
+package azure
+
+import (
+	"testing"
+)
+
+func TestEventgridResourceIDs(t *testing.T) {
+	testResourceIDs(t, []resourceIDTestCase{
+		{
+			Name:  "EventGrid Domain",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.EventGrid/domains/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParseEventGridDomainID(input)
+			},
+		},
+		{
+			Name:  "EventGrid Topic",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.EventGrid/topics/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParseEventGridTopicID(input)
+			},
+		},
+	})
+}
@@ -0,0 +1,162 @@
+package azure
+
+import (
+	"fmt"
+)
+
+// EventHubNamespaceID is a parsed EventHub Namespace Resource ID
+type EventHubNamespaceID struct {
+	ResourceGroup string
+	Name          string
+}
+
+func (id EventHubNamespaceID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.EventHub/namespaces/%s", subscriptionId, id.ResourceGroup, id.Name)
+}
+
+func ParseEventHubNamespaceID(input string) (*EventHubNamespaceID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.EventHub", "EventHub Namespace", "namespaces")
+	if err != nil {
+		return nil, err
+	}
+
+	return &EventHubNamespaceID{
+		ResourceGroup: id.ResourceGroup,
+		Name:          segments[0],
+	}, nil
+}
+
+func ValidateEventHubNamespaceID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseEventHubNamespaceID(input)
+		return err
+	})
+}
+
+// EventHubNamespaceAuthorizationRuleID is a parsed EventHub Namespace Authorization Rule Resource ID
+type EventHubNamespaceAuthorizationRuleID struct {
+	ResourceGroup string
+	NamespaceName string
+	Name          string
+}
+
+func (id EventHubNamespaceAuthorizationRuleID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.EventHub/namespaces/%s/authorizationRules/%s", subscriptionId, id.ResourceGroup, id.NamespaceName, id.Name)
+}
+
+func ParseEventHubNamespaceAuthorizationRuleID(input string) (*EventHubNamespaceAuthorizationRuleID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.EventHub", "EventHub Namespace Authorization Rule", "namespaces", "authorizationRules")
+	if err != nil {
+		return nil, err
+	}
+
+	return &EventHubNamespaceAuthorizationRuleID{
+		ResourceGroup: id.ResourceGroup,
+		NamespaceName: segments[0],
+		Name:          segments[1],
+	}, nil
+}
+
+func ValidateEventHubNamespaceAuthorizationRuleID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseEventHubNamespaceAuthorizationRuleID(input)
+		return err
+	})
+}
+
+// EventHubID is a parsed EventHub Resource ID
+type EventHubID struct {
+	ResourceGroup string
+	NamespaceName string
+	Name          string
+}
+
+func (id EventHubID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.EventHub/namespaces/%s/eventhubs/%s", subscriptionId, id.ResourceGroup, id.NamespaceName, id.Name)
+}
+
+func ParseEventHubID(input string) (*EventHubID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.EventHub", "EventHub", "namespaces", "eventhubs")
+	if err != nil {
+		return nil, err
+	}
+
+	return &EventHubID{
+		ResourceGroup: id.ResourceGroup,
+		NamespaceName: segments[0],
+		Name:          segments[1],
+	}, nil
+}
+
+func ValidateEventHubID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseEventHubID(input)
+		return err
+	})
+}
+
+// EventHubAuthorizationRuleID is a parsed EventHub Authorization Rule Resource ID
+type EventHubAuthorizationRuleID struct {
+	ResourceGroup string
+	NamespaceName string
+	EventHubName  string
+	Name          string
+}
+
+func (id EventHubAuthorizationRuleID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.EventHub/namespaces/%s/eventhubs/%s/authorizationRules/%s", subscriptionId, id.ResourceGroup, id.NamespaceName, id.EventHubName, id.Name)
+}
+
+func ParseEventHubAuthorizationRuleID(input string) (*EventHubAuthorizationRuleID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.EventHub", "EventHub Authorization Rule", "namespaces", "eventhubs", "authorizationRules")
+	if err != nil {
+		return nil, err
+	}
+
+	return &EventHubAuthorizationRuleID{
+		ResourceGroup: id.ResourceGroup,
+		NamespaceName: segments[0],
+		EventHubName:  segments[1],
+		Name:          segments[2],
+	}, nil
+}
+
+func ValidateEventHubAuthorizationRuleID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseEventHubAuthorizationRuleID(input)
+		return err
+	})
+}
+
+// EventHubConsumerGroupID is a parsed EventHub Consumer Group Resource ID
+type EventHubConsumerGroupID struct {
+	ResourceGroup string
+	NamespaceName string
+	EventHubName  string
+	Name          string
+}
+
+func (id EventHubConsumerGroupID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.EventHub/namespaces/%s/eventhubs/%s/consumergroups/%s", subscriptionId, id.ResourceGroup, id.NamespaceName, id.EventHubName, id.Name)
+}
+
+func ParseEventHubConsumerGroupID(input string) (*EventHubConsumerGroupID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.EventHub", "EventHub Consumer Group", "namespaces", "eventhubs", "consumergroups")
+	if err != nil {
+		return nil, err
+	}
+
+	return &EventHubConsumerGroupID{
+		ResourceGroup: id.ResourceGroup,
+		NamespaceName: segments[0],
+		EventHubName:  segments[1],
+		Name:          segments[2],
+	}, nil
+}
+
+func ValidateEventHubConsumerGroupID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseEventHubConsumerGroupID(input)
+		return err
+	})
+}
@@ -0,0 +1,45 @@
+package azure
+
+import (
+	"testing"
+)
+
+func TestEventhubResourceIDs(t *testing.T) {
+	testResourceIDs(t, []resourceIDTestCase{
+		{
+			Name:  "EventHub Namespace",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.EventHub/namespaces/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParseEventHubNamespaceID(input)
+			},
+		},
+		{
+			Name:  "EventHub Namespace Authorization Rule",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.EventHub/namespaces/namespace1/authorizationRules/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParseEventHubNamespaceAuthorizationRuleID(input)
+			},
+		},
+		{
+			Name:  "EventHub",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.EventHub/namespaces/namespace1/eventhubs/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParseEventHubID(input)
+			},
+		},
+		{
+			Name:  "EventHub Authorization Rule",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.EventHub/namespaces/namespace1/eventhubs/eventhub1/authorizationRules/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParseEventHubAuthorizationRuleID(input)
+			},
+		},
+		{
+			Name:  "EventHub Consumer Group",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.EventHub/namespaces/namespace1/eventhubs/eventhub1/consumergroups/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParseEventHubConsumerGroupID(input)
+			},
+		},
+	})
+}
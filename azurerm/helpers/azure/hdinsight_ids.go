@@ -0,0 +1,34 @@
+package azure
+
+import (
+	"fmt"
+)
+
+// HDInsightClusterID is a parsed HDInsight Cluster Resource ID
+type HDInsightClusterID struct {
+	ResourceGroup string
+	Name          string
+}
+
+func (id HDInsightClusterID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.HDInsight/clusters/%s", subscriptionId, id.ResourceGroup, id.Name)
+}
+
+func ParseHDInsightClusterID(input string) (*HDInsightClusterID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.HDInsight", "HDInsight Cluster", "clusters")
+	if err != nil {
+		return nil, err
+	}
+
+	return &HDInsightClusterID{
+		ResourceGroup: id.ResourceGroup,
+		Name:          segments[0],
+	}, nil
+}
+
+func ValidateHDInsightClusterID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseHDInsightClusterID(input)
+		return err
+	})
+}
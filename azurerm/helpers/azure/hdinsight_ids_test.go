@@ -0,0 +1,17 @@
+package azure
+
+import (
+	"testing"
+)
+
+func TestHdinsightResourceIDs(t *testing.T) {
+	testResourceIDs(t, []resourceIDTestCase{
+		{
+			Name:  "HDInsight Cluster",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.HDInsight/clusters/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParseHDInsightClusterID(input)
+			},
+		},
+	})
+}
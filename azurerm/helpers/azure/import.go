@@ -0,0 +1,27 @@
+package azure
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// ValidateResourceIDPriorToImport returns an Importer which validates the Resource ID being imported
+// using the specified ValidateFunc, prior to importing it as-is
+func ValidateResourceIDPriorToImport(validateFunc schema.SchemaValidateFunc) *schema.ResourceImporter {
+	return &schema.ResourceImporter{
+		State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+			if _, errors := validateFunc(d.Id(), "id"); len(errors) > 0 {
+				messages := make([]string, 0, len(errors))
+				for _, err := range errors {
+					messages = append(messages, err.Error())
+				}
+
+				return nil, fmt.Errorf("Error importing %q: %s", d.Id(), strings.Join(messages, "\n"))
+			}
+
+			return schema.ImportStatePassthrough(d, meta)
+		},
+	}
+}
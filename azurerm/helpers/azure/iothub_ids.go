@@ -0,0 +1,98 @@
+package azure
+
+import (
+	"fmt"
+)
+
+// IotHubID is a parsed IoT Hub Resource ID
+type IotHubID struct {
+	ResourceGroup string
+	Name          string
+}
+
+func (id IotHubID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Devices/IotHubs/%s", subscriptionId, id.ResourceGroup, id.Name)
+}
+
+func ParseIotHubID(input string) (*IotHubID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.Devices", "IoT Hub", "IotHubs")
+	if err != nil {
+		return nil, err
+	}
+
+	return &IotHubID{
+		ResourceGroup: id.ResourceGroup,
+		Name:          segments[0],
+	}, nil
+}
+
+func ValidateIotHubID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseIotHubID(input)
+		return err
+	})
+}
+
+// IotHubConsumerGroupID is a parsed IoT Hub Consumer Group Resource ID
+type IotHubConsumerGroupID struct {
+	ResourceGroup        string
+	IotHubName           string
+	EventHubEndpointName string
+	Name                 string
+}
+
+func (id IotHubConsumerGroupID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Devices/IotHubs/%s/eventHubEndpoints/%s/ConsumerGroups/%s", subscriptionId, id.ResourceGroup, id.IotHubName, id.EventHubEndpointName, id.Name)
+}
+
+func ParseIotHubConsumerGroupID(input string) (*IotHubConsumerGroupID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.Devices", "IoT Hub Consumer Group", "IotHubs", "eventHubEndpoints", "ConsumerGroups")
+	if err != nil {
+		return nil, err
+	}
+
+	return &IotHubConsumerGroupID{
+		ResourceGroup:        id.ResourceGroup,
+		IotHubName:           segments[0],
+		EventHubEndpointName: segments[1],
+		Name:                 segments[2],
+	}, nil
+}
+
+func ValidateIotHubConsumerGroupID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseIotHubConsumerGroupID(input)
+		return err
+	})
+}
+
+// IotHubSharedAccessPolicyID is a parsed IoT Hub Shared Access Policy Resource ID
+type IotHubSharedAccessPolicyID struct {
+	ResourceGroup string
+	IotHubName    string
+	Name          string
+}
+
+func (id IotHubSharedAccessPolicyID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Devices/IotHubs/%s/IotHubKeys/%s", subscriptionId, id.ResourceGroup, id.IotHubName, id.Name)
+}
+
+func ParseIotHubSharedAccessPolicyID(input string) (*IotHubSharedAccessPolicyID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.Devices", "IoT Hub Shared Access Policy", "IotHubs", "IotHubKeys")
+	if err != nil {
+		return nil, err
+	}
+
+	return &IotHubSharedAccessPolicyID{
+		ResourceGroup: id.ResourceGroup,
+		IotHubName:    segments[0],
+		Name:          segments[1],
+	}, nil
+}
+
+func ValidateIotHubSharedAccessPolicyID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseIotHubSharedAccessPolicyID(input)
+		return err
+	})
+}
@@ -0,0 +1,31 @@
+package azure
+
+import (
+	"testing"
+)
+
+func TestIothubResourceIDs(t *testing.T) {
+	testResourceIDs(t, []resourceIDTestCase{
+		{
+			Name:  "IoT Hub",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Devices/IotHubs/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParseIotHubID(input)
+			},
+		},
+		{
+			Name:  "IoT Hub Consumer Group",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Devices/IotHubs/iothub1/eventHubEndpoints/eventhubendpoint1/ConsumerGroups/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParseIotHubConsumerGroupID(input)
+			},
+		},
+		{
+			Name:  "IoT Hub Shared Access Policy",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Devices/IotHubs/iothub1/IotHubKeys/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParseIotHubSharedAccessPolicyID(input)
+			},
+		},
+	})
+}
@@ -0,0 +1,34 @@
+package azure
+
+import (
+	"fmt"
+)
+
+// KeyVaultID is a parsed Key Vault Resource ID
+type KeyVaultID struct {
+	ResourceGroup string
+	Name          string
+}
+
+func (id KeyVaultID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.KeyVault/vaults/%s", subscriptionId, id.ResourceGroup, id.Name)
+}
+
+func ParseKeyVaultID(input string) (*KeyVaultID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.KeyVault", "Key Vault", "vaults")
+	if err != nil {
+		return nil, err
+	}
+
+	return &KeyVaultID{
+		ResourceGroup: id.ResourceGroup,
+		Name:          segments[0],
+	}, nil
+}
+
+func ValidateKeyVaultID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseKeyVaultID(input)
+		return err
+	})
+}
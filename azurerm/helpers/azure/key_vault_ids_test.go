@@ -0,0 +1,17 @@
+package azure
+
+import (
+	"testing"
+)
+
+func TestKeyVaultResourceIDs(t *testing.T) {
+	testResourceIDs(t, []resourceIDTestCase{
+		{
+			Name:  "Key Vault",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.KeyVault/vaults/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParseKeyVaultID(input)
+			},
+		},
+	})
+}
@@ -0,0 +1,94 @@
+package azure
+
+import (
+	"fmt"
+)
+
+// LogAnalyticsWorkspaceID is a parsed Log Analytics Workspace Resource ID
+type LogAnalyticsWorkspaceID struct {
+	ResourceGroup string
+	Name          string
+}
+
+func (id LogAnalyticsWorkspaceID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.OperationalInsights/workspaces/%s", subscriptionId, id.ResourceGroup, id.Name)
+}
+
+func ParseLogAnalyticsWorkspaceID(input string) (*LogAnalyticsWorkspaceID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.OperationalInsights", "Log Analytics Workspace", "workspaces")
+	if err != nil {
+		return nil, err
+	}
+
+	return &LogAnalyticsWorkspaceID{
+		ResourceGroup: id.ResourceGroup,
+		Name:          segments[0],
+	}, nil
+}
+
+func ValidateLogAnalyticsWorkspaceID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseLogAnalyticsWorkspaceID(input)
+		return err
+	})
+}
+
+// LogAnalyticsLinkedServiceID is a parsed Log Analytics Linked Service Resource ID
+type LogAnalyticsLinkedServiceID struct {
+	ResourceGroup string
+	WorkspaceName string
+	Name          string
+}
+
+func (id LogAnalyticsLinkedServiceID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.OperationalInsights/workspaces/%s/linkedservices/%s", subscriptionId, id.ResourceGroup, id.WorkspaceName, id.Name)
+}
+
+func ParseLogAnalyticsLinkedServiceID(input string) (*LogAnalyticsLinkedServiceID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.OperationalInsights", "Log Analytics Linked Service", "workspaces", "linkedservices")
+	if err != nil {
+		return nil, err
+	}
+
+	return &LogAnalyticsLinkedServiceID{
+		ResourceGroup: id.ResourceGroup,
+		WorkspaceName: segments[0],
+		Name:          segments[1],
+	}, nil
+}
+
+func ValidateLogAnalyticsLinkedServiceID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseLogAnalyticsLinkedServiceID(input)
+		return err
+	})
+}
+
+// LogAnalyticsSolutionID is a parsed Log Analytics Solution Resource ID
+type LogAnalyticsSolutionID struct {
+	ResourceGroup string
+	Name          string
+}
+
+func (id LogAnalyticsSolutionID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.OperationsManagement/solutions/%s", subscriptionId, id.ResourceGroup, id.Name)
+}
+
+func ParseLogAnalyticsSolutionID(input string) (*LogAnalyticsSolutionID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.OperationsManagement", "Log Analytics Solution", "solutions")
+	if err != nil {
+		return nil, err
+	}
+
+	return &LogAnalyticsSolutionID{
+		ResourceGroup: id.ResourceGroup,
+		Name:          segments[0],
+	}, nil
+}
+
+func ValidateLogAnalyticsSolutionID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseLogAnalyticsSolutionID(input)
+		return err
+	})
+}
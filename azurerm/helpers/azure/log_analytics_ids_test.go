@@ -0,0 +1,31 @@
+package azure
+
+import (
+	"testing"
+)
+
+func TestLogAnalyticsResourceIDs(t *testing.T) {
+	testResourceIDs(t, []resourceIDTestCase{
+		{
+			Name:  "Log Analytics Workspace",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.OperationalInsights/workspaces/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParseLogAnalyticsWorkspaceID(input)
+			},
+		},
+		{
+			Name:  "Log Analytics Linked Service",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.OperationalInsights/workspaces/workspace1/linkedservices/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParseLogAnalyticsLinkedServiceID(input)
+			},
+		},
+		{
+			Name:  "Log Analytics Solution",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.OperationsManagement/solutions/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParseLogAnalyticsSolutionID(input)
+			},
+		},
+	})
+}
@@ -0,0 +1,139 @@
+package azure
+
+import (
+	"regexp"
+	"strings"
+)
+
+const redactedValue = "[REDACTED]"
+
+// LogRedactionOptions configures which fields are masked in the request/response debug logs
+type LogRedactionOptions struct {
+	// Allowlist contains the names of JSON fields, Headers and Connection String keys which would be redacted
+	// by default, but which should be logged as-is
+	Allowlist []string
+
+	// Denylist contains the names of additional JSON fields, Headers and Connection String keys to redact
+	Denylist []string
+}
+
+var (
+	// sensitiveFieldNames are the (lower-cased) names of fields which are always redacted
+	sensitiveFieldNames = map[string]bool{
+		"authorization":                true,
+		"cookie":                       true,
+		"customdata":                   true,
+		"pwd":                          true,
+		"set-cookie":                   true,
+		"sig":                          true,
+		"value":                        true,
+		"x-ms-authorization-auxiliary": true,
+	}
+
+	// nonSensitiveFieldNames are the (lower-cased) names of fields which would otherwise match one of the
+	// sensitiveFieldSuffixes, but which don't contain secrets
+	nonSensitiveFieldNames = map[string]bool{
+		"publickey": true,
+		"skiptoken": true,
+	}
+
+	// sensitiveFieldSuffixes are the (lower-cased) suffixes of field names which are redacted
+	// e.g. `administratorLoginPassword`, `primaryKey`, `clientSecret` and `sasToken`
+	sensitiveFieldSuffixes = []string{
+		"connectionstring",
+		"key",
+		"password",
+		"secret",
+		"sharedaccesssignature",
+		"token",
+	}
+
+	// matches `"name": "value"` pairs within a JSON document, where the value is a string
+	jsonStringFieldRegex = regexp.MustCompile(`"((?:[^"\\]|\\.)*)"(\s*:\s*)"((?:[^"\\]|\\.)*)"`)
+
+	// matches `name=value` pairs within Connection Strings and Query Strings (e.g. SAS Tokens)
+	keyValuePairRegex = regexp.MustCompile(`([A-Za-z][A-Za-z0-9_\-]*)=([^;&"'\s]+)`)
+
+	// matches `Name: value` lines within the headers of a request/response dump
+	headerRegex = regexp.MustCompile(`(?m)^([A-Za-z0-9\-]+):( *)(.*?)(\r?)$`)
+)
+
+type logRedactor struct {
+	allowed map[string]bool
+	denied  map[string]bool
+}
+
+func newLogRedactor(options LogRedactionOptions) logRedactor {
+	redactor := logRedactor{
+		allowed: make(map[string]bool),
+		denied:  make(map[string]bool),
+	}
+
+	for _, v := range options.Allowlist {
+		redactor.allowed[strings.ToLower(v)] = true
+	}
+
+	for _, v := range options.Denylist {
+		redactor.denied[strings.ToLower(v)] = true
+	}
+
+	return redactor
+}
+
+func (r logRedactor) isSensitive(name string) bool {
+	name = strings.ToLower(name)
+
+	if r.allowed[name] || nonSensitiveFieldNames[name] {
+		return false
+	}
+
+	if r.denied[name] || sensitiveFieldNames[name] {
+		return true
+	}
+
+	for _, suffix := range sensitiveFieldSuffixes {
+		if strings.HasSuffix(name, suffix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// redact masks the values of any sensitive Headers, JSON fields or Connection String/Query String
+// keys within the wire-format dump of a request or response
+func (r logRedactor) redact(dump string) string {
+	headers := dump
+	body := ""
+	if i := strings.Index(dump, "\r\n\r\n"); i >= 0 {
+		headers = dump[:i]
+		body = dump[i:]
+	}
+
+	headers = headerRegex.ReplaceAllStringFunc(headers, func(line string) string {
+		match := headerRegex.FindStringSubmatch(line)
+		if !r.isSensitive(match[1]) {
+			return line
+		}
+
+		return match[1] + ":" + match[2] + redactedValue + match[4]
+	})
+
+	body = jsonStringFieldRegex.ReplaceAllStringFunc(body, func(field string) string {
+		match := jsonStringFieldRegex.FindStringSubmatch(field)
+		if !r.isSensitive(match[1]) {
+			return field
+		}
+
+		return `"` + match[1] + `"` + match[2] + `"` + redactedValue + `"`
+	})
+
+	return keyValuePairRegex.ReplaceAllStringFunc(headers+body, func(pair string) string {
+		match := keyValuePairRegex.FindStringSubmatch(pair)
+		if !r.isSensitive(match[1]) {
+			return pair
+		}
+
+		return match[1] + "=" + redactedValue
+	})
+}
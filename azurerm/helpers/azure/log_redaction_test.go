@@ -0,0 +1,76 @@
+package azure
+
+import (
+	"testing"
+)
+
+func TestLogRedactorRedact(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Options  LogRedactionOptions
+		Input    string
+		Expected string
+	}{
+		{
+			Name:     "Authorization Header",
+			Input:    "GET /subscriptions HTTP/1.1\r\nHost: management.azure.com\r\nAuthorization: Bearer abc123\r\n\r\n",
+			Expected: "GET /subscriptions HTTP/1.1\r\nHost: management.azure.com\r\nAuthorization: [REDACTED]\r\n\r\n",
+		},
+		{
+			Name:     "Auxiliary Authorization Header",
+			Input:    "GET /subscriptions HTTP/1.1\r\nx-ms-authorization-auxiliary: Bearer abc123\r\n\r\n",
+			Expected: "GET /subscriptions HTTP/1.1\r\nx-ms-authorization-auxiliary: [REDACTED]\r\n\r\n",
+		},
+		{
+			Name:     "JSON Fields",
+			Input:    "HTTP/1.1 200 OK\r\n\r\n{\"name\":\"example\",\"administratorLoginPassword\": \"P@ssw0rd\",\"primaryKey\":\"abc==\"}",
+			Expected: "HTTP/1.1 200 OK\r\n\r\n{\"name\":\"example\",\"administratorLoginPassword\": \"[REDACTED]\",\"primaryKey\":\"[REDACTED]\"}",
+		},
+		{
+			Name:     "JSON List Values are left as-is",
+			Input:    "HTTP/1.1 200 OK\r\n\r\n{\"value\":[{\"name\":\"example\"}]}",
+			Expected: "HTTP/1.1 200 OK\r\n\r\n{\"value\":[{\"name\":\"example\"}]}",
+		},
+		{
+			Name:     "JSON Escaped Quotes",
+			Input:    "HTTP/1.1 200 OK\r\n\r\n{\"clientSecret\":\"a\\\"b\",\"name\":\"example\"}",
+			Expected: "HTTP/1.1 200 OK\r\n\r\n{\"clientSecret\":\"[REDACTED]\",\"name\":\"example\"}",
+		},
+		{
+			Name:     "Connection String",
+			Input:    "HTTP/1.1 200 OK\r\n\r\n{\"conn\":\"DefaultEndpointsProtocol=https;AccountName=example;AccountKey=abc==;EndpointSuffix=core.windows.net\"}",
+			Expected: "HTTP/1.1 200 OK\r\n\r\n{\"conn\":\"DefaultEndpointsProtocol=https;AccountName=example;AccountKey=[REDACTED];EndpointSuffix=core.windows.net\"}",
+		},
+		{
+			Name:     "SAS Token",
+			Input:    "GET /container/blob?sv=2018-11-09&se=2019-01-01&sig=abc%3D HTTP/1.1\r\nHost: example.blob.core.windows.net\r\n\r\n",
+			Expected: "GET /container/blob?sv=2018-11-09&se=2019-01-01&sig=[REDACTED] HTTP/1.1\r\nHost: example.blob.core.windows.net\r\n\r\n",
+		},
+		{
+			Name:     "Skip Token isn't redacted",
+			Input:    "GET /subscriptions?api-version=2018-05-01&$skiptoken=abc HTTP/1.1\r\n\r\n",
+			Expected: "GET /subscriptions?api-version=2018-05-01&$skiptoken=abc HTTP/1.1\r\n\r\n",
+		},
+		{
+			Name:     "Allowlist",
+			Options:  LogRedactionOptions{Allowlist: []string{"PrimaryKey"}},
+			Input:    "HTTP/1.1 200 OK\r\n\r\n{\"primaryKey\":\"abc==\",\"secondaryKey\":\"def==\"}",
+			Expected: "HTTP/1.1 200 OK\r\n\r\n{\"primaryKey\":\"abc==\",\"secondaryKey\":\"[REDACTED]\"}",
+		},
+		{
+			Name:     "Denylist",
+			Options:  LogRedactionOptions{Denylist: []string{"adminUsername", "X-Custom-Header"}},
+			Input:    "HTTP/1.1 200 OK\r\nX-Custom-Header: hello\r\n\r\n{\"adminUsername\":\"tfuser\",\"name\":\"example\"}",
+			Expected: "HTTP/1.1 200 OK\r\nX-Custom-Header: [REDACTED]\r\n\r\n{\"adminUsername\":\"[REDACTED]\",\"name\":\"example\"}",
+		},
+	}
+
+	for _, v := range cases {
+		t.Run(v.Name, func(t *testing.T) {
+			actual := newLogRedactor(v.Options).redact(v.Input)
+			if actual != v.Expected {
+				t.Fatalf("Expected %q but got %q", v.Expected, actual)
+			}
+		})
+	}
+}
@@ -0,0 +1,96 @@
+package azure
+
+import (
+	"fmt"
+)
+
+// LogicAppWorkflowID is a parsed Logic App Workflow Resource ID
+type LogicAppWorkflowID struct {
+	ResourceGroup string
+	Name          string
+}
+
+func (id LogicAppWorkflowID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Logic/workflows/%s", subscriptionId, id.ResourceGroup, id.Name)
+}
+
+func ParseLogicAppWorkflowID(input string) (*LogicAppWorkflowID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.Logic", "Logic App Workflow", "workflows")
+	if err != nil {
+		return nil, err
+	}
+
+	return &LogicAppWorkflowID{
+		ResourceGroup: id.ResourceGroup,
+		Name:          segments[0],
+	}, nil
+}
+
+func ValidateLogicAppWorkflowID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseLogicAppWorkflowID(input)
+		return err
+	})
+}
+
+// LogicAppActionID is a parsed Logic App Action Resource ID
+type LogicAppActionID struct {
+	ResourceGroup string
+	WorkflowName  string
+	Name          string
+}
+
+func (id LogicAppActionID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Logic/workflows/%s/actions/%s", subscriptionId, id.ResourceGroup, id.WorkflowName, id.Name)
+}
+
+func ParseLogicAppActionID(input string) (*LogicAppActionID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.Logic", "Logic App Action", "workflows", "actions")
+	if err != nil {
+		return nil, err
+	}
+
+	return &LogicAppActionID{
+		ResourceGroup: id.ResourceGroup,
+		WorkflowName:  segments[0],
+		Name:          segments[1],
+	}, nil
+}
+
+func ValidateLogicAppActionID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseLogicAppActionID(input)
+		return err
+	})
+}
+
+// LogicAppTriggerID is a parsed Logic App Trigger Resource ID
+type LogicAppTriggerID struct {
+	ResourceGroup string
+	WorkflowName  string
+	Name          string
+}
+
+func (id LogicAppTriggerID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Logic/workflows/%s/triggers/%s", subscriptionId, id.ResourceGroup, id.WorkflowName, id.Name)
+}
+
+func ParseLogicAppTriggerID(input string) (*LogicAppTriggerID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.Logic", "Logic App Trigger", "workflows", "triggers")
+	if err != nil {
+		return nil, err
+	}
+
+	return &LogicAppTriggerID{
+		ResourceGroup: id.ResourceGroup,
+		WorkflowName:  segments[0],
+		Name:          segments[1],
+	}, nil
+}
+
+func ValidateLogicAppTriggerID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseLogicAppTriggerID(input)
+		return err
+	})
+}
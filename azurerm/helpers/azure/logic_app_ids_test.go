@@ -0,0 +1,31 @@
+package azure
+
+import (
+	"testing"
+)
+
+func TestLogicAppResourceIDs(t *testing.T) {
+	testResourceIDs(t, []resourceIDTestCase{
+		{
+			Name:  "Logic App Workflow",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Logic/workflows/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParseLogicAppWorkflowID(input)
+			},
+		},
+		{
+			Name:  "Logic App Action",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Logic/workflows/workflow1/actions/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParseLogicAppActionID(input)
+			},
+		},
+		{
+			Name:  "Logic App Trigger",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Logic/workflows/workflow1/triggers/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParseLogicAppTriggerID(input)
+			},
+		},
+	})
+}
@@ -0,0 +1,65 @@
+package azure
+
+import (
+	"fmt"
+)
+
+// MariaDbServerID is a parsed MariaDB Server Resource ID
+type MariaDbServerID struct {
+	ResourceGroup string
+	Name          string
+}
+
+func (id MariaDbServerID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.DBforMariaDB/servers/%s", subscriptionId, id.ResourceGroup, id.Name)
+}
+
+func ParseMariaDbServerID(input string) (*MariaDbServerID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.DBforMariaDB", "MariaDB Server", "servers")
+	if err != nil {
+		return nil, err
+	}
+
+	return &MariaDbServerID{
+		ResourceGroup: id.ResourceGroup,
+		Name:          segments[0],
+	}, nil
+}
+
+func ValidateMariaDbServerID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseMariaDbServerID(input)
+		return err
+	})
+}
+
+// MariaDbDatabaseID is a parsed MariaDB Database Resource ID
+type MariaDbDatabaseID struct {
+	ResourceGroup string
+	ServerName    string
+	Name          string
+}
+
+func (id MariaDbDatabaseID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.DBforMariaDB/servers/%s/databases/%s", subscriptionId, id.ResourceGroup, id.ServerName, id.Name)
+}
+
+func ParseMariaDbDatabaseID(input string) (*MariaDbDatabaseID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.DBforMariaDB", "MariaDB Database", "servers", "databases")
+	if err != nil {
+		return nil, err
+	}
+
+	return &MariaDbDatabaseID{
+		ResourceGroup: id.ResourceGroup,
+		ServerName:    segments[0],
+		Name:          segments[1],
+	}, nil
+}
+
+func ValidateMariaDbDatabaseID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseMariaDbDatabaseID(input)
+		return err
+	})
+}
@@ -0,0 +1,24 @@
+package azure
+
+import (
+	"testing"
+)
+
+func TestMariadbResourceIDs(t *testing.T) {
+	testResourceIDs(t, []resourceIDTestCase{
+		{
+			Name:  "MariaDB Server",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.DBforMariaDB/servers/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParseMariaDbServerID(input)
+			},
+		},
+		{
+			Name:  "MariaDB Database",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.DBforMariaDB/servers/server1/databases/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParseMariaDbDatabaseID(input)
+			},
+		},
+	})
+}
@@ -0,0 +1,34 @@
+package azure
+
+import (
+	"fmt"
+)
+
+// MediaServicesAccountID is a parsed Media Services Account Resource ID
+type MediaServicesAccountID struct {
+	ResourceGroup string
+	Name          string
+}
+
+func (id MediaServicesAccountID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Media/mediaservices/%s", subscriptionId, id.ResourceGroup, id.Name)
+}
+
+func ParseMediaServicesAccountID(input string) (*MediaServicesAccountID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.Media", "Media Services Account", "mediaservices")
+	if err != nil {
+		return nil, err
+	}
+
+	return &MediaServicesAccountID{
+		ResourceGroup: id.ResourceGroup,
+		Name:          segments[0],
+	}, nil
+}
+
+func ValidateMediaServicesAccountID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseMediaServicesAccountID(input)
+		return err
+	})
+}
@@ -0,0 +1,150 @@
+package azure
+
+import (
+	"fmt"
+)
+
+// MonitorActionGroupID is a parsed Action Group Resource ID
+type MonitorActionGroupID struct {
+	ResourceGroup string
+	Name          string
+}
+
+func (id MonitorActionGroupID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/microsoft.insights/actionGroups/%s", subscriptionId, id.ResourceGroup, id.Name)
+}
+
+func ParseMonitorActionGroupID(input string) (*MonitorActionGroupID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.Insights", "Action Group", "actionGroups")
+	if err != nil {
+		return nil, err
+	}
+
+	return &MonitorActionGroupID{
+		ResourceGroup: id.ResourceGroup,
+		Name:          segments[0],
+	}, nil
+}
+
+func ValidateMonitorActionGroupID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseMonitorActionGroupID(input)
+		return err
+	})
+}
+
+// MonitorActivityLogAlertID is a parsed Activity Log Alert Resource ID
+type MonitorActivityLogAlertID struct {
+	ResourceGroup string
+	Name          string
+}
+
+func (id MonitorActivityLogAlertID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/microsoft.insights/activityLogAlerts/%s", subscriptionId, id.ResourceGroup, id.Name)
+}
+
+func ParseMonitorActivityLogAlertID(input string) (*MonitorActivityLogAlertID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.Insights", "Activity Log Alert", "activityLogAlerts")
+	if err != nil {
+		return nil, err
+	}
+
+	return &MonitorActivityLogAlertID{
+		ResourceGroup: id.ResourceGroup,
+		Name:          segments[0],
+	}, nil
+}
+
+func ValidateMonitorActivityLogAlertID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseMonitorActivityLogAlertID(input)
+		return err
+	})
+}
+
+// MonitorAutoscaleSettingID is a parsed AutoScale Setting Resource ID
+type MonitorAutoscaleSettingID struct {
+	ResourceGroup string
+	Name          string
+}
+
+func (id MonitorAutoscaleSettingID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/microsoft.insights/autoscalesettings/%s", subscriptionId, id.ResourceGroup, id.Name)
+}
+
+func ParseMonitorAutoscaleSettingID(input string) (*MonitorAutoscaleSettingID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.Insights", "AutoScale Setting", "autoscalesettings")
+	if err != nil {
+		return nil, err
+	}
+
+	return &MonitorAutoscaleSettingID{
+		ResourceGroup: id.ResourceGroup,
+		Name:          segments[0],
+	}, nil
+}
+
+func ValidateMonitorAutoscaleSettingID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseMonitorAutoscaleSettingID(input)
+		return err
+	})
+}
+
+// MonitorMetricAlertID is a parsed Metric Alert Resource ID
+type MonitorMetricAlertID struct {
+	ResourceGroup string
+	Name          string
+}
+
+func (id MonitorMetricAlertID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/microsoft.insights/metricAlerts/%s", subscriptionId, id.ResourceGroup, id.Name)
+}
+
+func ParseMonitorMetricAlertID(input string) (*MonitorMetricAlertID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.Insights", "Metric Alert", "metricAlerts")
+	if err != nil {
+		return nil, err
+	}
+
+	return &MonitorMetricAlertID{
+		ResourceGroup: id.ResourceGroup,
+		Name:          segments[0],
+	}, nil
+}
+
+func ValidateMonitorMetricAlertID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseMonitorMetricAlertID(input)
+		return err
+	})
+}
+
+// MonitorMetricAlertRuleID is a parsed Metric Alert Rule Resource ID
+type MonitorMetricAlertRuleID struct {
+	ResourceGroup string
+	Name          string
+}
+
+func (id MonitorMetricAlertRuleID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/microsoft.insights/alertrules/%s", subscriptionId, id.ResourceGroup, id.Name)
+}
+
+func ParseMonitorMetricAlertRuleID(input string) (*MonitorMetricAlertRuleID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.Insights", "Metric Alert Rule", "alertrules")
+	if err != nil {
+		return nil, err
+	}
+
+	return &MonitorMetricAlertRuleID{
+		ResourceGroup: id.ResourceGroup,
+		Name:          segments[0],
+	}, nil
+}
+
+func ValidateMonitorMetricAlertRuleID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseMonitorMetricAlertRuleID(input)
+		return err
+	})
+}
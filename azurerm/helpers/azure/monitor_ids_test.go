@@ -0,0 +1,45 @@
+package azure
+
+import (
+	"testing"
+)
+
+func TestMonitorResourceIDs(t *testing.T) {
+	testResourceIDs(t, []resourceIDTestCase{
+		{
+			Name:  "Action Group",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/microsoft.insights/actionGroups/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParseMonitorActionGroupID(input)
+			},
+		},
+		{
+			Name:  "Activity Log Alert",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/microsoft.insights/activityLogAlerts/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParseMonitorActivityLogAlertID(input)
+			},
+		},
+		{
+			Name:  "AutoScale Setting",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/microsoft.insights/autoscalesettings/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParseMonitorAutoscaleSettingID(input)
+			},
+		},
+		{
+			Name:  "Metric Alert",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/microsoft.insights/metricAlerts/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParseMonitorMetricAlertID(input)
+			},
+		},
+		{
+			Name:  "Metric Alert Rule",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/microsoft.insights/alertrules/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParseMonitorMetricAlertRuleID(input)
+			},
+		},
+	})
+}
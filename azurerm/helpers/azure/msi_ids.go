@@ -0,0 +1,34 @@
+package azure
+
+import (
+	"fmt"
+)
+
+// UserAssignedIdentityID is a parsed User Assigned Identity Resource ID
+type UserAssignedIdentityID struct {
+	ResourceGroup string
+	Name          string
+}
+
+func (id UserAssignedIdentityID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.ManagedIdentity/userAssignedIdentities/%s", subscriptionId, id.ResourceGroup, id.Name)
+}
+
+func ParseUserAssignedIdentityID(input string) (*UserAssignedIdentityID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.ManagedIdentity", "User Assigned Identity", "userAssignedIdentities")
+	if err != nil {
+		return nil, err
+	}
+
+	return &UserAssignedIdentityID{
+		ResourceGroup: id.ResourceGroup,
+		Name:          segments[0],
+	}, nil
+}
+
+func ValidateUserAssignedIdentityID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseUserAssignedIdentityID(input)
+		return err
+	})
+}
@@ -0,0 +1,17 @@
+package azure
+
+import (
+	"testing"
+)
+
+func TestMsiResourceIDs(t *testing.T) {
+	testResourceIDs(t, []resourceIDTestCase{
+		{
+			Name:  "User Assigned Identity",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.ManagedIdentity/userAssignedIdentities/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParseUserAssignedIdentityID(input)
+			},
+		},
+	})
+}
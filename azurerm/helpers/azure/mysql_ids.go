@@ -0,0 +1,158 @@
+package azure
+
+import (
+	"fmt"
+)
+
+// MySqlServerID is a parsed MySQL Server Resource ID
+type MySqlServerID struct {
+	ResourceGroup string
+	Name          string
+}
+
+func (id MySqlServerID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.DBforMySQL/servers/%s", subscriptionId, id.ResourceGroup, id.Name)
+}
+
+func ParseMySqlServerID(input string) (*MySqlServerID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.DBforMySQL", "MySQL Server", "servers")
+	if err != nil {
+		return nil, err
+	}
+
+	return &MySqlServerID{
+		ResourceGroup: id.ResourceGroup,
+		Name:          segments[0],
+	}, nil
+}
+
+func ValidateMySqlServerID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseMySqlServerID(input)
+		return err
+	})
+}
+
+// MySqlConfigurationID is a parsed MySQL Configuration Resource ID
+type MySqlConfigurationID struct {
+	ResourceGroup string
+	ServerName    string
+	Name          string
+}
+
+func (id MySqlConfigurationID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.DBforMySQL/servers/%s/configurations/%s", subscriptionId, id.ResourceGroup, id.ServerName, id.Name)
+}
+
+func ParseMySqlConfigurationID(input string) (*MySqlConfigurationID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.DBforMySQL", "MySQL Configuration", "servers", "configurations")
+	if err != nil {
+		return nil, err
+	}
+
+	return &MySqlConfigurationID{
+		ResourceGroup: id.ResourceGroup,
+		ServerName:    segments[0],
+		Name:          segments[1],
+	}, nil
+}
+
+func ValidateMySqlConfigurationID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseMySqlConfigurationID(input)
+		return err
+	})
+}
+
+// MySqlDatabaseID is a parsed MySQL Database Resource ID
+type MySqlDatabaseID struct {
+	ResourceGroup string
+	ServerName    string
+	Name          string
+}
+
+func (id MySqlDatabaseID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.DBforMySQL/servers/%s/databases/%s", subscriptionId, id.ResourceGroup, id.ServerName, id.Name)
+}
+
+func ParseMySqlDatabaseID(input string) (*MySqlDatabaseID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.DBforMySQL", "MySQL Database", "servers", "databases")
+	if err != nil {
+		return nil, err
+	}
+
+	return &MySqlDatabaseID{
+		ResourceGroup: id.ResourceGroup,
+		ServerName:    segments[0],
+		Name:          segments[1],
+	}, nil
+}
+
+func ValidateMySqlDatabaseID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseMySqlDatabaseID(input)
+		return err
+	})
+}
+
+// MySqlFirewallRuleID is a parsed MySQL Firewall Rule Resource ID
+type MySqlFirewallRuleID struct {
+	ResourceGroup string
+	ServerName    string
+	Name          string
+}
+
+func (id MySqlFirewallRuleID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.DBforMySQL/servers/%s/firewallRules/%s", subscriptionId, id.ResourceGroup, id.ServerName, id.Name)
+}
+
+func ParseMySqlFirewallRuleID(input string) (*MySqlFirewallRuleID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.DBforMySQL", "MySQL Firewall Rule", "servers", "firewallRules")
+	if err != nil {
+		return nil, err
+	}
+
+	return &MySqlFirewallRuleID{
+		ResourceGroup: id.ResourceGroup,
+		ServerName:    segments[0],
+		Name:          segments[1],
+	}, nil
+}
+
+func ValidateMySqlFirewallRuleID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseMySqlFirewallRuleID(input)
+		return err
+	})
+}
+
+// MySqlVirtualNetworkRuleID is a parsed MySQL Virtual Network Rule Resource ID
+type MySqlVirtualNetworkRuleID struct {
+	ResourceGroup string
+	ServerName    string
+	Name          string
+}
+
+func (id MySqlVirtualNetworkRuleID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.DBforMySQL/servers/%s/virtualNetworkRules/%s", subscriptionId, id.ResourceGroup, id.ServerName, id.Name)
+}
+
+func ParseMySqlVirtualNetworkRuleID(input string) (*MySqlVirtualNetworkRuleID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.DBforMySQL", "MySQL Virtual Network Rule", "servers", "virtualNetworkRules")
+	if err != nil {
+		return nil, err
+	}
+
+	return &MySqlVirtualNetworkRuleID{
+		ResourceGroup: id.ResourceGroup,
+		ServerName:    segments[0],
+		Name:          segments[1],
+	}, nil
+}
+
+func ValidateMySqlVirtualNetworkRuleID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseMySqlVirtualNetworkRuleID(input)
+		return err
+	})
+}
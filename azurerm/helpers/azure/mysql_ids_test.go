@@ -0,0 +1,45 @@
+package azure
+
+import (
+	"testing"
+)
+
+func TestMysqlResourceIDs(t *testing.T) {
+	testResourceIDs(t, []resourceIDTestCase{
+		{
+			Name:  "MySQL Server",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.DBforMySQL/servers/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParseMySqlServerID(input)
+			},
+		},
+		{
+			Name:  "MySQL Configuration",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.DBforMySQL/servers/server1/configurations/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParseMySqlConfigurationID(input)
+			},
+		},
+		{
+			Name:  "MySQL Database",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.DBforMySQL/servers/server1/databases/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParseMySqlDatabaseID(input)
+			},
+		},
+		{
+			Name:  "MySQL Firewall Rule",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.DBforMySQL/servers/server1/firewallRules/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParseMySqlFirewallRuleID(input)
+			},
+		},
+		{
+			Name:  "MySQL Virtual Network Rule",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.DBforMySQL/servers/server1/virtualNetworkRules/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParseMySqlVirtualNetworkRuleID(input)
+			},
+		},
+	})
+}
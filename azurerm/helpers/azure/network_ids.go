@@ -0,0 +1,1085 @@
+package azure
+
+import (
+	"fmt"
+)
+
+// ApplicationGatewayID is a parsed Application Gateway Resource ID
+type ApplicationGatewayID struct {
+	ResourceGroup string
+	Name          string
+}
+
+func (id ApplicationGatewayID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/applicationGateways/%s", subscriptionId, id.ResourceGroup, id.Name)
+}
+
+func ParseApplicationGatewayID(input string) (*ApplicationGatewayID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.Network", "Application Gateway", "applicationGateways")
+	if err != nil {
+		return nil, err
+	}
+
+	return &ApplicationGatewayID{
+		ResourceGroup: id.ResourceGroup,
+		Name:          segments[0],
+	}, nil
+}
+
+func ValidateApplicationGatewayID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseApplicationGatewayID(input)
+		return err
+	})
+}
+
+// ApplicationSecurityGroupID is a parsed Application Security Group Resource ID
+type ApplicationSecurityGroupID struct {
+	ResourceGroup string
+	Name          string
+}
+
+func (id ApplicationSecurityGroupID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/applicationSecurityGroups/%s", subscriptionId, id.ResourceGroup, id.Name)
+}
+
+func ParseApplicationSecurityGroupID(input string) (*ApplicationSecurityGroupID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.Network", "Application Security Group", "applicationSecurityGroups")
+	if err != nil {
+		return nil, err
+	}
+
+	return &ApplicationSecurityGroupID{
+		ResourceGroup: id.ResourceGroup,
+		Name:          segments[0],
+	}, nil
+}
+
+func ValidateApplicationSecurityGroupID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseApplicationSecurityGroupID(input)
+		return err
+	})
+}
+
+// ConnectionMonitorID is a parsed Connection Monitor Resource ID
+type ConnectionMonitorID struct {
+	ResourceGroup      string
+	NetworkWatcherName string
+	Name               string
+}
+
+func (id ConnectionMonitorID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/networkWatchers/%s/connectionMonitors/%s", subscriptionId, id.ResourceGroup, id.NetworkWatcherName, id.Name)
+}
+
+func ParseConnectionMonitorID(input string) (*ConnectionMonitorID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.Network", "Connection Monitor", "networkWatchers", "connectionMonitors")
+	if err != nil {
+		return nil, err
+	}
+
+	return &ConnectionMonitorID{
+		ResourceGroup:      id.ResourceGroup,
+		NetworkWatcherName: segments[0],
+		Name:               segments[1],
+	}, nil
+}
+
+func ValidateConnectionMonitorID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseConnectionMonitorID(input)
+		return err
+	})
+}
+
+// DDoSProtectionPlanID is a parsed DDoS Protection Plan Resource ID
+type DDoSProtectionPlanID struct {
+	ResourceGroup string
+	Name          string
+}
+
+func (id DDoSProtectionPlanID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/ddosProtectionPlans/%s", subscriptionId, id.ResourceGroup, id.Name)
+}
+
+func ParseDDoSProtectionPlanID(input string) (*DDoSProtectionPlanID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.Network", "DDoS Protection Plan", "ddosProtectionPlans")
+	if err != nil {
+		return nil, err
+	}
+
+	return &DDoSProtectionPlanID{
+		ResourceGroup: id.ResourceGroup,
+		Name:          segments[0],
+	}, nil
+}
+
+func ValidateDDoSProtectionPlanID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseDDoSProtectionPlanID(input)
+		return err
+	})
+}
+
+// ExpressRouteCircuitID is a parsed ExpressRoute Circuit Resource ID
+type ExpressRouteCircuitID struct {
+	ResourceGroup string
+	Name          string
+}
+
+func (id ExpressRouteCircuitID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/expressRouteCircuits/%s", subscriptionId, id.ResourceGroup, id.Name)
+}
+
+func ParseExpressRouteCircuitID(input string) (*ExpressRouteCircuitID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.Network", "ExpressRoute Circuit", "expressRouteCircuits")
+	if err != nil {
+		return nil, err
+	}
+
+	return &ExpressRouteCircuitID{
+		ResourceGroup: id.ResourceGroup,
+		Name:          segments[0],
+	}, nil
+}
+
+func ValidateExpressRouteCircuitID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseExpressRouteCircuitID(input)
+		return err
+	})
+}
+
+// ExpressRouteCircuitAuthorizationID is a parsed ExpressRoute Circuit Authorization Resource ID
+type ExpressRouteCircuitAuthorizationID struct {
+	ResourceGroup string
+	CircuitName   string
+	Name          string
+}
+
+func (id ExpressRouteCircuitAuthorizationID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/expressRouteCircuits/%s/authorizations/%s", subscriptionId, id.ResourceGroup, id.CircuitName, id.Name)
+}
+
+func ParseExpressRouteCircuitAuthorizationID(input string) (*ExpressRouteCircuitAuthorizationID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.Network", "ExpressRoute Circuit Authorization", "expressRouteCircuits", "authorizations")
+	if err != nil {
+		return nil, err
+	}
+
+	return &ExpressRouteCircuitAuthorizationID{
+		ResourceGroup: id.ResourceGroup,
+		CircuitName:   segments[0],
+		Name:          segments[1],
+	}, nil
+}
+
+func ValidateExpressRouteCircuitAuthorizationID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseExpressRouteCircuitAuthorizationID(input)
+		return err
+	})
+}
+
+// ExpressRouteCircuitPeeringID is a parsed ExpressRoute Circuit Peering Resource ID
+type ExpressRouteCircuitPeeringID struct {
+	ResourceGroup string
+	CircuitName   string
+	Name          string
+}
+
+func (id ExpressRouteCircuitPeeringID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/expressRouteCircuits/%s/peerings/%s", subscriptionId, id.ResourceGroup, id.CircuitName, id.Name)
+}
+
+func ParseExpressRouteCircuitPeeringID(input string) (*ExpressRouteCircuitPeeringID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.Network", "ExpressRoute Circuit Peering", "expressRouteCircuits", "peerings")
+	if err != nil {
+		return nil, err
+	}
+
+	return &ExpressRouteCircuitPeeringID{
+		ResourceGroup: id.ResourceGroup,
+		CircuitName:   segments[0],
+		Name:          segments[1],
+	}, nil
+}
+
+func ValidateExpressRouteCircuitPeeringID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseExpressRouteCircuitPeeringID(input)
+		return err
+	})
+}
+
+// FirewallID is a parsed Firewall Resource ID
+type FirewallID struct {
+	ResourceGroup string
+	Name          string
+}
+
+func (id FirewallID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/azureFirewalls/%s", subscriptionId, id.ResourceGroup, id.Name)
+}
+
+func ParseFirewallID(input string) (*FirewallID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.Network", "Firewall", "azureFirewalls")
+	if err != nil {
+		return nil, err
+	}
+
+	return &FirewallID{
+		ResourceGroup: id.ResourceGroup,
+		Name:          segments[0],
+	}, nil
+}
+
+func ValidateFirewallID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseFirewallID(input)
+		return err
+	})
+}
+
+// FirewallApplicationRuleCollectionID is a parsed Firewall Application Rule Collection Resource ID
+type FirewallApplicationRuleCollectionID struct {
+	ResourceGroup string
+	FirewallName  string
+	Name          string
+}
+
+func (id FirewallApplicationRuleCollectionID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/azureFirewalls/%s/applicationRuleCollections/%s", subscriptionId, id.ResourceGroup, id.FirewallName, id.Name)
+}
+
+func ParseFirewallApplicationRuleCollectionID(input string) (*FirewallApplicationRuleCollectionID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.Network", "Firewall Application Rule Collection", "azureFirewalls", "applicationRuleCollections")
+	if err != nil {
+		return nil, err
+	}
+
+	return &FirewallApplicationRuleCollectionID{
+		ResourceGroup: id.ResourceGroup,
+		FirewallName:  segments[0],
+		Name:          segments[1],
+	}, nil
+}
+
+func ValidateFirewallApplicationRuleCollectionID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseFirewallApplicationRuleCollectionID(input)
+		return err
+	})
+}
+
+// FirewallNatRuleCollectionID is a parsed Firewall NAT Rule Collection Resource ID
+type FirewallNatRuleCollectionID struct {
+	ResourceGroup string
+	FirewallName  string
+	Name          string
+}
+
+func (id FirewallNatRuleCollectionID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/azureFirewalls/%s/natRuleCollections/%s", subscriptionId, id.ResourceGroup, id.FirewallName, id.Name)
+}
+
+func ParseFirewallNatRuleCollectionID(input string) (*FirewallNatRuleCollectionID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.Network", "Firewall NAT Rule Collection", "azureFirewalls", "natRuleCollections")
+	if err != nil {
+		return nil, err
+	}
+
+	return &FirewallNatRuleCollectionID{
+		ResourceGroup: id.ResourceGroup,
+		FirewallName:  segments[0],
+		Name:          segments[1],
+	}, nil
+}
+
+func ValidateFirewallNatRuleCollectionID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseFirewallNatRuleCollectionID(input)
+		return err
+	})
+}
+
+// FirewallNetworkRuleCollectionID is a parsed Firewall Network Rule Collection Resource ID
+type FirewallNetworkRuleCollectionID struct {
+	ResourceGroup string
+	FirewallName  string
+	Name          string
+}
+
+func (id FirewallNetworkRuleCollectionID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/azureFirewalls/%s/networkRuleCollections/%s", subscriptionId, id.ResourceGroup, id.FirewallName, id.Name)
+}
+
+func ParseFirewallNetworkRuleCollectionID(input string) (*FirewallNetworkRuleCollectionID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.Network", "Firewall Network Rule Collection", "azureFirewalls", "networkRuleCollections")
+	if err != nil {
+		return nil, err
+	}
+
+	return &FirewallNetworkRuleCollectionID{
+		ResourceGroup: id.ResourceGroup,
+		FirewallName:  segments[0],
+		Name:          segments[1],
+	}, nil
+}
+
+func ValidateFirewallNetworkRuleCollectionID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseFirewallNetworkRuleCollectionID(input)
+		return err
+	})
+}
+
+// LoadBalancerID is a parsed Load Balancer Resource ID
+type LoadBalancerID struct {
+	ResourceGroup string
+	Name          string
+}
+
+func (id LoadBalancerID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/loadBalancers/%s", subscriptionId, id.ResourceGroup, id.Name)
+}
+
+func ParseLoadBalancerID(input string) (*LoadBalancerID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.Network", "Load Balancer", "loadBalancers")
+	if err != nil {
+		return nil, err
+	}
+
+	return &LoadBalancerID{
+		ResourceGroup: id.ResourceGroup,
+		Name:          segments[0],
+	}, nil
+}
+
+func ValidateLoadBalancerID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseLoadBalancerID(input)
+		return err
+	})
+}
+
+// LoadBalancerBackendAddressPoolID is a parsed Load Balancer Backend Address Pool Resource ID
+type LoadBalancerBackendAddressPoolID struct {
+	ResourceGroup    string
+	LoadBalancerName string
+	Name             string
+}
+
+func (id LoadBalancerBackendAddressPoolID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/loadBalancers/%s/backendAddressPools/%s", subscriptionId, id.ResourceGroup, id.LoadBalancerName, id.Name)
+}
+
+func ParseLoadBalancerBackendAddressPoolID(input string) (*LoadBalancerBackendAddressPoolID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.Network", "Load Balancer Backend Address Pool", "loadBalancers", "backendAddressPools")
+	if err != nil {
+		return nil, err
+	}
+
+	return &LoadBalancerBackendAddressPoolID{
+		ResourceGroup:    id.ResourceGroup,
+		LoadBalancerName: segments[0],
+		Name:             segments[1],
+	}, nil
+}
+
+func ValidateLoadBalancerBackendAddressPoolID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseLoadBalancerBackendAddressPoolID(input)
+		return err
+	})
+}
+
+// LoadBalancerFrontendIPConfigurationID is a parsed Load Balancer Frontend IP Configuration Resource ID
+type LoadBalancerFrontendIPConfigurationID struct {
+	ResourceGroup    string
+	LoadBalancerName string
+	Name             string
+}
+
+func (id LoadBalancerFrontendIPConfigurationID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/loadBalancers/%s/frontendIPConfigurations/%s", subscriptionId, id.ResourceGroup, id.LoadBalancerName, id.Name)
+}
+
+func ParseLoadBalancerFrontendIPConfigurationID(input string) (*LoadBalancerFrontendIPConfigurationID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.Network", "Load Balancer Frontend IP Configuration", "loadBalancers", "frontendIPConfigurations")
+	if err != nil {
+		return nil, err
+	}
+
+	return &LoadBalancerFrontendIPConfigurationID{
+		ResourceGroup:    id.ResourceGroup,
+		LoadBalancerName: segments[0],
+		Name:             segments[1],
+	}, nil
+}
+
+func ValidateLoadBalancerFrontendIPConfigurationID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseLoadBalancerFrontendIPConfigurationID(input)
+		return err
+	})
+}
+
+// LoadBalancerInboundNatPoolID is a parsed Load Balancer Inbound NAT Pool Resource ID
+type LoadBalancerInboundNatPoolID struct {
+	ResourceGroup    string
+	LoadBalancerName string
+	Name             string
+}
+
+func (id LoadBalancerInboundNatPoolID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/loadBalancers/%s/inboundNatPools/%s", subscriptionId, id.ResourceGroup, id.LoadBalancerName, id.Name)
+}
+
+func ParseLoadBalancerInboundNatPoolID(input string) (*LoadBalancerInboundNatPoolID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.Network", "Load Balancer Inbound NAT Pool", "loadBalancers", "inboundNatPools")
+	if err != nil {
+		return nil, err
+	}
+
+	return &LoadBalancerInboundNatPoolID{
+		ResourceGroup:    id.ResourceGroup,
+		LoadBalancerName: segments[0],
+		Name:             segments[1],
+	}, nil
+}
+
+func ValidateLoadBalancerInboundNatPoolID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseLoadBalancerInboundNatPoolID(input)
+		return err
+	})
+}
+
+// LoadBalancerInboundNatRuleID is a parsed Load Balancer Inbound NAT Rule Resource ID
+type LoadBalancerInboundNatRuleID struct {
+	ResourceGroup    string
+	LoadBalancerName string
+	Name             string
+}
+
+func (id LoadBalancerInboundNatRuleID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/loadBalancers/%s/inboundNatRules/%s", subscriptionId, id.ResourceGroup, id.LoadBalancerName, id.Name)
+}
+
+func ParseLoadBalancerInboundNatRuleID(input string) (*LoadBalancerInboundNatRuleID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.Network", "Load Balancer Inbound NAT Rule", "loadBalancers", "inboundNatRules")
+	if err != nil {
+		return nil, err
+	}
+
+	return &LoadBalancerInboundNatRuleID{
+		ResourceGroup:    id.ResourceGroup,
+		LoadBalancerName: segments[0],
+		Name:             segments[1],
+	}, nil
+}
+
+func ValidateLoadBalancerInboundNatRuleID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseLoadBalancerInboundNatRuleID(input)
+		return err
+	})
+}
+
+// LoadBalancerOutboundRuleID is a parsed Load Balancer Outbound Rule Resource ID
+type LoadBalancerOutboundRuleID struct {
+	ResourceGroup    string
+	LoadBalancerName string
+	Name             string
+}
+
+func (id LoadBalancerOutboundRuleID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/loadBalancers/%s/outboundRules/%s", subscriptionId, id.ResourceGroup, id.LoadBalancerName, id.Name)
+}
+
+func ParseLoadBalancerOutboundRuleID(input string) (*LoadBalancerOutboundRuleID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.Network", "Load Balancer Outbound Rule", "loadBalancers", "outboundRules")
+	if err != nil {
+		return nil, err
+	}
+
+	return &LoadBalancerOutboundRuleID{
+		ResourceGroup:    id.ResourceGroup,
+		LoadBalancerName: segments[0],
+		Name:             segments[1],
+	}, nil
+}
+
+func ValidateLoadBalancerOutboundRuleID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseLoadBalancerOutboundRuleID(input)
+		return err
+	})
+}
+
+// LoadBalancerProbeID is a parsed Load Balancer Probe Resource ID
+type LoadBalancerProbeID struct {
+	ResourceGroup    string
+	LoadBalancerName string
+	Name             string
+}
+
+func (id LoadBalancerProbeID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/loadBalancers/%s/probes/%s", subscriptionId, id.ResourceGroup, id.LoadBalancerName, id.Name)
+}
+
+func ParseLoadBalancerProbeID(input string) (*LoadBalancerProbeID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.Network", "Load Balancer Probe", "loadBalancers", "probes")
+	if err != nil {
+		return nil, err
+	}
+
+	return &LoadBalancerProbeID{
+		ResourceGroup:    id.ResourceGroup,
+		LoadBalancerName: segments[0],
+		Name:             segments[1],
+	}, nil
+}
+
+func ValidateLoadBalancerProbeID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseLoadBalancerProbeID(input)
+		return err
+	})
+}
+
+// LoadBalancerRuleID is a parsed Load Balancer Rule Resource ID
+type LoadBalancerRuleID struct {
+	ResourceGroup    string
+	LoadBalancerName string
+	Name             string
+}
+
+func (id LoadBalancerRuleID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/loadBalancers/%s/loadBalancingRules/%s", subscriptionId, id.ResourceGroup, id.LoadBalancerName, id.Name)
+}
+
+func ParseLoadBalancerRuleID(input string) (*LoadBalancerRuleID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.Network", "Load Balancer Rule", "loadBalancers", "loadBalancingRules")
+	if err != nil {
+		return nil, err
+	}
+
+	return &LoadBalancerRuleID{
+		ResourceGroup:    id.ResourceGroup,
+		LoadBalancerName: segments[0],
+		Name:             segments[1],
+	}, nil
+}
+
+func ValidateLoadBalancerRuleID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseLoadBalancerRuleID(input)
+		return err
+	})
+}
+
+// LocalNetworkGatewayID is a parsed Local Network Gateway Resource ID
+type LocalNetworkGatewayID struct {
+	ResourceGroup string
+	Name          string
+}
+
+func (id LocalNetworkGatewayID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/localNetworkGateways/%s", subscriptionId, id.ResourceGroup, id.Name)
+}
+
+func ParseLocalNetworkGatewayID(input string) (*LocalNetworkGatewayID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.Network", "Local Network Gateway", "localNetworkGateways")
+	if err != nil {
+		return nil, err
+	}
+
+	return &LocalNetworkGatewayID{
+		ResourceGroup: id.ResourceGroup,
+		Name:          segments[0],
+	}, nil
+}
+
+func ValidateLocalNetworkGatewayID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseLocalNetworkGatewayID(input)
+		return err
+	})
+}
+
+// NetworkInterfaceID is a parsed Network Interface Resource ID
+type NetworkInterfaceID struct {
+	ResourceGroup string
+	Name          string
+}
+
+func (id NetworkInterfaceID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/networkInterfaces/%s", subscriptionId, id.ResourceGroup, id.Name)
+}
+
+func ParseNetworkInterfaceID(input string) (*NetworkInterfaceID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.Network", "Network Interface", "networkInterfaces")
+	if err != nil {
+		return nil, err
+	}
+
+	return &NetworkInterfaceID{
+		ResourceGroup: id.ResourceGroup,
+		Name:          segments[0],
+	}, nil
+}
+
+func ValidateNetworkInterfaceID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseNetworkInterfaceID(input)
+		return err
+	})
+}
+
+// NetworkProfileID is a parsed Network Profile Resource ID
+type NetworkProfileID struct {
+	ResourceGroup string
+	Name          string
+}
+
+func (id NetworkProfileID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/networkProfiles/%s", subscriptionId, id.ResourceGroup, id.Name)
+}
+
+func ParseNetworkProfileID(input string) (*NetworkProfileID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.Network", "Network Profile", "networkProfiles")
+	if err != nil {
+		return nil, err
+	}
+
+	return &NetworkProfileID{
+		ResourceGroup: id.ResourceGroup,
+		Name:          segments[0],
+	}, nil
+}
+
+func ValidateNetworkProfileID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseNetworkProfileID(input)
+		return err
+	})
+}
+
+// NetworkSecurityGroupID is a parsed Network Security Group Resource ID
+type NetworkSecurityGroupID struct {
+	ResourceGroup string
+	Name          string
+}
+
+func (id NetworkSecurityGroupID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/networkSecurityGroups/%s", subscriptionId, id.ResourceGroup, id.Name)
+}
+
+func ParseNetworkSecurityGroupID(input string) (*NetworkSecurityGroupID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.Network", "Network Security Group", "networkSecurityGroups")
+	if err != nil {
+		return nil, err
+	}
+
+	return &NetworkSecurityGroupID{
+		ResourceGroup: id.ResourceGroup,
+		Name:          segments[0],
+	}, nil
+}
+
+func ValidateNetworkSecurityGroupID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseNetworkSecurityGroupID(input)
+		return err
+	})
+}
+
+// NetworkSecurityRuleID is a parsed Network Security Rule Resource ID
+type NetworkSecurityRuleID struct {
+	ResourceGroup            string
+	NetworkSecurityGroupName string
+	Name                     string
+}
+
+func (id NetworkSecurityRuleID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/networkSecurityGroups/%s/securityRules/%s", subscriptionId, id.ResourceGroup, id.NetworkSecurityGroupName, id.Name)
+}
+
+func ParseNetworkSecurityRuleID(input string) (*NetworkSecurityRuleID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.Network", "Network Security Rule", "networkSecurityGroups", "securityRules")
+	if err != nil {
+		return nil, err
+	}
+
+	return &NetworkSecurityRuleID{
+		ResourceGroup:            id.ResourceGroup,
+		NetworkSecurityGroupName: segments[0],
+		Name:                     segments[1],
+	}, nil
+}
+
+func ValidateNetworkSecurityRuleID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseNetworkSecurityRuleID(input)
+		return err
+	})
+}
+
+// NetworkWatcherID is a parsed Network Watcher Resource ID
+type NetworkWatcherID struct {
+	ResourceGroup string
+	Name          string
+}
+
+func (id NetworkWatcherID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/networkWatchers/%s", subscriptionId, id.ResourceGroup, id.Name)
+}
+
+func ParseNetworkWatcherID(input string) (*NetworkWatcherID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.Network", "Network Watcher", "networkWatchers")
+	if err != nil {
+		return nil, err
+	}
+
+	return &NetworkWatcherID{
+		ResourceGroup: id.ResourceGroup,
+		Name:          segments[0],
+	}, nil
+}
+
+func ValidateNetworkWatcherID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseNetworkWatcherID(input)
+		return err
+	})
+}
+
+// PacketCaptureID is a parsed Packet Capture Resource ID
+type PacketCaptureID struct {
+	ResourceGroup      string
+	NetworkWatcherName string
+	Name               string
+}
+
+func (id PacketCaptureID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/networkWatchers/%s/packetCaptures/%s", subscriptionId, id.ResourceGroup, id.NetworkWatcherName, id.Name)
+}
+
+func ParsePacketCaptureID(input string) (*PacketCaptureID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.Network", "Packet Capture", "networkWatchers", "packetCaptures")
+	if err != nil {
+		return nil, err
+	}
+
+	return &PacketCaptureID{
+		ResourceGroup:      id.ResourceGroup,
+		NetworkWatcherName: segments[0],
+		Name:               segments[1],
+	}, nil
+}
+
+func ValidatePacketCaptureID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParsePacketCaptureID(input)
+		return err
+	})
+}
+
+// PublicIPAddressID is a parsed Public IP Address Resource ID
+type PublicIPAddressID struct {
+	ResourceGroup string
+	Name          string
+}
+
+func (id PublicIPAddressID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/publicIPAddresses/%s", subscriptionId, id.ResourceGroup, id.Name)
+}
+
+func ParsePublicIPAddressID(input string) (*PublicIPAddressID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.Network", "Public IP Address", "publicIPAddresses")
+	if err != nil {
+		return nil, err
+	}
+
+	return &PublicIPAddressID{
+		ResourceGroup: id.ResourceGroup,
+		Name:          segments[0],
+	}, nil
+}
+
+func ValidatePublicIPAddressID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParsePublicIPAddressID(input)
+		return err
+	})
+}
+
+// PublicIPPrefixID is a parsed Public IP Prefix Resource ID
+type PublicIPPrefixID struct {
+	ResourceGroup string
+	Name          string
+}
+
+func (id PublicIPPrefixID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/publicIPPrefixes/%s", subscriptionId, id.ResourceGroup, id.Name)
+}
+
+func ParsePublicIPPrefixID(input string) (*PublicIPPrefixID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.Network", "Public IP Prefix", "publicIPPrefixes")
+	if err != nil {
+		return nil, err
+	}
+
+	return &PublicIPPrefixID{
+		ResourceGroup: id.ResourceGroup,
+		Name:          segments[0],
+	}, nil
+}
+
+func ValidatePublicIPPrefixID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParsePublicIPPrefixID(input)
+		return err
+	})
+}
+
+// RouteTableID is a parsed Route Table Resource ID
+type RouteTableID struct {
+	ResourceGroup string
+	Name          string
+}
+
+func (id RouteTableID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/routeTables/%s", subscriptionId, id.ResourceGroup, id.Name)
+}
+
+func ParseRouteTableID(input string) (*RouteTableID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.Network", "Route Table", "routeTables")
+	if err != nil {
+		return nil, err
+	}
+
+	return &RouteTableID{
+		ResourceGroup: id.ResourceGroup,
+		Name:          segments[0],
+	}, nil
+}
+
+func ValidateRouteTableID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseRouteTableID(input)
+		return err
+	})
+}
+
+// RouteID is a parsed Route Resource ID
+type RouteID struct {
+	ResourceGroup  string
+	RouteTableName string
+	Name           string
+}
+
+func (id RouteID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/routeTables/%s/routes/%s", subscriptionId, id.ResourceGroup, id.RouteTableName, id.Name)
+}
+
+func ParseRouteID(input string) (*RouteID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.Network", "Route", "routeTables", "routes")
+	if err != nil {
+		return nil, err
+	}
+
+	return &RouteID{
+		ResourceGroup:  id.ResourceGroup,
+		RouteTableName: segments[0],
+		Name:           segments[1],
+	}, nil
+}
+
+func ValidateRouteID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseRouteID(input)
+		return err
+	})
+}
+
+// SubnetID is a parsed Subnet Resource ID
+type SubnetID struct {
+	ResourceGroup      string
+	VirtualNetworkName string
+	Name               string
+}
+
+func (id SubnetID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/virtualNetworks/%s/subnets/%s", subscriptionId, id.ResourceGroup, id.VirtualNetworkName, id.Name)
+}
+
+func ParseSubnetID(input string) (*SubnetID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.Network", "Subnet", "virtualNetworks", "subnets")
+	if err != nil {
+		return nil, err
+	}
+
+	return &SubnetID{
+		ResourceGroup:      id.ResourceGroup,
+		VirtualNetworkName: segments[0],
+		Name:               segments[1],
+	}, nil
+}
+
+func ValidateSubnetID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseSubnetID(input)
+		return err
+	})
+}
+
+// TrafficManagerProfileID is a parsed Traffic Manager Profile Resource ID
+type TrafficManagerProfileID struct {
+	ResourceGroup string
+	Name          string
+}
+
+func (id TrafficManagerProfileID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/trafficManagerProfiles/%s", subscriptionId, id.ResourceGroup, id.Name)
+}
+
+func ParseTrafficManagerProfileID(input string) (*TrafficManagerProfileID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.Network", "Traffic Manager Profile", "trafficManagerProfiles")
+	if err != nil {
+		return nil, err
+	}
+
+	return &TrafficManagerProfileID{
+		ResourceGroup: id.ResourceGroup,
+		Name:          segments[0],
+	}, nil
+}
+
+func ValidateTrafficManagerProfileID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseTrafficManagerProfileID(input)
+		return err
+	})
+}
+
+// VirtualNetworkID is a parsed Virtual Network Resource ID
+type VirtualNetworkID struct {
+	ResourceGroup string
+	Name          string
+}
+
+func (id VirtualNetworkID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/virtualNetworks/%s", subscriptionId, id.ResourceGroup, id.Name)
+}
+
+func ParseVirtualNetworkID(input string) (*VirtualNetworkID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.Network", "Virtual Network", "virtualNetworks")
+	if err != nil {
+		return nil, err
+	}
+
+	return &VirtualNetworkID{
+		ResourceGroup: id.ResourceGroup,
+		Name:          segments[0],
+	}, nil
+}
+
+func ValidateVirtualNetworkID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseVirtualNetworkID(input)
+		return err
+	})
+}
+
+// VirtualNetworkGatewayID is a parsed Virtual Network Gateway Resource ID
+type VirtualNetworkGatewayID struct {
+	ResourceGroup string
+	Name          string
+}
+
+func (id VirtualNetworkGatewayID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/virtualNetworkGateways/%s", subscriptionId, id.ResourceGroup, id.Name)
+}
+
+func ParseVirtualNetworkGatewayID(input string) (*VirtualNetworkGatewayID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.Network", "Virtual Network Gateway", "virtualNetworkGateways")
+	if err != nil {
+		return nil, err
+	}
+
+	return &VirtualNetworkGatewayID{
+		ResourceGroup: id.ResourceGroup,
+		Name:          segments[0],
+	}, nil
+}
+
+func ValidateVirtualNetworkGatewayID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseVirtualNetworkGatewayID(input)
+		return err
+	})
+}
+
+// VirtualNetworkGatewayConnectionID is a parsed Virtual Network Gateway Connection Resource ID
+type VirtualNetworkGatewayConnectionID struct {
+	ResourceGroup string
+	Name          string
+}
+
+func (id VirtualNetworkGatewayConnectionID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/connections/%s", subscriptionId, id.ResourceGroup, id.Name)
+}
+
+func ParseVirtualNetworkGatewayConnectionID(input string) (*VirtualNetworkGatewayConnectionID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.Network", "Virtual Network Gateway Connection", "connections")
+	if err != nil {
+		return nil, err
+	}
+
+	return &VirtualNetworkGatewayConnectionID{
+		ResourceGroup: id.ResourceGroup,
+		Name:          segments[0],
+	}, nil
+}
+
+func ValidateVirtualNetworkGatewayConnectionID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseVirtualNetworkGatewayConnectionID(input)
+		return err
+	})
+}
+
+// VirtualNetworkPeeringID is a parsed Virtual Network Peering Resource ID
+type VirtualNetworkPeeringID struct {
+	ResourceGroup      string
+	VirtualNetworkName string
+	Name               string
+}
+
+func (id VirtualNetworkPeeringID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/virtualNetworks/%s/virtualNetworkPeerings/%s", subscriptionId, id.ResourceGroup, id.VirtualNetworkName, id.Name)
+}
+
+func ParseVirtualNetworkPeeringID(input string) (*VirtualNetworkPeeringID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.Network", "Virtual Network Peering", "virtualNetworks", "virtualNetworkPeerings")
+	if err != nil {
+		return nil, err
+	}
+
+	return &VirtualNetworkPeeringID{
+		ResourceGroup:      id.ResourceGroup,
+		VirtualNetworkName: segments[0],
+		Name:               segments[1],
+	}, nil
+}
+
+func ValidateVirtualNetworkPeeringID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseVirtualNetworkPeeringID(input)
+		return err
+	})
+}
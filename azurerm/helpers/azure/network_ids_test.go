@@ -0,0 +1,262 @@
+package azure
+
+import (
+	"testing"
+)
+
+func TestNetworkResourceIDs(t *testing.T) {
+	testResourceIDs(t, []resourceIDTestCase{
+		{
+			Name:  "Application Gateway",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/applicationGateways/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParseApplicationGatewayID(input)
+			},
+		},
+		{
+			Name:  "Application Security Group",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/applicationSecurityGroups/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParseApplicationSecurityGroupID(input)
+			},
+		},
+		{
+			Name:  "Connection Monitor",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/networkWatchers/networkwatcher1/connectionMonitors/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParseConnectionMonitorID(input)
+			},
+		},
+		{
+			Name:  "DDoS Protection Plan",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/ddosProtectionPlans/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParseDDoSProtectionPlanID(input)
+			},
+		},
+		{
+			Name:  "ExpressRoute Circuit",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/expressRouteCircuits/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParseExpressRouteCircuitID(input)
+			},
+		},
+		{
+			Name:  "ExpressRoute Circuit Authorization",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/expressRouteCircuits/circuit1/authorizations/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParseExpressRouteCircuitAuthorizationID(input)
+			},
+		},
+		{
+			Name:  "ExpressRoute Circuit Peering",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/expressRouteCircuits/circuit1/peerings/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParseExpressRouteCircuitPeeringID(input)
+			},
+		},
+		{
+			Name:  "Firewall",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/azureFirewalls/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParseFirewallID(input)
+			},
+		},
+		{
+			Name:  "Firewall Application Rule Collection",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/azureFirewalls/firewall1/applicationRuleCollections/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParseFirewallApplicationRuleCollectionID(input)
+			},
+		},
+		{
+			Name:  "Firewall NAT Rule Collection",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/azureFirewalls/firewall1/natRuleCollections/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParseFirewallNatRuleCollectionID(input)
+			},
+		},
+		{
+			Name:  "Firewall Network Rule Collection",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/azureFirewalls/firewall1/networkRuleCollections/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParseFirewallNetworkRuleCollectionID(input)
+			},
+		},
+		{
+			Name:  "Load Balancer",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/loadBalancers/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParseLoadBalancerID(input)
+			},
+		},
+		{
+			Name:  "Load Balancer Backend Address Pool",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/loadBalancers/loadbalancer1/backendAddressPools/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParseLoadBalancerBackendAddressPoolID(input)
+			},
+		},
+		{
+			Name:  "Load Balancer Frontend IP Configuration",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/loadBalancers/loadbalancer1/frontendIPConfigurations/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParseLoadBalancerFrontendIPConfigurationID(input)
+			},
+		},
+		{
+			Name:  "Load Balancer Inbound NAT Pool",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/loadBalancers/loadbalancer1/inboundNatPools/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParseLoadBalancerInboundNatPoolID(input)
+			},
+		},
+		{
+			Name:  "Load Balancer Inbound NAT Rule",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/loadBalancers/loadbalancer1/inboundNatRules/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParseLoadBalancerInboundNatRuleID(input)
+			},
+		},
+		{
+			Name:  "Load Balancer Outbound Rule",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/loadBalancers/loadbalancer1/outboundRules/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParseLoadBalancerOutboundRuleID(input)
+			},
+		},
+		{
+			Name:  "Load Balancer Probe",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/loadBalancers/loadbalancer1/probes/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParseLoadBalancerProbeID(input)
+			},
+		},
+		{
+			Name:  "Load Balancer Rule",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/loadBalancers/loadbalancer1/loadBalancingRules/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParseLoadBalancerRuleID(input)
+			},
+		},
+		{
+			Name:  "Local Network Gateway",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/localNetworkGateways/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParseLocalNetworkGatewayID(input)
+			},
+		},
+		{
+			Name:  "Network Interface",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/networkInterfaces/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParseNetworkInterfaceID(input)
+			},
+		},
+		{
+			Name:  "Network Profile",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/networkProfiles/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParseNetworkProfileID(input)
+			},
+		},
+		{
+			Name:  "Network Security Group",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/networkSecurityGroups/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParseNetworkSecurityGroupID(input)
+			},
+		},
+		{
+			Name:  "Network Security Rule",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/networkSecurityGroups/networksecuritygroup1/securityRules/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParseNetworkSecurityRuleID(input)
+			},
+		},
+		{
+			Name:  "Network Watcher",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/networkWatchers/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParseNetworkWatcherID(input)
+			},
+		},
+		{
+			Name:  "Packet Capture",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/networkWatchers/networkwatcher1/packetCaptures/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParsePacketCaptureID(input)
+			},
+		},
+		{
+			Name:  "Public IP Address",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/publicIPAddresses/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParsePublicIPAddressID(input)
+			},
+		},
+		{
+			Name:  "Public IP Prefix",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/publicIPPrefixes/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParsePublicIPPrefixID(input)
+			},
+		},
+		{
+			Name:  "Route Table",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/routeTables/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParseRouteTableID(input)
+			},
+		},
+		{
+			Name:  "Route",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/routeTables/routetable1/routes/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParseRouteID(input)
+			},
+		},
+		{
+			Name:  "Subnet",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/virtualnetwork1/subnets/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParseSubnetID(input)
+			},
+		},
+		{
+			Name:  "Traffic Manager Profile",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/trafficManagerProfiles/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParseTrafficManagerProfileID(input)
+			},
+		},
+		{
+			Name:  "Virtual Network",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParseVirtualNetworkID(input)
+			},
+		},
+		{
+			Name:  "Virtual Network Gateway",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworkGateways/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParseVirtualNetworkGatewayID(input)
+			},
+		},
+		{
+			Name:  "Virtual Network Gateway Connection",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/connections/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParseVirtualNetworkGatewayConnectionID(input)
+			},
+		},
+		{
+			Name:  "Virtual Network Peering",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/virtualnetwork1/virtualNetworkPeerings/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParseVirtualNetworkPeeringID(input)
+			},
+		},
+	})
+}
@@ -0,0 +1,98 @@
+package azure
+
+import (
+	"fmt"
+)
+
+// NotificationHubNamespaceID is a parsed Notification Hub Namespace Resource ID
+type NotificationHubNamespaceID struct {
+	ResourceGroup string
+	Name          string
+}
+
+func (id NotificationHubNamespaceID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.NotificationHubs/namespaces/%s", subscriptionId, id.ResourceGroup, id.Name)
+}
+
+func ParseNotificationHubNamespaceID(input string) (*NotificationHubNamespaceID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.NotificationHubs", "Notification Hub Namespace", "namespaces")
+	if err != nil {
+		return nil, err
+	}
+
+	return &NotificationHubNamespaceID{
+		ResourceGroup: id.ResourceGroup,
+		Name:          segments[0],
+	}, nil
+}
+
+func ValidateNotificationHubNamespaceID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseNotificationHubNamespaceID(input)
+		return err
+	})
+}
+
+// NotificationHubID is a parsed Notification Hub Resource ID
+type NotificationHubID struct {
+	ResourceGroup string
+	NamespaceName string
+	Name          string
+}
+
+func (id NotificationHubID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.NotificationHubs/namespaces/%s/notificationHubs/%s", subscriptionId, id.ResourceGroup, id.NamespaceName, id.Name)
+}
+
+func ParseNotificationHubID(input string) (*NotificationHubID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.NotificationHubs", "Notification Hub", "namespaces", "notificationHubs")
+	if err != nil {
+		return nil, err
+	}
+
+	return &NotificationHubID{
+		ResourceGroup: id.ResourceGroup,
+		NamespaceName: segments[0],
+		Name:          segments[1],
+	}, nil
+}
+
+func ValidateNotificationHubID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseNotificationHubID(input)
+		return err
+	})
+}
+
+// NotificationHubAuthorizationRuleID is a parsed Notification Hub Authorization Rule Resource ID
+type NotificationHubAuthorizationRuleID struct {
+	ResourceGroup       string
+	NamespaceName       string
+	NotificationHubName string
+	Name                string
+}
+
+func (id NotificationHubAuthorizationRuleID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.NotificationHubs/namespaces/%s/notificationHubs/%s/AuthorizationRules/%s", subscriptionId, id.ResourceGroup, id.NamespaceName, id.NotificationHubName, id.Name)
+}
+
+func ParseNotificationHubAuthorizationRuleID(input string) (*NotificationHubAuthorizationRuleID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.NotificationHubs", "Notification Hub Authorization Rule", "namespaces", "notificationHubs", "AuthorizationRules")
+	if err != nil {
+		return nil, err
+	}
+
+	return &NotificationHubAuthorizationRuleID{
+		ResourceGroup:       id.ResourceGroup,
+		NamespaceName:       segments[0],
+		NotificationHubName: segments[1],
+		Name:                segments[2],
+	}, nil
+}
+
+func ValidateNotificationHubAuthorizationRuleID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseNotificationHubAuthorizationRuleID(input)
+		return err
+	})
+}
@@ -0,0 +1,31 @@
+package azure
+
+import (
+	"testing"
+)
+
+func TestNotificationHubResourceIDs(t *testing.T) {
+	testResourceIDs(t, []resourceIDTestCase{
+		{
+			Name:  "Notification Hub Namespace",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.NotificationHubs/namespaces/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParseNotificationHubNamespaceID(input)
+			},
+		},
+		{
+			Name:  "Notification Hub",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.NotificationHubs/namespaces/namespace1/notificationHubs/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParseNotificationHubID(input)
+			},
+		},
+		{
+			Name:  "Notification Hub Authorization Rule",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.NotificationHubs/namespaces/namespace1/notificationHubs/notificationhub1/AuthorizationRules/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParseNotificationHubAuthorizationRuleID(input)
+			},
+		},
+	})
+}
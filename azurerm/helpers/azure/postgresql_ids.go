@@ -0,0 +1,158 @@
+package azure
+
+import (
+	"fmt"
+)
+
+// PostgreSQLServerID is a parsed PostgreSQL Server Resource ID
+type PostgreSQLServerID struct {
+	ResourceGroup string
+	Name          string
+}
+
+func (id PostgreSQLServerID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.DBforPostgreSQL/servers/%s", subscriptionId, id.ResourceGroup, id.Name)
+}
+
+func ParsePostgreSQLServerID(input string) (*PostgreSQLServerID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.DBforPostgreSQL", "PostgreSQL Server", "servers")
+	if err != nil {
+		return nil, err
+	}
+
+	return &PostgreSQLServerID{
+		ResourceGroup: id.ResourceGroup,
+		Name:          segments[0],
+	}, nil
+}
+
+func ValidatePostgreSQLServerID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParsePostgreSQLServerID(input)
+		return err
+	})
+}
+
+// PostgreSQLConfigurationID is a parsed PostgreSQL Configuration Resource ID
+type PostgreSQLConfigurationID struct {
+	ResourceGroup string
+	ServerName    string
+	Name          string
+}
+
+func (id PostgreSQLConfigurationID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.DBforPostgreSQL/servers/%s/configurations/%s", subscriptionId, id.ResourceGroup, id.ServerName, id.Name)
+}
+
+func ParsePostgreSQLConfigurationID(input string) (*PostgreSQLConfigurationID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.DBforPostgreSQL", "PostgreSQL Configuration", "servers", "configurations")
+	if err != nil {
+		return nil, err
+	}
+
+	return &PostgreSQLConfigurationID{
+		ResourceGroup: id.ResourceGroup,
+		ServerName:    segments[0],
+		Name:          segments[1],
+	}, nil
+}
+
+func ValidatePostgreSQLConfigurationID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParsePostgreSQLConfigurationID(input)
+		return err
+	})
+}
+
+// PostgreSQLDatabaseID is a parsed PostgreSQL Database Resource ID
+type PostgreSQLDatabaseID struct {
+	ResourceGroup string
+	ServerName    string
+	Name          string
+}
+
+func (id PostgreSQLDatabaseID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.DBforPostgreSQL/servers/%s/databases/%s", subscriptionId, id.ResourceGroup, id.ServerName, id.Name)
+}
+
+func ParsePostgreSQLDatabaseID(input string) (*PostgreSQLDatabaseID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.DBforPostgreSQL", "PostgreSQL Database", "servers", "databases")
+	if err != nil {
+		return nil, err
+	}
+
+	return &PostgreSQLDatabaseID{
+		ResourceGroup: id.ResourceGroup,
+		ServerName:    segments[0],
+		Name:          segments[1],
+	}, nil
+}
+
+func ValidatePostgreSQLDatabaseID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParsePostgreSQLDatabaseID(input)
+		return err
+	})
+}
+
+// PostgreSQLFirewallRuleID is a parsed PostgreSQL Firewall Rule Resource ID
+type PostgreSQLFirewallRuleID struct {
+	ResourceGroup string
+	ServerName    string
+	Name          string
+}
+
+func (id PostgreSQLFirewallRuleID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.DBforPostgreSQL/servers/%s/firewallRules/%s", subscriptionId, id.ResourceGroup, id.ServerName, id.Name)
+}
+
+func ParsePostgreSQLFirewallRuleID(input string) (*PostgreSQLFirewallRuleID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.DBforPostgreSQL", "PostgreSQL Firewall Rule", "servers", "firewallRules")
+	if err != nil {
+		return nil, err
+	}
+
+	return &PostgreSQLFirewallRuleID{
+		ResourceGroup: id.ResourceGroup,
+		ServerName:    segments[0],
+		Name:          segments[1],
+	}, nil
+}
+
+func ValidatePostgreSQLFirewallRuleID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParsePostgreSQLFirewallRuleID(input)
+		return err
+	})
+}
+
+// PostgreSQLVirtualNetworkRuleID is a parsed PostgreSQL Virtual Network Rule Resource ID
+type PostgreSQLVirtualNetworkRuleID struct {
+	ResourceGroup string
+	ServerName    string
+	Name          string
+}
+
+func (id PostgreSQLVirtualNetworkRuleID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.DBforPostgreSQL/servers/%s/virtualNetworkRules/%s", subscriptionId, id.ResourceGroup, id.ServerName, id.Name)
+}
+
+func ParsePostgreSQLVirtualNetworkRuleID(input string) (*PostgreSQLVirtualNetworkRuleID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.DBforPostgreSQL", "PostgreSQL Virtual Network Rule", "servers", "virtualNetworkRules")
+	if err != nil {
+		return nil, err
+	}
+
+	return &PostgreSQLVirtualNetworkRuleID{
+		ResourceGroup: id.ResourceGroup,
+		ServerName:    segments[0],
+		Name:          segments[1],
+	}, nil
+}
+
+func ValidatePostgreSQLVirtualNetworkRuleID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParsePostgreSQLVirtualNetworkRuleID(input)
+		return err
+	})
+}
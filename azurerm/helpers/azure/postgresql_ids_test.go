@@ -0,0 +1,45 @@
+package azure
+
+import (
+	"testing"
+)
+
+func TestPostgresqlResourceIDs(t *testing.T) {
+	testResourceIDs(t, []resourceIDTestCase{
+		{
+			Name:  "PostgreSQL Server",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.DBforPostgreSQL/servers/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParsePostgreSQLServerID(input)
+			},
+		},
+		{
+			Name:  "PostgreSQL Configuration",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.DBforPostgreSQL/servers/server1/configurations/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParsePostgreSQLConfigurationID(input)
+			},
+		},
+		{
+			Name:  "PostgreSQL Database",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.DBforPostgreSQL/servers/server1/databases/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParsePostgreSQLDatabaseID(input)
+			},
+		},
+		{
+			Name:  "PostgreSQL Firewall Rule",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.DBforPostgreSQL/servers/server1/firewallRules/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParsePostgreSQLFirewallRuleID(input)
+			},
+		},
+		{
+			Name:  "PostgreSQL Virtual Network Rule",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.DBforPostgreSQL/servers/server1/virtualNetworkRules/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParsePostgreSQLVirtualNetworkRuleID(input)
+			},
+		},
+	})
+}
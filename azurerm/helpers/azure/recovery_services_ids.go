@@ -0,0 +1,100 @@
+package azure
+
+import (
+	"fmt"
+)
+
+// RecoveryServicesVaultID is a parsed Recovery Services Vault Resource ID
+type RecoveryServicesVaultID struct {
+	ResourceGroup string
+	Name          string
+}
+
+func (id RecoveryServicesVaultID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.RecoveryServices/vaults/%s", subscriptionId, id.ResourceGroup, id.Name)
+}
+
+func ParseRecoveryServicesVaultID(input string) (*RecoveryServicesVaultID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.RecoveryServices", "Recovery Services Vault", "vaults")
+	if err != nil {
+		return nil, err
+	}
+
+	return &RecoveryServicesVaultID{
+		ResourceGroup: id.ResourceGroup,
+		Name:          segments[0],
+	}, nil
+}
+
+func ValidateRecoveryServicesVaultID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseRecoveryServicesVaultID(input)
+		return err
+	})
+}
+
+// RecoveryServicesProtectionPolicyID is a parsed Recovery Services Protection Policy Resource ID
+type RecoveryServicesProtectionPolicyID struct {
+	ResourceGroup string
+	VaultName     string
+	Name          string
+}
+
+func (id RecoveryServicesProtectionPolicyID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.RecoveryServices/vaults/%s/backupPolicies/%s", subscriptionId, id.ResourceGroup, id.VaultName, id.Name)
+}
+
+func ParseRecoveryServicesProtectionPolicyID(input string) (*RecoveryServicesProtectionPolicyID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.RecoveryServices", "Recovery Services Protection Policy", "vaults", "backupPolicies")
+	if err != nil {
+		return nil, err
+	}
+
+	return &RecoveryServicesProtectionPolicyID{
+		ResourceGroup: id.ResourceGroup,
+		VaultName:     segments[0],
+		Name:          segments[1],
+	}, nil
+}
+
+func ValidateRecoveryServicesProtectionPolicyID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseRecoveryServicesProtectionPolicyID(input)
+		return err
+	})
+}
+
+// RecoveryServicesProtectedItemID is a parsed Recovery Services Protected Item Resource ID
+type RecoveryServicesProtectedItemID struct {
+	ResourceGroup           string
+	VaultName               string
+	FabricName              string
+	ProtectionContainerName string
+	Name                    string
+}
+
+func (id RecoveryServicesProtectedItemID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.RecoveryServices/vaults/%s/backupFabrics/%s/protectionContainers/%s/protectedItems/%s", subscriptionId, id.ResourceGroup, id.VaultName, id.FabricName, id.ProtectionContainerName, id.Name)
+}
+
+func ParseRecoveryServicesProtectedItemID(input string) (*RecoveryServicesProtectedItemID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.RecoveryServices", "Recovery Services Protected Item", "vaults", "backupFabrics", "protectionContainers", "protectedItems")
+	if err != nil {
+		return nil, err
+	}
+
+	return &RecoveryServicesProtectedItemID{
+		ResourceGroup:           id.ResourceGroup,
+		VaultName:               segments[0],
+		FabricName:              segments[1],
+		ProtectionContainerName: segments[2],
+		Name:                    segments[3],
+	}, nil
+}
+
+func ValidateRecoveryServicesProtectedItemID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseRecoveryServicesProtectedItemID(input)
+		return err
+	})
+}
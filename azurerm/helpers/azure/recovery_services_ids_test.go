@@ -0,0 +1,31 @@
+package azure
+
+import (
+	"testing"
+)
+
+func TestRecoveryServicesResourceIDs(t *testing.T) {
+	testResourceIDs(t, []resourceIDTestCase{
+		{
+			Name:  "Recovery Services Vault",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.RecoveryServices/vaults/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParseRecoveryServicesVaultID(input)
+			},
+		},
+		{
+			Name:  "Recovery Services Protection Policy",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.RecoveryServices/vaults/vault1/backupPolicies/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParseRecoveryServicesProtectionPolicyID(input)
+			},
+		},
+		{
+			Name:  "Recovery Services Protected Item",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.RecoveryServices/vaults/vault1/backupFabrics/fabric1/protectionContainers/protectioncontainer1/protectedItems/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParseRecoveryServicesProtectedItemID(input)
+			},
+		},
+	})
+}
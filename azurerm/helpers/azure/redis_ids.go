@@ -0,0 +1,65 @@
+package azure
+
+import (
+	"fmt"
+)
+
+// RedisCacheID is a parsed Redis Cache Resource ID
+type RedisCacheID struct {
+	ResourceGroup string
+	Name          string
+}
+
+func (id RedisCacheID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Cache/Redis/%s", subscriptionId, id.ResourceGroup, id.Name)
+}
+
+func ParseRedisCacheID(input string) (*RedisCacheID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.Cache", "Redis Cache", "Redis")
+	if err != nil {
+		return nil, err
+	}
+
+	return &RedisCacheID{
+		ResourceGroup: id.ResourceGroup,
+		Name:          segments[0],
+	}, nil
+}
+
+func ValidateRedisCacheID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseRedisCacheID(input)
+		return err
+	})
+}
+
+// RedisFirewallRuleID is a parsed Redis Firewall Rule Resource ID
+type RedisFirewallRuleID struct {
+	ResourceGroup string
+	CacheName     string
+	Name          string
+}
+
+func (id RedisFirewallRuleID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Cache/Redis/%s/firewallRules/%s", subscriptionId, id.ResourceGroup, id.CacheName, id.Name)
+}
+
+func ParseRedisFirewallRuleID(input string) (*RedisFirewallRuleID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.Cache", "Redis Firewall Rule", "Redis", "firewallRules")
+	if err != nil {
+		return nil, err
+	}
+
+	return &RedisFirewallRuleID{
+		ResourceGroup: id.ResourceGroup,
+		CacheName:     segments[0],
+		Name:          segments[1],
+	}, nil
+}
+
+func ValidateRedisFirewallRuleID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseRedisFirewallRuleID(input)
+		return err
+	})
+}
@@ -0,0 +1,24 @@
+package azure
+
+import (
+	"testing"
+)
+
+func TestRedisResourceIDs(t *testing.T) {
+	testResourceIDs(t, []resourceIDTestCase{
+		{
+			Name:  "Redis Cache",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Cache/Redis/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParseRedisCacheID(input)
+			},
+		},
+		{
+			Name:  "Redis Firewall Rule",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Cache/Redis/cache1/firewallRules/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParseRedisFirewallRuleID(input)
+			},
+		},
+	})
+}
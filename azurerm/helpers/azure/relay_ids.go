@@ -0,0 +1,34 @@
+package azure
+
+import (
+	"fmt"
+)
+
+// RelayNamespaceID is a parsed Relay Namespace Resource ID
+type RelayNamespaceID struct {
+	ResourceGroup string
+	Name          string
+}
+
+func (id RelayNamespaceID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Relay/namespaces/%s", subscriptionId, id.ResourceGroup, id.Name)
+}
+
+func ParseRelayNamespaceID(input string) (*RelayNamespaceID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.Relay", "Relay Namespace", "namespaces")
+	if err != nil {
+		return nil, err
+	}
+
+	return &RelayNamespaceID{
+		ResourceGroup: id.ResourceGroup,
+		Name:          segments[0],
+	}, nil
+}
+
+func ValidateRelayNamespaceID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseRelayNamespaceID(input)
+		return err
+	})
+}
@@ -0,0 +1,17 @@
+package azure
+
+import (
+	"testing"
+)
+
+func TestRelayResourceIDs(t *testing.T) {
+	testResourceIDs(t, []resourceIDTestCase{
+		{
+			Name:  "Relay Namespace",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Relay/namespaces/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParseRelayNamespaceID(input)
+			},
+		},
+	})
+}
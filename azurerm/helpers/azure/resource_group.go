@@ -35,6 +35,41 @@ func SchemaResourceGroupNameForDataSource() *schema.Schema {
 	}
 }
 
+// ResourceGroupID is a parsed Resource Group Resource ID
+type ResourceGroupID struct {
+	Name string
+}
+
+func (id ResourceGroupID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s", subscriptionId, id.Name)
+}
+
+func ParseResourceGroupID(input string) (*ResourceGroupID, error) {
+	id, err := ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Resource Group ID %q: %+v", input, err)
+	}
+
+	if id.Provider != "" {
+		return nil, fmt.Errorf("Error parsing Resource Group ID: ID contained more segments than required: %q", input)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Resource Group ID: %+v", err)
+	}
+
+	return &ResourceGroupID{
+		Name: id.ResourceGroup,
+	}, nil
+}
+
+func ValidateResourceGroupID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseResourceGroupID(input)
+		return err
+	})
+}
+
 func validateResourceGroupName(v interface{}, k string) (warnings []string, errors []error) {
 	value := v.(string)
 
@@ -65,3 +65,15 @@ func TestValidateResourceGroupName(t *testing.T) {
 		}
 	}
 }
+
+func TestResourceGroupResourceIDs(t *testing.T) {
+	testResourceIDs(t, []resourceIDTestCase{
+		{
+			Name:  "Resource Group",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParseResourceGroupID(input)
+			},
+		},
+	})
+}
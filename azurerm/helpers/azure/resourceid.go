@@ -3,6 +3,7 @@ package azure
 import (
 	"fmt"
 	"net/url"
+	"sort"
 	"strings"
 )
 
@@ -94,3 +95,106 @@ func ParseAzureResourceID(id string) (*ResourceID, error) {
 
 	return idObj, nil
 }
+
+// PopSegment retrieves a segment from the Path and removes it from the ResourceID, such that it's possible
+// to check all segments have been consumed via ValidateNoEmptySegments. Since Azure isn't consistent
+// in the casing of segment names, if there's no exact match a case-insensitive match is used.
+func (id *ResourceID) PopSegment(name string) (string, error) {
+	key := name
+	if _, ok := id.Path[key]; !ok {
+		for k := range id.Path {
+			if strings.EqualFold(k, name) {
+				key = k
+				break
+			}
+		}
+	}
+
+	val, ok := id.Path[key]
+	if !ok {
+		return "", fmt.Errorf("ID was missing the `%s` element", name)
+	}
+
+	delete(id.Path, key)
+	return val, nil
+}
+
+// ValidateNoEmptySegments validates that there's no unconsumed segments remaining in the Path
+func (id *ResourceID) ValidateNoEmptySegments(sourceId string) error {
+	if len(id.Path) == 0 {
+		return nil
+	}
+
+	keys := make([]string, 0, len(id.Path))
+	for k := range id.Path {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	return fmt.Errorf("ID contained more segments than required: %q (unexpected segments %q)", sourceId, strings.Join(keys, ", "))
+}
+
+// parseResourceIDWithSegments parses the specified Resource ID, returning the values of the named
+// segments (in the order specified) - returning an error if the ID belongs to a different Resource
+// Provider, if any segments are missing, or if the ID contains segments other than these
+func parseResourceIDWithSegments(input string, provider string, resourceType string, segments ...string) (*ResourceID, []string, error) {
+	id, err := ParseAzureResourceID(input)
+	if err != nil {
+		return nil, nil, fmt.Errorf("Error parsing %s ID %q: %+v", resourceType, input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, provider) {
+		return nil, nil, fmt.Errorf("Error parsing %s ID %q: expected the Resource Provider to be %q but got %q", resourceType, input, provider, id.Provider)
+	}
+
+	values := make([]string, 0, len(segments))
+	for _, segment := range segments {
+		value, err := id.PopSegment(segment)
+		if err != nil {
+			return nil, nil, fmt.Errorf("Error parsing %s ID %q: %+v", resourceType, input, err)
+		}
+
+		values = append(values, value)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, nil, fmt.Errorf("Error parsing %s ID: %+v", resourceType, err)
+	}
+
+	return id, values, nil
+}
+
+// parseScopedResourceIDWithSegments parses a Resource ID which isn't scoped to a Resource Group (such as one scoped to a
+// Subscription or a Management Group), which must contain exactly the specified segments in order. Segments are
+// specified either as `key` (where the value is returned) or `key=value` (where the value must match)
+func parseScopedResourceIDWithSegments(input string, resourceType string, segments ...string) ([]string, error) {
+	path := strings.TrimSuffix(strings.TrimPrefix(input, "/"), "/")
+	components := strings.Split(path, "/")
+	if len(components) != len(segments)*2 {
+		return nil, fmt.Errorf("Error parsing %s ID %q: expected %d segments but got %d", resourceType, input, len(segments)*2, len(components))
+	}
+
+	values := make([]string, 0)
+	for i, segment := range segments {
+		key, value := components[i*2], components[i*2+1]
+		if value == "" {
+			return nil, fmt.Errorf("Error parsing %s ID %q: the value for `%s` was empty", resourceType, input, key)
+		}
+
+		expected := strings.SplitN(segment, "=", 2)
+		if !strings.EqualFold(key, expected[0]) {
+			return nil, fmt.Errorf("Error parsing %s ID %q: expected the segment `%s` but got `%s`", resourceType, input, expected[0], key)
+		}
+
+		if len(expected) == 2 {
+			if !strings.EqualFold(value, expected[1]) {
+				return nil, fmt.Errorf("Error parsing %s ID %q: expected `%s` to be %q but got %q", resourceType, input, key, expected[1], value)
+			}
+			continue
+		}
+
+		values = append(values, value)
+	}
+
+	return values, nil
+}
@@ -2,6 +2,7 @@ package azure
 
 import (
 	"reflect"
+	"strings"
 	"testing"
 )
 
@@ -156,3 +157,80 @@ func TestParseAzureResourceID(t *testing.T) {
 		}
 	}
 }
+
+func TestResourceIDPopSegment(t *testing.T) {
+	id, err := ParseAzureResourceID("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1/Subnets/subnet1")
+	if err != nil {
+		t.Fatalf("Expected no error but got: %+v", err)
+	}
+
+	if network, err := id.PopSegment("virtualNetworks"); err != nil || network != "network1" {
+		t.Fatalf("Expected the `virtualNetworks` segment to be %q but got %q (error %+v)", "network1", network, err)
+	}
+
+	if err := id.ValidateNoEmptySegments("example"); err == nil {
+		t.Fatalf("Expected an error since the `Subnets` segment hasn't been consumed but didn't get one")
+	}
+
+	// segment names are matched case-insensitively when there's no exact match
+	if subnet, err := id.PopSegment("subnets"); err != nil || subnet != "subnet1" {
+		t.Fatalf("Expected the `subnets` segment to be %q but got %q (error %+v)", "subnet1", subnet, err)
+	}
+
+	if _, err := id.PopSegment("subnets"); err == nil {
+		t.Fatalf("Expected an error since the `subnets` segment has already been consumed but didn't get one")
+	}
+
+	if err := id.ValidateNoEmptySegments("example"); err != nil {
+		t.Fatalf("Expected no error since all segments have been consumed but got: %+v", err)
+	}
+}
+
+type resourceIDFormatter interface {
+	ID(subscriptionId string) string
+}
+
+type resourceIDTestCase struct {
+	Name  string
+	Input string
+	Parse func(input string) (resourceIDFormatter, error)
+}
+
+// testResourceIDs ensures that each typed Resource ID can be parsed and formatted back into the same
+// Resource ID, and that parsing fails when either a segment is missing, an additional segment is present
+// or the Resource ID belongs to a different Resource Provider
+func testResourceIDs(t *testing.T, cases []resourceIDTestCase) {
+	for _, v := range cases {
+		t.Run(v.Name, func(t *testing.T) {
+			id, err := v.Parse(v.Input)
+			if err != nil {
+				t.Fatalf("Expected %q to be parsed but got: %+v", v.Input, err)
+			}
+
+			if actual := id.ID("00000000-0000-0000-0000-000000000000"); actual != v.Input {
+				t.Fatalf("Expected the formatted ID to be %q but got %q", v.Input, actual)
+			}
+
+			if _, err := v.Parse(strings.ToLower(v.Input)); err != nil {
+				t.Fatalf("Expected the lower-cased ID to be parsed but got: %+v", err)
+			}
+
+			parent := v.Input[:strings.LastIndex(v.Input[:strings.LastIndex(v.Input, "/")], "/")]
+			if _, err := v.Parse(parent); err == nil {
+				t.Fatalf("Expected an error parsing %q since a segment is missing but didn't get one", parent)
+			}
+
+			extra := v.Input + "/extra/segment1"
+			if _, err := v.Parse(extra); err == nil {
+				t.Fatalf("Expected an error parsing %q since it contains an additional segment but didn't get one", extra)
+			}
+
+			providerStart := strings.LastIndex(v.Input, "/providers/") + len("/providers/")
+			providerEnd := providerStart + strings.Index(v.Input[providerStart:], "/")
+			wrongProvider := v.Input[:providerStart] + "Microsoft.Unrelated" + v.Input[providerEnd:]
+			if _, err := v.Parse(wrongProvider); err == nil {
+				t.Fatalf("Expected an error parsing %q since it belongs to a different Resource Provider but didn't get one", wrongProvider)
+			}
+		})
+	}
+}
@@ -0,0 +1,91 @@
+package azure
+
+import (
+	"fmt"
+)
+
+// TemplateDeploymentID is a parsed Template Deployment Resource ID
+type TemplateDeploymentID struct {
+	ResourceGroup string
+	Name          string
+}
+
+func (id TemplateDeploymentID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Resources/deployments/%s", subscriptionId, id.ResourceGroup, id.Name)
+}
+
+func ParseTemplateDeploymentID(input string) (*TemplateDeploymentID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.Resources", "Template Deployment", "deployments")
+	if err != nil {
+		return nil, err
+	}
+
+	return &TemplateDeploymentID{
+		ResourceGroup: id.ResourceGroup,
+		Name:          segments[0],
+	}, nil
+}
+
+func ValidateTemplateDeploymentID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseTemplateDeploymentID(input)
+		return err
+	})
+}
+
+// SubscriptionTemplateDeploymentID is a parsed Subscription Template Deployment Resource ID
+type SubscriptionTemplateDeploymentID struct {
+	Name string
+}
+
+func (id SubscriptionTemplateDeploymentID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/providers/Microsoft.Resources/deployments/%s", subscriptionId, id.Name)
+}
+
+func ParseSubscriptionTemplateDeploymentID(input string) (*SubscriptionTemplateDeploymentID, error) {
+	values, err := parseScopedResourceIDWithSegments(input, "Subscription Template Deployment", "subscriptions", "providers=Microsoft.Resources", "deployments")
+	if err != nil {
+		return nil, err
+	}
+
+	return &SubscriptionTemplateDeploymentID{
+		Name: values[1],
+	}, nil
+}
+
+func ValidateSubscriptionTemplateDeploymentID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseSubscriptionTemplateDeploymentID(input)
+		return err
+	})
+}
+
+// ManagementGroupTemplateDeploymentID is a parsed Management Group Template Deployment Resource ID
+type ManagementGroupTemplateDeploymentID struct {
+	ManagementGroup string
+	Name            string
+}
+
+// ID returns the Resource ID for this Template Deployment, which isn't scoped to a Subscription
+func (id ManagementGroupTemplateDeploymentID) ID(_ string) string {
+	return fmt.Sprintf("/providers/Microsoft.Management/managementGroups/%s/providers/Microsoft.Resources/deployments/%s", id.ManagementGroup, id.Name)
+}
+
+func ParseManagementGroupTemplateDeploymentID(input string) (*ManagementGroupTemplateDeploymentID, error) {
+	values, err := parseScopedResourceIDWithSegments(input, "Management Group Template Deployment", "providers=Microsoft.Management", "managementGroups", "providers=Microsoft.Resources", "deployments")
+	if err != nil {
+		return nil, err
+	}
+
+	return &ManagementGroupTemplateDeploymentID{
+		ManagementGroup: values[0],
+		Name:            values[1],
+	}, nil
+}
+
+func ValidateManagementGroupTemplateDeploymentID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseManagementGroupTemplateDeploymentID(input)
+		return err
+	})
+}
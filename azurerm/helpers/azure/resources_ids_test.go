@@ -0,0 +1,31 @@
+package azure
+
+import (
+	"testing"
+)
+
+func TestResourcesResourceIDs(t *testing.T) {
+	testResourceIDs(t, []resourceIDTestCase{
+		{
+			Name:  "Template Deployment",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Resources/deployments/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParseTemplateDeploymentID(input)
+			},
+		},
+		{
+			Name:  "Subscription Template Deployment",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.Resources/deployments/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParseSubscriptionTemplateDeploymentID(input)
+			},
+		},
+		{
+			Name:  "Management Group Template Deployment",
+			Input: "/providers/Microsoft.Management/managementGroups/group1/providers/Microsoft.Resources/deployments/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParseManagementGroupTemplateDeploymentID(input)
+			},
+		},
+	})
+}
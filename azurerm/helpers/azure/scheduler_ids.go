@@ -0,0 +1,65 @@
+package azure
+
+import (
+	"fmt"
+)
+
+// SchedulerJobCollectionID is a parsed Scheduler Job Collection Resource ID
+type SchedulerJobCollectionID struct {
+	ResourceGroup string
+	Name          string
+}
+
+func (id SchedulerJobCollectionID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Scheduler/jobCollections/%s", subscriptionId, id.ResourceGroup, id.Name)
+}
+
+func ParseSchedulerJobCollectionID(input string) (*SchedulerJobCollectionID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.Scheduler", "Scheduler Job Collection", "jobCollections")
+	if err != nil {
+		return nil, err
+	}
+
+	return &SchedulerJobCollectionID{
+		ResourceGroup: id.ResourceGroup,
+		Name:          segments[0],
+	}, nil
+}
+
+func ValidateSchedulerJobCollectionID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseSchedulerJobCollectionID(input)
+		return err
+	})
+}
+
+// SchedulerJobID is a parsed Scheduler Job Resource ID
+type SchedulerJobID struct {
+	ResourceGroup     string
+	JobCollectionName string
+	Name              string
+}
+
+func (id SchedulerJobID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Scheduler/jobCollections/%s/jobs/%s", subscriptionId, id.ResourceGroup, id.JobCollectionName, id.Name)
+}
+
+func ParseSchedulerJobID(input string) (*SchedulerJobID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.Scheduler", "Scheduler Job", "jobCollections", "jobs")
+	if err != nil {
+		return nil, err
+	}
+
+	return &SchedulerJobID{
+		ResourceGroup:     id.ResourceGroup,
+		JobCollectionName: segments[0],
+		Name:              segments[1],
+	}, nil
+}
+
+func ValidateSchedulerJobID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseSchedulerJobID(input)
+		return err
+	})
+}
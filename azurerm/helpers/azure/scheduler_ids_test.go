@@ -0,0 +1,24 @@
+package azure
+
+import (
+	"testing"
+)
+
+func TestSchedulerResourceIDs(t *testing.T) {
+	testResourceIDs(t, []resourceIDTestCase{
+		{
+			Name:  "Scheduler Job Collection",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Scheduler/jobCollections/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParseSchedulerJobCollectionID(input)
+			},
+		},
+		{
+			Name:  "Scheduler Job",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Scheduler/jobCollections/jobcollection1/jobs/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParseSchedulerJobID(input)
+			},
+		},
+	})
+}
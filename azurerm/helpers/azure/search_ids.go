@@ -0,0 +1,34 @@
+package azure
+
+import (
+	"fmt"
+)
+
+// SearchServiceID is a parsed Search Service Resource ID
+type SearchServiceID struct {
+	ResourceGroup string
+	Name          string
+}
+
+func (id SearchServiceID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Search/searchServices/%s", subscriptionId, id.ResourceGroup, id.Name)
+}
+
+func ParseSearchServiceID(input string) (*SearchServiceID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.Search", "Search Service", "searchServices")
+	if err != nil {
+		return nil, err
+	}
+
+	return &SearchServiceID{
+		ResourceGroup: id.ResourceGroup,
+		Name:          segments[0],
+	}, nil
+}
+
+func ValidateSearchServiceID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseSearchServiceID(input)
+		return err
+	})
+}
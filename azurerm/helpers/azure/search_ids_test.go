@@ -0,0 +1,17 @@
+package azure
+
+import (
+	"testing"
+)
+
+func TestSearchResourceIDs(t *testing.T) {
+	testResourceIDs(t, []resourceIDTestCase{
+		{
+			Name:  "Search Service",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Search/searchServices/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParseSearchServiceID(input)
+			},
+		},
+	})
+}
@@ -1,49 +1,112 @@
 package azure
 
 import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
 	"log"
+	"math"
 	"net/http"
 	"net/http/httputil"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/Azure/go-autorest/autorest"
 )
 
-func BuildSender() autorest.Sender {
+const (
+	// HeaderRetryAfter is the header used by ARM to specify how long to wait before retrying a request
+	HeaderRetryAfter = "Retry-After"
+
+	// HeaderRateLimitRemainingPrefix is the prefix of the headers ARM uses to return the remaining request quota
+	// e.g. `x-ms-ratelimit-remaining-subscription-reads`
+	HeaderRateLimitRemainingPrefix = "x-ms-ratelimit-remaining-"
+
+	retryMinimumBackoff = 1 * time.Second
+
+	// throttledErrorMaxBodySize is the maximum number of bytes of the response body included in a ThrottledError
+	throttledErrorMaxBodySize = 4096
+)
+
+// ThrottledError is returned when a request is still being throttled (429) once all of the attempts have been used.
+//
+// This implements `net.Error` reporting that it's neither temporary nor a timeout, since the retry decorator used by the
+// Azure SDK treats any other error as temporary - and retries throttled responses until the context expires, without
+// counting these as an attempt - which would otherwise ignore the configured `MaxAttempts` and `MaxBackoff`.
+type ThrottledError struct {
+	Method     string
+	URL        string
+	Attempts   int
+	RetryAfter string
+	Body       string
+}
+
+func (e ThrottledError) Error() string {
+	message := fmt.Sprintf("%s %s was still being throttled (429 Too Many Requests) after %d attempt(s)", e.Method, e.URL, e.Attempts)
+	if e.RetryAfter != "" {
+		message += fmt.Sprintf(" - ARM requested a delay of %ss", e.RetryAfter)
+	}
+	if e.Body != "" {
+		message += fmt.Sprintf(": %s", e.Body)
+	}
+	return message
+}
+
+// Timeout implements `net.Error`
+func (e ThrottledError) Timeout() bool {
+	return false
+}
+
+// Temporary implements `net.Error` - the retries have already been exhausted, so this mustn't be retried again
+func (e ThrottledError) Temporary() bool {
+	return false
+}
+
+// RetryOptions configures how requests which are throttled, or fail with a transient error, are retried
+type RetryOptions struct {
+	// MaxAttempts is the maximum number of times a request will be sent, including the first attempt
+	MaxAttempts int
+
+	// MaxBackoff is the upper bound on the delay between two attempts - including any delay requested by ARM
+	// via the `Retry-After` header
+	MaxBackoff time.Duration
+}
+
+// DefaultRetryOptions returns the RetryOptions used when none are specified
+func DefaultRetryOptions() RetryOptions {
+	return RetryOptions{
+		MaxAttempts: 5,
+		MaxBackoff:  60 * time.Second,
+	}
+}
+
+func BuildSender(retry RetryOptions, redaction LogRedactionOptions) autorest.Sender {
 	return autorest.DecorateSender(&http.Client{
 		Transport: &http.Transport{
 			Proxy: http.ProxyFromEnvironment,
 		},
-	}, withRequestLogging())
+	}, withRequestLogging(redaction), withRetries(retry))
 }
 
-func withRequestLogging() autorest.SendDecorator {
+func withRequestLogging(redaction LogRedactionOptions) autorest.SendDecorator {
+	redactor := newLogRedactor(redaction)
+
 	return func(s autorest.Sender) autorest.Sender {
 		return autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
-			// strip the authorization header prior to printing
-			authHeaderName := "Authorization"
-			auth := r.Header.Get(authHeaderName)
-			if auth != "" {
-				r.Header.Del(authHeaderName)
-			}
-
-			// dump request to wire format
+			// dump request to wire format, masking any secrets (e.g. the Authorization header) prior to printing
 			if dump, err := httputil.DumpRequestOut(r, true); err == nil {
-				log.Printf("[DEBUG] AzureRM Request: \n%s\n", dump)
+				log.Printf("[DEBUG] AzureRM Request: \n%s\n", redactor.redact(string(dump)))
 			} else {
 				// fallback to basic message
 				log.Printf("[DEBUG] AzureRM Request: %s to %s\n", r.Method, r.URL)
 			}
 
-			// add the auth header back
-			if auth != "" {
-				r.Header.Add(authHeaderName, auth)
-			}
-
 			resp, err := s.Do(r)
 			if resp != nil {
 				// dump response to wire format
 				if dump, err2 := httputil.DumpResponse(resp, true); err2 == nil {
-					log.Printf("[DEBUG] AzureRM Response for %s: \n%s\n", r.URL, dump)
+					log.Printf("[DEBUG] AzureRM Response for %s: \n%s\n", r.URL, redactor.redact(string(dump)))
 				} else {
 					// fallback to basic message
 					log.Printf("[DEBUG] AzureRM Response: %s for %s\n", resp.Status, r.URL)
@@ -55,3 +118,198 @@ func withRequestLogging() autorest.SendDecorator {
 		})
 	}
 }
+
+// withRetries returns a SendDecorator which retries requests which have been throttled (429) or which
+// failed with a transient error (408/5xx or a temporary network error), up to `options.MaxAttempts` times.
+// Since a request which failed with a transient error may still have been processed, these are only retried
+// for idempotent methods - whereas throttled requests are retried regardless of the method.
+//
+// The delay between attempts honours the `Retry-After` header when ARM returns one - otherwise it backs off
+// exponentially - in both cases capped at `options.MaxBackoff`, waiting the full `MaxBackoff` when the
+// `x-ms-ratelimit-remaining-*` headers show the request quota has been exhausted.
+func withRetries(options RetryOptions) autorest.SendDecorator {
+	return func(s autorest.Sender) autorest.Sender {
+		return autorest.SenderFunc(func(r *http.Request) (resp *http.Response, err error) {
+			rr := autorest.NewRetriableRequest(r)
+			for attempt := 1; ; attempt++ {
+				if err = rr.Prepare(); err != nil {
+					return resp, err
+				}
+
+				resp, err = s.Do(rr.Request())
+				if r.Context().Err() != nil || !shouldRetryRequest(r.Method, resp, err) {
+					return resp, err
+				}
+
+				if attempt >= options.MaxAttempts {
+					if err == nil && resp != nil && resp.StatusCode == http.StatusTooManyRequests {
+						return resp, newThrottledError(r, resp, attempt)
+					}
+
+					return resp, err
+				}
+
+				delay := retryDelay(resp, attempt, options.MaxBackoff)
+				log.Printf("[DEBUG] AzureRM Request %s to %s failed (%s) - retrying in %s (attempt %d of %d)", r.Method, r.URL, describeRetryableFailure(resp, err), delay, attempt, options.MaxAttempts)
+
+				if resp != nil && resp.Body != nil {
+					resp.Body.Close()
+				}
+
+				select {
+				case <-time.After(delay):
+				case <-r.Context().Done():
+					return resp, r.Context().Err()
+				}
+			}
+		})
+	}
+}
+
+// newThrottledError returns a ThrottledError for the final throttled response - the body of which is retained so that
+// it can still be read by the caller
+func newThrottledError(r *http.Request, resp *http.Response, attempts int) error {
+	body := ""
+	if resp.Body != nil {
+		if b, err := ioutil.ReadAll(resp.Body); err == nil {
+			resp.Body.Close()
+			resp.Body = ioutil.NopCloser(bytes.NewReader(b))
+
+			body = strings.TrimSpace(string(b))
+			if len(body) > throttledErrorMaxBodySize {
+				body = body[:throttledErrorMaxBodySize]
+			}
+		}
+	}
+
+	return ThrottledError{
+		Method:     r.Method,
+		URL:        r.URL.String(),
+		Attempts:   attempts,
+		RetryAfter: resp.Header.Get(HeaderRetryAfter),
+		Body:       body,
+	}
+}
+
+func shouldRetryRequest(method string, resp *http.Response, err error) bool {
+	if err != nil {
+		return isIdempotentMethod(method) && autorest.IsTemporaryNetworkError(err)
+	}
+
+	if resp == nil {
+		return false
+	}
+
+	// throttled requests haven't been processed, so these can be safely retried regardless of the method
+	if resp.StatusCode == http.StatusTooManyRequests {
+		return true
+	}
+
+	if !isIdempotentMethod(method) {
+		return false
+	}
+
+	for _, code := range autorest.StatusCodesForRetry {
+		if resp.StatusCode == code {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isIdempotentMethod returns whether sending a request with the specified HTTP method multiple times
+// has the same effect as sending it once - which isn't the case for POST actions (e.g. Restart) or PATCH
+func isIdempotentMethod(method string) bool {
+	switch strings.ToUpper(method) {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+
+	return false
+}
+
+func describeRetryableFailure(resp *http.Response, err error) string {
+	if err != nil {
+		return err.Error()
+	}
+
+	return resp.Status
+}
+
+// retryDelay determines how long to wait before sending the next attempt of a request, which is never longer than `maxBackoff`
+func retryDelay(resp *http.Response, attempt int, maxBackoff time.Duration) time.Duration {
+	if retryAfter, ok := parseRetryAfter(resp); ok {
+		if retryAfter > maxBackoff {
+			log.Printf("[DEBUG] The %s of %s exceeds the Max Backoff - waiting %s", HeaderRetryAfter, retryAfter, maxBackoff)
+			return maxBackoff
+		}
+
+		return retryAfter
+	}
+
+	if rateLimitExhausted(resp) {
+		return maxBackoff
+	}
+
+	backoff := time.Duration(float64(retryMinimumBackoff) * math.Pow(2, float64(attempt-1)))
+	if backoff > maxBackoff || backoff <= 0 {
+		return maxBackoff
+	}
+
+	return backoff
+}
+
+// parseRetryAfter parses the `Retry-After` header, which can either be a number of seconds or a HTTP Date
+func parseRetryAfter(resp *http.Response) (time.Duration, bool) {
+	if resp == nil {
+		return 0, false
+	}
+
+	value := strings.TrimSpace(resp.Header.Get(HeaderRetryAfter))
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		delay := time.Until(date)
+		if delay < 0 {
+			delay = 0
+		}
+
+		return delay, true
+	}
+
+	log.Printf("[DEBUG] Unable to parse the %s header %q - ignoring", HeaderRetryAfter, value)
+	return 0, false
+}
+
+// rateLimitExhausted returns whether any of the `x-ms-ratelimit-remaining-*` headers show the quota has been used up
+func rateLimitExhausted(resp *http.Response) bool {
+	if resp == nil {
+		return false
+	}
+
+	for key, values := range resp.Header {
+		if !strings.HasPrefix(strings.ToLower(key), HeaderRateLimitRemainingPrefix) {
+			continue
+		}
+
+		for _, value := range values {
+			if remaining, err := strconv.Atoi(strings.TrimSpace(value)); err == nil && remaining <= 0 {
+				log.Printf("[DEBUG] Request quota exhausted (%s: %d)", key, remaining)
+				return true
+			}
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,302 @@
+package azure
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+func TestWithRetries(t *testing.T) {
+	cases := []struct {
+		Name             string
+		Method           string
+		Responses        []int
+		MaxAttempts      int
+		ExpectedAttempts int
+		ExpectedStatus   int
+		ExpectThrottled  bool
+	}{
+		{
+			Name:             "Success",
+			Responses:        []int{http.StatusOK},
+			MaxAttempts:      3,
+			ExpectedAttempts: 1,
+			ExpectedStatus:   http.StatusOK,
+		},
+		{
+			Name:             "Not Found isn't retried",
+			Responses:        []int{http.StatusNotFound},
+			MaxAttempts:      3,
+			ExpectedAttempts: 1,
+			ExpectedStatus:   http.StatusNotFound,
+		},
+		{
+			Name:             "Throttled then Success",
+			Responses:        []int{http.StatusTooManyRequests, http.StatusTooManyRequests, http.StatusOK},
+			MaxAttempts:      3,
+			ExpectedAttempts: 3,
+			ExpectedStatus:   http.StatusOK,
+		},
+		{
+			Name:             "Server Error then Success",
+			Responses:        []int{http.StatusInternalServerError, http.StatusBadGateway, http.StatusOK},
+			MaxAttempts:      5,
+			ExpectedAttempts: 3,
+			ExpectedStatus:   http.StatusOK,
+		},
+		{
+			Name:             "Attempts Exhausted",
+			Responses:        []int{http.StatusServiceUnavailable, http.StatusServiceUnavailable, http.StatusServiceUnavailable, http.StatusOK},
+			MaxAttempts:      3,
+			ExpectedAttempts: 3,
+			ExpectedStatus:   http.StatusServiceUnavailable,
+		},
+		{
+			Name:             "Throttled POST is retried",
+			Method:           http.MethodPost,
+			Responses:        []int{http.StatusTooManyRequests, http.StatusOK},
+			MaxAttempts:      3,
+			ExpectedAttempts: 2,
+			ExpectedStatus:   http.StatusOK,
+		},
+		{
+			Name:             "Server Error on a POST isn't retried",
+			Method:           http.MethodPost,
+			Responses:        []int{http.StatusInternalServerError, http.StatusOK},
+			MaxAttempts:      3,
+			ExpectedAttempts: 1,
+			ExpectedStatus:   http.StatusInternalServerError,
+		},
+		{
+			Name:             "Single Attempt",
+			Responses:        []int{http.StatusTooManyRequests, http.StatusOK},
+			MaxAttempts:      1,
+			ExpectedAttempts: 1,
+			ExpectedStatus:   http.StatusTooManyRequests,
+			ExpectThrottled:  true,
+		},
+		{
+			Name:             "Throttled Attempts Exhausted",
+			Responses:        []int{http.StatusTooManyRequests, http.StatusTooManyRequests, http.StatusTooManyRequests, http.StatusOK},
+			MaxAttempts:      3,
+			ExpectedAttempts: 3,
+			ExpectedStatus:   http.StatusTooManyRequests,
+			ExpectThrottled:  true,
+		},
+	}
+
+	for _, v := range cases {
+		t.Run(v.Name, func(t *testing.T) {
+			var attempts int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				attempt := atomic.AddInt32(&attempts, 1)
+				body, _ := ioutil.ReadAll(r.Body)
+				if string(body) != `{"hello":"world"}` {
+					t.Errorf("Expected the request body to be sent on attempt %d but got %q", attempt, string(body))
+				}
+
+				w.Header().Set(HeaderRetryAfter, "0")
+				w.WriteHeader(v.Responses[attempt-1])
+			}))
+			defer server.Close()
+
+			sender := BuildSender(RetryOptions{
+				MaxAttempts: v.MaxAttempts,
+				MaxBackoff:  10 * time.Millisecond,
+			}, LogRedactionOptions{})
+
+			method := v.Method
+			if method == "" {
+				method = http.MethodPut
+			}
+
+			req, _ := http.NewRequest(method, server.URL, strings.NewReader(`{"hello":"world"}`))
+			resp, err := sender.Do(req)
+			if v.ExpectThrottled {
+				if _, ok := err.(ThrottledError); !ok {
+					t.Fatalf("Expected a ThrottledError but got: %+v", err)
+				}
+			} else if err != nil {
+				t.Fatalf("Expected no error but got: %+v", err)
+			}
+
+			if resp.StatusCode != v.ExpectedStatus {
+				t.Fatalf("Expected the status code to be %d but got %d", v.ExpectedStatus, resp.StatusCode)
+			}
+
+			if actual := int(atomic.LoadInt32(&attempts)); actual != v.ExpectedAttempts {
+				t.Fatalf("Expected %d attempts but got %d", v.ExpectedAttempts, actual)
+			}
+		})
+	}
+}
+
+func TestWithRetriesThrottlingIsTerminalForTheSDK(t *testing.T) {
+	var attempts int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&attempts, 1)
+		w.Header().Set(HeaderRetryAfter, "60")
+		w.WriteHeader(http.StatusTooManyRequests)
+		w.Write([]byte(`{"error":{"code":"TooManyRequests"}}`))
+	}))
+	defer server.Close()
+
+	// configured as the Clients are - where the SDK's own retries are disabled
+	client := autorest.NewClientWithUserAgent("")
+	client.Sender = BuildSender(RetryOptions{
+		MaxAttempts: 3,
+		MaxBackoff:  10 * time.Millisecond,
+	}, LogRedactionOptions{})
+	client.RetryAttempts = 0
+	client.RetryDuration = 0
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	req, _ := http.NewRequest(http.MethodGet, server.URL, nil)
+	_, err := autorest.SendWithSender(client, req.WithContext(ctx),
+		autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
+	if _, ok := err.(ThrottledError); !ok {
+		t.Fatalf("Expected a ThrottledError but got: %+v", err)
+	}
+	if !strings.Contains(err.Error(), "TooManyRequests") {
+		t.Fatalf("Expected the error to include the response body but got: %s", err.Error())
+	}
+
+	if ctx.Err() != nil {
+		t.Fatalf("Expected the retries to stop before the context expired")
+	}
+
+	if actual := atomic.LoadInt32(&attempts); actual != 3 {
+		t.Fatalf("Expected 3 attempts but got %d", actual)
+	}
+}
+
+func TestWithRetriesStopsWhenContextIsCancelled(t *testing.T) {
+	var attempts int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&attempts, 1)
+		w.Header().Set(HeaderRetryAfter, "60")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	sender := BuildSender(RetryOptions{
+		MaxAttempts: 5,
+		MaxBackoff:  time.Minute,
+	}, LogRedactionOptions{})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	req, _ := http.NewRequest(http.MethodGet, server.URL, nil)
+	if _, err := sender.Do(req.WithContext(ctx)); err == nil {
+		t.Fatalf("Expected an error when the context was cancelled but didn't get one")
+	}
+
+	if actual := atomic.LoadInt32(&attempts); actual != 1 {
+		t.Fatalf("Expected 1 attempt but got %d", actual)
+	}
+}
+
+func TestRetryDelay(t *testing.T) {
+	cases := []struct {
+		Name       string
+		Headers    map[string]string
+		Attempt    int
+		MaxBackoff time.Duration
+		Expected   time.Duration
+	}{
+		{
+			Name:       "Retry-After in Seconds",
+			Headers:    map[string]string{HeaderRetryAfter: "17"},
+			Attempt:    1,
+			MaxBackoff: time.Minute,
+			Expected:   17 * time.Second,
+		},
+		{
+			Name:       "Retry-After takes precedence over the Backoff",
+			Headers:    map[string]string{HeaderRetryAfter: "30"},
+			Attempt:    1,
+			MaxBackoff: time.Minute,
+			Expected:   30 * time.Second,
+		},
+		{
+			Name:       "Retry-After is capped at the Max Backoff",
+			Headers:    map[string]string{HeaderRetryAfter: "120"},
+			Attempt:    1,
+			MaxBackoff: time.Minute,
+			Expected:   time.Minute,
+		},
+		{
+			Name:       "Retry-After in the Past",
+			Headers:    map[string]string{HeaderRetryAfter: "Wed, 21 Oct 2015 07:28:00 GMT"},
+			Attempt:    1,
+			MaxBackoff: time.Minute,
+			Expected:   0,
+		},
+		{
+			Name:       "Invalid Retry-After",
+			Headers:    map[string]string{HeaderRetryAfter: "soon"},
+			Attempt:    1,
+			MaxBackoff: time.Minute,
+			Expected:   time.Second,
+		},
+		{
+			Name:       "First Backoff",
+			Attempt:    1,
+			MaxBackoff: time.Minute,
+			Expected:   time.Second,
+		},
+		{
+			Name:       "Exponential Backoff",
+			Attempt:    4,
+			MaxBackoff: time.Minute,
+			Expected:   8 * time.Second,
+		},
+		{
+			Name:       "Backoff Ceiling",
+			Attempt:    10,
+			MaxBackoff: time.Minute,
+			Expected:   time.Minute,
+		},
+		{
+			Name:       "Rate Limit Exhausted",
+			Headers:    map[string]string{"x-ms-ratelimit-remaining-subscription-writes": "0"},
+			Attempt:    1,
+			MaxBackoff: time.Minute,
+			Expected:   time.Minute,
+		},
+		{
+			Name:       "Rate Limit Remaining",
+			Headers:    map[string]string{"x-ms-ratelimit-remaining-subscription-reads": "11999"},
+			Attempt:    1,
+			MaxBackoff: time.Minute,
+			Expected:   time.Second,
+		},
+	}
+
+	for _, v := range cases {
+		t.Run(v.Name, func(t *testing.T) {
+			resp := &http.Response{
+				StatusCode: http.StatusTooManyRequests,
+				Header:     http.Header{},
+			}
+			for k, val := range v.Headers {
+				resp.Header.Set(k, val)
+			}
+
+			actual := retryDelay(resp, v.Attempt, v.MaxBackoff)
+			if actual != v.Expected {
+				t.Fatalf("Expected a delay of %s but got %s", v.Expected, actual)
+			}
+		})
+	}
+}
@@ -0,0 +1,34 @@
+package azure
+
+import (
+	"fmt"
+)
+
+// ServiceFabricClusterID is a parsed Service Fabric Cluster Resource ID
+type ServiceFabricClusterID struct {
+	ResourceGroup string
+	Name          string
+}
+
+func (id ServiceFabricClusterID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.ServiceFabric/clusters/%s", subscriptionId, id.ResourceGroup, id.Name)
+}
+
+func ParseServiceFabricClusterID(input string) (*ServiceFabricClusterID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.ServiceFabric", "Service Fabric Cluster", "clusters")
+	if err != nil {
+		return nil, err
+	}
+
+	return &ServiceFabricClusterID{
+		ResourceGroup: id.ResourceGroup,
+		Name:          segments[0],
+	}, nil
+}
+
+func ValidateServiceFabricClusterID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseServiceFabricClusterID(input)
+		return err
+	})
+}
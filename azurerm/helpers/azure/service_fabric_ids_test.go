@@ -0,0 +1,17 @@
+package azure
+
+import (
+	"testing"
+)
+
+func TestServiceFabricResourceIDs(t *testing.T) {
+	testResourceIDs(t, []resourceIDTestCase{
+		{
+			Name:  "Service Fabric Cluster",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.ServiceFabric/clusters/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParseServiceFabricClusterID(input)
+			},
+		},
+	})
+}
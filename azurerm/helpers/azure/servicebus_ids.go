@@ -0,0 +1,261 @@
+package azure
+
+import (
+	"fmt"
+)
+
+// ServiceBusNamespaceID is a parsed ServiceBus Namespace Resource ID
+type ServiceBusNamespaceID struct {
+	ResourceGroup string
+	Name          string
+}
+
+func (id ServiceBusNamespaceID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.ServiceBus/namespaces/%s", subscriptionId, id.ResourceGroup, id.Name)
+}
+
+func ParseServiceBusNamespaceID(input string) (*ServiceBusNamespaceID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.ServiceBus", "ServiceBus Namespace", "namespaces")
+	if err != nil {
+		return nil, err
+	}
+
+	return &ServiceBusNamespaceID{
+		ResourceGroup: id.ResourceGroup,
+		Name:          segments[0],
+	}, nil
+}
+
+func ValidateServiceBusNamespaceID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseServiceBusNamespaceID(input)
+		return err
+	})
+}
+
+// ServiceBusNamespaceAuthorizationRuleID is a parsed ServiceBus Namespace Authorization Rule Resource ID
+type ServiceBusNamespaceAuthorizationRuleID struct {
+	ResourceGroup string
+	NamespaceName string
+	Name          string
+}
+
+func (id ServiceBusNamespaceAuthorizationRuleID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.ServiceBus/namespaces/%s/AuthorizationRules/%s", subscriptionId, id.ResourceGroup, id.NamespaceName, id.Name)
+}
+
+func ParseServiceBusNamespaceAuthorizationRuleID(input string) (*ServiceBusNamespaceAuthorizationRuleID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.ServiceBus", "ServiceBus Namespace Authorization Rule", "namespaces", "AuthorizationRules")
+	if err != nil {
+		return nil, err
+	}
+
+	return &ServiceBusNamespaceAuthorizationRuleID{
+		ResourceGroup: id.ResourceGroup,
+		NamespaceName: segments[0],
+		Name:          segments[1],
+	}, nil
+}
+
+func ValidateServiceBusNamespaceAuthorizationRuleID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseServiceBusNamespaceAuthorizationRuleID(input)
+		return err
+	})
+}
+
+// ServiceBusQueueID is a parsed ServiceBus Queue Resource ID
+type ServiceBusQueueID struct {
+	ResourceGroup string
+	NamespaceName string
+	Name          string
+}
+
+func (id ServiceBusQueueID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.ServiceBus/namespaces/%s/queues/%s", subscriptionId, id.ResourceGroup, id.NamespaceName, id.Name)
+}
+
+func ParseServiceBusQueueID(input string) (*ServiceBusQueueID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.ServiceBus", "ServiceBus Queue", "namespaces", "queues")
+	if err != nil {
+		return nil, err
+	}
+
+	return &ServiceBusQueueID{
+		ResourceGroup: id.ResourceGroup,
+		NamespaceName: segments[0],
+		Name:          segments[1],
+	}, nil
+}
+
+func ValidateServiceBusQueueID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseServiceBusQueueID(input)
+		return err
+	})
+}
+
+// ServiceBusQueueAuthorizationRuleID is a parsed ServiceBus Queue Authorization Rule Resource ID
+type ServiceBusQueueAuthorizationRuleID struct {
+	ResourceGroup string
+	NamespaceName string
+	QueueName     string
+	Name          string
+}
+
+func (id ServiceBusQueueAuthorizationRuleID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.ServiceBus/namespaces/%s/queues/%s/authorizationRules/%s", subscriptionId, id.ResourceGroup, id.NamespaceName, id.QueueName, id.Name)
+}
+
+func ParseServiceBusQueueAuthorizationRuleID(input string) (*ServiceBusQueueAuthorizationRuleID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.ServiceBus", "ServiceBus Queue Authorization Rule", "namespaces", "queues", "authorizationRules")
+	if err != nil {
+		return nil, err
+	}
+
+	return &ServiceBusQueueAuthorizationRuleID{
+		ResourceGroup: id.ResourceGroup,
+		NamespaceName: segments[0],
+		QueueName:     segments[1],
+		Name:          segments[2],
+	}, nil
+}
+
+func ValidateServiceBusQueueAuthorizationRuleID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseServiceBusQueueAuthorizationRuleID(input)
+		return err
+	})
+}
+
+// ServiceBusTopicID is a parsed ServiceBus Topic Resource ID
+type ServiceBusTopicID struct {
+	ResourceGroup string
+	NamespaceName string
+	Name          string
+}
+
+func (id ServiceBusTopicID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.ServiceBus/namespaces/%s/topics/%s", subscriptionId, id.ResourceGroup, id.NamespaceName, id.Name)
+}
+
+func ParseServiceBusTopicID(input string) (*ServiceBusTopicID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.ServiceBus", "ServiceBus Topic", "namespaces", "topics")
+	if err != nil {
+		return nil, err
+	}
+
+	return &ServiceBusTopicID{
+		ResourceGroup: id.ResourceGroup,
+		NamespaceName: segments[0],
+		Name:          segments[1],
+	}, nil
+}
+
+func ValidateServiceBusTopicID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseServiceBusTopicID(input)
+		return err
+	})
+}
+
+// ServiceBusTopicAuthorizationRuleID is a parsed ServiceBus Topic Authorization Rule Resource ID
+type ServiceBusTopicAuthorizationRuleID struct {
+	ResourceGroup string
+	NamespaceName string
+	TopicName     string
+	Name          string
+}
+
+func (id ServiceBusTopicAuthorizationRuleID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.ServiceBus/namespaces/%s/topics/%s/authorizationRules/%s", subscriptionId, id.ResourceGroup, id.NamespaceName, id.TopicName, id.Name)
+}
+
+func ParseServiceBusTopicAuthorizationRuleID(input string) (*ServiceBusTopicAuthorizationRuleID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.ServiceBus", "ServiceBus Topic Authorization Rule", "namespaces", "topics", "authorizationRules")
+	if err != nil {
+		return nil, err
+	}
+
+	return &ServiceBusTopicAuthorizationRuleID{
+		ResourceGroup: id.ResourceGroup,
+		NamespaceName: segments[0],
+		TopicName:     segments[1],
+		Name:          segments[2],
+	}, nil
+}
+
+func ValidateServiceBusTopicAuthorizationRuleID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseServiceBusTopicAuthorizationRuleID(input)
+		return err
+	})
+}
+
+// ServiceBusSubscriptionID is a parsed ServiceBus Subscription Resource ID
+type ServiceBusSubscriptionID struct {
+	ResourceGroup string
+	NamespaceName string
+	TopicName     string
+	Name          string
+}
+
+func (id ServiceBusSubscriptionID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.ServiceBus/namespaces/%s/topics/%s/subscriptions/%s", subscriptionId, id.ResourceGroup, id.NamespaceName, id.TopicName, id.Name)
+}
+
+func ParseServiceBusSubscriptionID(input string) (*ServiceBusSubscriptionID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.ServiceBus", "ServiceBus Subscription", "namespaces", "topics", "subscriptions")
+	if err != nil {
+		return nil, err
+	}
+
+	return &ServiceBusSubscriptionID{
+		ResourceGroup: id.ResourceGroup,
+		NamespaceName: segments[0],
+		TopicName:     segments[1],
+		Name:          segments[2],
+	}, nil
+}
+
+func ValidateServiceBusSubscriptionID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseServiceBusSubscriptionID(input)
+		return err
+	})
+}
+
+// ServiceBusSubscriptionRuleID is a parsed ServiceBus Subscription Rule Resource ID
+type ServiceBusSubscriptionRuleID struct {
+	ResourceGroup    string
+	NamespaceName    string
+	TopicName        string
+	SubscriptionName string
+	Name             string
+}
+
+func (id ServiceBusSubscriptionRuleID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.ServiceBus/namespaces/%s/topics/%s/subscriptions/%s/rules/%s", subscriptionId, id.ResourceGroup, id.NamespaceName, id.TopicName, id.SubscriptionName, id.Name)
+}
+
+func ParseServiceBusSubscriptionRuleID(input string) (*ServiceBusSubscriptionRuleID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.ServiceBus", "ServiceBus Subscription Rule", "namespaces", "topics", "subscriptions", "rules")
+	if err != nil {
+		return nil, err
+	}
+
+	return &ServiceBusSubscriptionRuleID{
+		ResourceGroup:    id.ResourceGroup,
+		NamespaceName:    segments[0],
+		TopicName:        segments[1],
+		SubscriptionName: segments[2],
+		Name:             segments[3],
+	}, nil
+}
+
+func ValidateServiceBusSubscriptionRuleID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseServiceBusSubscriptionRuleID(input)
+		return err
+	})
+}
@@ -0,0 +1,66 @@
+package azure
+
+import (
+	"testing"
+)
+
+func TestServicebusResourceIDs(t *testing.T) {
+	testResourceIDs(t, []resourceIDTestCase{
+		{
+			Name:  "ServiceBus Namespace",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.ServiceBus/namespaces/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParseServiceBusNamespaceID(input)
+			},
+		},
+		{
+			Name:  "ServiceBus Namespace Authorization Rule",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.ServiceBus/namespaces/namespace1/AuthorizationRules/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParseServiceBusNamespaceAuthorizationRuleID(input)
+			},
+		},
+		{
+			Name:  "ServiceBus Queue",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.ServiceBus/namespaces/namespace1/queues/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParseServiceBusQueueID(input)
+			},
+		},
+		{
+			Name:  "ServiceBus Queue Authorization Rule",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.ServiceBus/namespaces/namespace1/queues/queue1/authorizationRules/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParseServiceBusQueueAuthorizationRuleID(input)
+			},
+		},
+		{
+			Name:  "ServiceBus Topic",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.ServiceBus/namespaces/namespace1/topics/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParseServiceBusTopicID(input)
+			},
+		},
+		{
+			Name:  "ServiceBus Topic Authorization Rule",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.ServiceBus/namespaces/namespace1/topics/topic1/authorizationRules/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParseServiceBusTopicAuthorizationRuleID(input)
+			},
+		},
+		{
+			Name:  "ServiceBus Subscription",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.ServiceBus/namespaces/namespace1/topics/topic1/subscriptions/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParseServiceBusSubscriptionID(input)
+			},
+		},
+		{
+			Name:  "ServiceBus Subscription Rule",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.ServiceBus/namespaces/namespace1/topics/topic1/subscriptions/subscription1/rules/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParseServiceBusSubscriptionRuleID(input)
+			},
+		},
+	})
+}
@@ -0,0 +1,34 @@
+package azure
+
+import (
+	"fmt"
+)
+
+// SignalRServiceID is a parsed SignalR Service Resource ID
+type SignalRServiceID struct {
+	ResourceGroup string
+	Name          string
+}
+
+func (id SignalRServiceID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.SignalRService/SignalR/%s", subscriptionId, id.ResourceGroup, id.Name)
+}
+
+func ParseSignalRServiceID(input string) (*SignalRServiceID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.SignalRService", "SignalR Service", "SignalR")
+	if err != nil {
+		return nil, err
+	}
+
+	return &SignalRServiceID{
+		ResourceGroup: id.ResourceGroup,
+		Name:          segments[0],
+	}, nil
+}
+
+func ValidateSignalRServiceID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseSignalRServiceID(input)
+		return err
+	})
+}
@@ -0,0 +1,17 @@
+package azure
+
+import (
+	"testing"
+)
+
+func TestSignalrResourceIDs(t *testing.T) {
+	testResourceIDs(t, []resourceIDTestCase{
+		{
+			Name:  "SignalR Service",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.SignalRService/SignalR/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParseSignalRServiceID(input)
+			},
+		},
+	})
+}
@@ -0,0 +1,189 @@
+package azure
+
+import (
+	"fmt"
+)
+
+// SqlServerID is a parsed SQL Server Resource ID
+type SqlServerID struct {
+	ResourceGroup string
+	Name          string
+}
+
+func (id SqlServerID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Sql/servers/%s", subscriptionId, id.ResourceGroup, id.Name)
+}
+
+func ParseSqlServerID(input string) (*SqlServerID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.Sql", "SQL Server", "servers")
+	if err != nil {
+		return nil, err
+	}
+
+	return &SqlServerID{
+		ResourceGroup: id.ResourceGroup,
+		Name:          segments[0],
+	}, nil
+}
+
+func ValidateSqlServerID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseSqlServerID(input)
+		return err
+	})
+}
+
+// SqlActiveDirectoryAdministratorID is a parsed SQL Active Directory Administrator Resource ID
+type SqlActiveDirectoryAdministratorID struct {
+	ResourceGroup string
+	ServerName    string
+	Name          string
+}
+
+func (id SqlActiveDirectoryAdministratorID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Sql/servers/%s/administrators/%s", subscriptionId, id.ResourceGroup, id.ServerName, id.Name)
+}
+
+func ParseSqlActiveDirectoryAdministratorID(input string) (*SqlActiveDirectoryAdministratorID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.Sql", "SQL Active Directory Administrator", "servers", "administrators")
+	if err != nil {
+		return nil, err
+	}
+
+	return &SqlActiveDirectoryAdministratorID{
+		ResourceGroup: id.ResourceGroup,
+		ServerName:    segments[0],
+		Name:          segments[1],
+	}, nil
+}
+
+func ValidateSqlActiveDirectoryAdministratorID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseSqlActiveDirectoryAdministratorID(input)
+		return err
+	})
+}
+
+// SqlDatabaseID is a parsed SQL Database Resource ID
+type SqlDatabaseID struct {
+	ResourceGroup string
+	ServerName    string
+	Name          string
+}
+
+func (id SqlDatabaseID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Sql/servers/%s/databases/%s", subscriptionId, id.ResourceGroup, id.ServerName, id.Name)
+}
+
+func ParseSqlDatabaseID(input string) (*SqlDatabaseID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.Sql", "SQL Database", "servers", "databases")
+	if err != nil {
+		return nil, err
+	}
+
+	return &SqlDatabaseID{
+		ResourceGroup: id.ResourceGroup,
+		ServerName:    segments[0],
+		Name:          segments[1],
+	}, nil
+}
+
+func ValidateSqlDatabaseID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseSqlDatabaseID(input)
+		return err
+	})
+}
+
+// SqlElasticPoolID is a parsed SQL Elastic Pool Resource ID
+type SqlElasticPoolID struct {
+	ResourceGroup string
+	ServerName    string
+	Name          string
+}
+
+func (id SqlElasticPoolID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Sql/servers/%s/elasticPools/%s", subscriptionId, id.ResourceGroup, id.ServerName, id.Name)
+}
+
+func ParseSqlElasticPoolID(input string) (*SqlElasticPoolID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.Sql", "SQL Elastic Pool", "servers", "elasticPools")
+	if err != nil {
+		return nil, err
+	}
+
+	return &SqlElasticPoolID{
+		ResourceGroup: id.ResourceGroup,
+		ServerName:    segments[0],
+		Name:          segments[1],
+	}, nil
+}
+
+func ValidateSqlElasticPoolID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseSqlElasticPoolID(input)
+		return err
+	})
+}
+
+// SqlFirewallRuleID is a parsed SQL Firewall Rule Resource ID
+type SqlFirewallRuleID struct {
+	ResourceGroup string
+	ServerName    string
+	Name          string
+}
+
+func (id SqlFirewallRuleID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Sql/servers/%s/firewallRules/%s", subscriptionId, id.ResourceGroup, id.ServerName, id.Name)
+}
+
+func ParseSqlFirewallRuleID(input string) (*SqlFirewallRuleID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.Sql", "SQL Firewall Rule", "servers", "firewallRules")
+	if err != nil {
+		return nil, err
+	}
+
+	return &SqlFirewallRuleID{
+		ResourceGroup: id.ResourceGroup,
+		ServerName:    segments[0],
+		Name:          segments[1],
+	}, nil
+}
+
+func ValidateSqlFirewallRuleID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseSqlFirewallRuleID(input)
+		return err
+	})
+}
+
+// SqlVirtualNetworkRuleID is a parsed SQL Virtual Network Rule Resource ID
+type SqlVirtualNetworkRuleID struct {
+	ResourceGroup string
+	ServerName    string
+	Name          string
+}
+
+func (id SqlVirtualNetworkRuleID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Sql/servers/%s/virtualNetworkRules/%s", subscriptionId, id.ResourceGroup, id.ServerName, id.Name)
+}
+
+func ParseSqlVirtualNetworkRuleID(input string) (*SqlVirtualNetworkRuleID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.Sql", "SQL Virtual Network Rule", "servers", "virtualNetworkRules")
+	if err != nil {
+		return nil, err
+	}
+
+	return &SqlVirtualNetworkRuleID{
+		ResourceGroup: id.ResourceGroup,
+		ServerName:    segments[0],
+		Name:          segments[1],
+	}, nil
+}
+
+func ValidateSqlVirtualNetworkRuleID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseSqlVirtualNetworkRuleID(input)
+		return err
+	})
+}
@@ -0,0 +1,52 @@
+package azure
+
+import (
+	"testing"
+)
+
+func TestSqlResourceIDs(t *testing.T) {
+	testResourceIDs(t, []resourceIDTestCase{
+		{
+			Name:  "SQL Server",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Sql/servers/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParseSqlServerID(input)
+			},
+		},
+		{
+			Name:  "SQL Active Directory Administrator",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Sql/servers/server1/administrators/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParseSqlActiveDirectoryAdministratorID(input)
+			},
+		},
+		{
+			Name:  "SQL Database",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Sql/servers/server1/databases/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParseSqlDatabaseID(input)
+			},
+		},
+		{
+			Name:  "SQL Elastic Pool",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Sql/servers/server1/elasticPools/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParseSqlElasticPoolID(input)
+			},
+		},
+		{
+			Name:  "SQL Firewall Rule",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Sql/servers/server1/firewallRules/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParseSqlFirewallRuleID(input)
+			},
+		},
+		{
+			Name:  "SQL Virtual Network Rule",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Sql/servers/server1/virtualNetworkRules/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParseSqlVirtualNetworkRuleID(input)
+			},
+		},
+	})
+}
@@ -0,0 +1,34 @@
+package azure
+
+import (
+	"fmt"
+)
+
+// StorageAccountID is a parsed Storage Account Resource ID
+type StorageAccountID struct {
+	ResourceGroup string
+	Name          string
+}
+
+func (id StorageAccountID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Storage/storageAccounts/%s", subscriptionId, id.ResourceGroup, id.Name)
+}
+
+func ParseStorageAccountID(input string) (*StorageAccountID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.Storage", "Storage Account", "storageAccounts")
+	if err != nil {
+		return nil, err
+	}
+
+	return &StorageAccountID{
+		ResourceGroup: id.ResourceGroup,
+		Name:          segments[0],
+	}, nil
+}
+
+func ValidateStorageAccountID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseStorageAccountID(input)
+		return err
+	})
+}
@@ -0,0 +1,17 @@
+package azure
+
+import (
+	"testing"
+)
+
+func TestStorageResourceIDs(t *testing.T) {
+	testResourceIDs(t, []resourceIDTestCase{
+		{
+			Name:  "Storage Account",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Storage/storageAccounts/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParseStorageAccountID(input)
+			},
+		},
+	})
+}
@@ -0,0 +1,54 @@
+package azure
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/storage"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+// StorageAzureADResource returns the resource which Azure AD tokens for the Storage Data Plane are issued for
+// in the specified Azure Environment - which is shared by the well-known clouds, but is specific to the Storage
+// Endpoint for custom Environments (e.g. those loaded from a Metadata Host or an Environment File)
+func StorageAzureADResource(env azure.Environment) string {
+	for _, wellKnown := range []azure.Environment{azure.PublicCloud, azure.USGovernmentCloud, azure.ChinaCloud, azure.GermanCloud} {
+		if strings.EqualFold(env.StorageEndpointSuffix, wellKnown.StorageEndpointSuffix) {
+			return "https://storage.azure.com/"
+		}
+	}
+
+	return fmt.Sprintf("https://storage.%s/", strings.TrimPrefix(env.StorageEndpointSuffix, "."))
+}
+
+// StorageAzureADAPIVersion is the Storage API Version used when authorizing requests using Azure AD,
+// since earlier API Versions don't support Bearer Tokens
+const StorageAzureADAPIVersion = "2018-03-28"
+
+type storageBearerTokenSender struct {
+	authorizer autorest.Authorizer
+	sender     autorest.Sender
+}
+
+// NewStorageBearerTokenSender returns a Sender for the Storage Data Plane SDK which authorizes requests
+// using an Azure AD Bearer Token, rather than the Shared Key signature applied by the SDK
+func NewStorageBearerTokenSender(authorizer autorest.Authorizer, sender autorest.Sender) storage.Sender {
+	return storageBearerTokenSender{
+		authorizer: authorizer,
+		sender:     sender,
+	}
+}
+
+func (s storageBearerTokenSender) Send(_ *storage.Client, req *http.Request) (*http.Response, error) {
+	// the SDK always signs the request using the Account Key, so this needs to be replaced
+	req.Header.Del("Authorization")
+
+	authorized, err := autorest.Prepare(req, s.authorizer.WithAuthorization())
+	if err != nil {
+		return nil, fmt.Errorf("Error authorizing Storage request to %s: %+v", req.URL, err)
+	}
+
+	return s.sender.Do(authorized)
+}
@@ -0,0 +1,73 @@
+package azure
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/adal"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+func TestStorageAzureADResource(t *testing.T) {
+	cases := []struct {
+		Name        string
+		Environment azure.Environment
+		Expected    string
+	}{
+		{
+			Name:        "Public",
+			Environment: azure.PublicCloud,
+			Expected:    "https://storage.azure.com/",
+		},
+		{
+			Name:        "China",
+			Environment: azure.ChinaCloud,
+			Expected:    "https://storage.azure.com/",
+		},
+		{
+			Name: "Custom",
+			Environment: azure.Environment{
+				Name:                  "AzureStackCloud",
+				StorageEndpointSuffix: "local.azurestack.external",
+			},
+			Expected: "https://storage.local.azurestack.external/",
+		},
+	}
+
+	for _, v := range cases {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		if actual := StorageAzureADResource(v.Environment); actual != v.Expected {
+			t.Fatalf("Expected %q but got %q", v.Expected, actual)
+		}
+	}
+}
+
+func TestStorageBearerTokenSender(t *testing.T) {
+	var authorization []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authorization = r.Header["Authorization"]
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	authorizer := autorest.NewBearerAuthorizer(&adal.Token{AccessToken: "abc123"})
+	sender := NewStorageBearerTokenSender(authorizer, BuildSender(DefaultRetryOptions(), LogRedactionOptions{}))
+
+	req, _ := http.NewRequest(http.MethodGet, server.URL, nil)
+	req.Header["Authorization"] = []string{"SharedKey account:signature"}
+
+	resp, err := sender.Send(nil, req)
+	if err != nil {
+		t.Fatalf("Expected no error but got: %+v", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("Expected the status code to be %d but got %d", http.StatusOK, resp.StatusCode)
+	}
+
+	if len(authorization) != 1 || authorization[0] != "Bearer abc123" {
+		t.Fatalf("Expected a single Bearer Token Authorization header but got %+v", authorization)
+	}
+}
@@ -0,0 +1,127 @@
+package azure
+
+import (
+	"fmt"
+)
+
+// StreamAnalyticsJobID is a parsed Stream Analytics Job Resource ID
+type StreamAnalyticsJobID struct {
+	ResourceGroup string
+	Name          string
+}
+
+func (id StreamAnalyticsJobID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.StreamAnalytics/streamingjobs/%s", subscriptionId, id.ResourceGroup, id.Name)
+}
+
+func ParseStreamAnalyticsJobID(input string) (*StreamAnalyticsJobID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.StreamAnalytics", "Stream Analytics Job", "streamingjobs")
+	if err != nil {
+		return nil, err
+	}
+
+	return &StreamAnalyticsJobID{
+		ResourceGroup: id.ResourceGroup,
+		Name:          segments[0],
+	}, nil
+}
+
+func ValidateStreamAnalyticsJobID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseStreamAnalyticsJobID(input)
+		return err
+	})
+}
+
+// StreamAnalyticsFunctionID is a parsed Stream Analytics Function Resource ID
+type StreamAnalyticsFunctionID struct {
+	ResourceGroup string
+	JobName       string
+	Name          string
+}
+
+func (id StreamAnalyticsFunctionID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.StreamAnalytics/streamingjobs/%s/functions/%s", subscriptionId, id.ResourceGroup, id.JobName, id.Name)
+}
+
+func ParseStreamAnalyticsFunctionID(input string) (*StreamAnalyticsFunctionID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.StreamAnalytics", "Stream Analytics Function", "streamingjobs", "functions")
+	if err != nil {
+		return nil, err
+	}
+
+	return &StreamAnalyticsFunctionID{
+		ResourceGroup: id.ResourceGroup,
+		JobName:       segments[0],
+		Name:          segments[1],
+	}, nil
+}
+
+func ValidateStreamAnalyticsFunctionID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseStreamAnalyticsFunctionID(input)
+		return err
+	})
+}
+
+// StreamAnalyticsOutputID is a parsed Stream Analytics Output Resource ID
+type StreamAnalyticsOutputID struct {
+	ResourceGroup string
+	JobName       string
+	Name          string
+}
+
+func (id StreamAnalyticsOutputID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.StreamAnalytics/streamingjobs/%s/outputs/%s", subscriptionId, id.ResourceGroup, id.JobName, id.Name)
+}
+
+func ParseStreamAnalyticsOutputID(input string) (*StreamAnalyticsOutputID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.StreamAnalytics", "Stream Analytics Output", "streamingjobs", "outputs")
+	if err != nil {
+		return nil, err
+	}
+
+	return &StreamAnalyticsOutputID{
+		ResourceGroup: id.ResourceGroup,
+		JobName:       segments[0],
+		Name:          segments[1],
+	}, nil
+}
+
+func ValidateStreamAnalyticsOutputID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseStreamAnalyticsOutputID(input)
+		return err
+	})
+}
+
+// StreamAnalyticsStreamInputID is a parsed Stream Analytics Stream Input Resource ID
+type StreamAnalyticsStreamInputID struct {
+	ResourceGroup string
+	JobName       string
+	Name          string
+}
+
+func (id StreamAnalyticsStreamInputID) ID(subscriptionId string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.StreamAnalytics/streamingjobs/%s/inputs/%s", subscriptionId, id.ResourceGroup, id.JobName, id.Name)
+}
+
+func ParseStreamAnalyticsStreamInputID(input string) (*StreamAnalyticsStreamInputID, error) {
+	id, segments, err := parseResourceIDWithSegments(input, "Microsoft.StreamAnalytics", "Stream Analytics Stream Input", "streamingjobs", "inputs")
+	if err != nil {
+		return nil, err
+	}
+
+	return &StreamAnalyticsStreamInputID{
+		ResourceGroup: id.ResourceGroup,
+		JobName:       segments[0],
+		Name:          segments[1],
+	}, nil
+}
+
+func ValidateStreamAnalyticsStreamInputID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDUsing(i, k, func(input string) error {
+		_, err := ParseStreamAnalyticsStreamInputID(input)
+		return err
+	})
+}
@@ -0,0 +1,38 @@
+package azure
+
+import (
+	"testing"
+)
+
+func TestStreamAnalyticsResourceIDs(t *testing.T) {
+	testResourceIDs(t, []resourceIDTestCase{
+		{
+			Name:  "Stream Analytics Job",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.StreamAnalytics/streamingjobs/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParseStreamAnalyticsJobID(input)
+			},
+		},
+		{
+			Name:  "Stream Analytics Function",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.StreamAnalytics/streamingjobs/job1/functions/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParseStreamAnalyticsFunctionID(input)
+			},
+		},
+		{
+			Name:  "Stream Analytics Output",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.StreamAnalytics/streamingjobs/job1/outputs/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParseStreamAnalyticsOutputID(input)
+			},
+		},
+		{
+			Name:  "Stream Analytics Stream Input",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.StreamAnalytics/streamingjobs/job1/inputs/name1",
+			Parse: func(input string) (resourceIDFormatter, error) {
+				return ParseStreamAnalyticsStreamInputID(input)
+			},
+		},
+	})
+}
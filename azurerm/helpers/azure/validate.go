@@ -2,6 +2,8 @@ package azure
 
 import (
 	"fmt"
+
+	"github.com/hashicorp/terraform/helper/schema"
 )
 
 func ValidateResourceID(i interface{}, k string) (warnings []string, errors []error) {
@@ -32,3 +34,30 @@ func ValidateResourceIDOrEmpty(i interface{}, k string) (_ []string, errors []er
 
 	return ValidateResourceID(i, k)
 }
+
+// ValidateOrEmpty returns a ValidateFunc which allows either an empty string, or a value which is valid according to
+// `validateFunc` - for Optional arguments which have historically accepted an empty string (e.g. a typed Resource ID)
+func ValidateOrEmpty(validateFunc schema.SchemaValidateFunc) schema.SchemaValidateFunc {
+	return func(i interface{}, k string) ([]string, []error) {
+		if v, ok := i.(string); ok && v == "" {
+			return nil, nil
+		}
+
+		return validateFunc(i, k)
+	}
+}
+
+// validateResourceIDUsing validates the value is a string which can be parsed using the specified function
+func validateResourceIDUsing(i interface{}, k string, parse func(input string) error) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if err := parse(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a resource id: %v", k, err))
+	}
+
+	return warnings, errors
+}
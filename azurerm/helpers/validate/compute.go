@@ -48,3 +48,40 @@ func SharedImageVersionName(v interface{}, k string) (warnings []string, errors
 
 	return warnings, errors
 }
+
+// VirtualMachineName validates the name of a Virtual Machine (and the Computer Name of a Linux Virtual Machine)
+// which can be up to 64 characters and can contain alphanumerics, full stops and dashes - but must start and
+// end with an alphanumeric
+func VirtualMachineName(v interface{}, k string) (warnings []string, errors []error) {
+	value := v.(string)
+
+	if !regexp.MustCompile(`^[A-Za-z0-9]([A-Za-z0-9.-]*[A-Za-z0-9])?$`).MatchString(value) {
+		errors = append(errors, fmt.Errorf("%s can only contain alphanumerics, full stops and dashes and must start and end with an alphanumeric. Got %q.", k, value))
+	}
+
+	if length := len(value); length > 64 {
+		errors = append(errors, fmt.Errorf("%s can be up to 64 characters, currently %d.", k, length))
+	}
+
+	return warnings, errors
+}
+
+// WindowsComputerName validates the Computer Name of a Windows Virtual Machine, which can be up to 15 characters
+// and can contain alphanumerics and dashes - but can't be entirely numeric
+func WindowsComputerName(v interface{}, k string) (warnings []string, errors []error) {
+	value := v.(string)
+
+	if !regexp.MustCompile(`^[A-Za-z0-9]([A-Za-z0-9-]*[A-Za-z0-9])?$`).MatchString(value) {
+		errors = append(errors, fmt.Errorf("%s can only contain alphanumerics and dashes and must start and end with an alphanumeric. Got %q.", k, value))
+	}
+
+	if regexp.MustCompile(`^[0-9]+$`).MatchString(value) {
+		errors = append(errors, fmt.Errorf("%s cannot be entirely numeric. Got %q.", k, value))
+	}
+
+	if length := len(value); length > 15 {
+		errors = append(errors, fmt.Errorf("%s can be up to 15 characters, currently %d.", k, length))
+	}
+
+	return warnings, errors
+}
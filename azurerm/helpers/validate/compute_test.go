@@ -174,3 +174,109 @@ func TestSharedImageVersionName(t *testing.T) {
 		})
 	}
 }
+
+func TestVirtualMachineName(t *testing.T) {
+	cases := []struct {
+		Input       string
+		ShouldError bool
+	}{
+		{
+			Input:       "",
+			ShouldError: true,
+		},
+		{
+			Input:       "hello",
+			ShouldError: false,
+		},
+		{
+			Input:       "hello-123.example",
+			ShouldError: false,
+		},
+		{
+			Input:       "-hello",
+			ShouldError: true,
+		},
+		{
+			Input:       "hello.",
+			ShouldError: true,
+		},
+		{
+			Input:       "hello_123",
+			ShouldError: true,
+		},
+		{
+			Input:       acctest.RandString(64),
+			ShouldError: false,
+		},
+		{
+			Input:       acctest.RandString(65),
+			ShouldError: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Input, func(t *testing.T) {
+			_, errors := VirtualMachineName(tc.Input, "test")
+
+			hasErrors := len(errors) > 0
+			if !hasErrors && tc.ShouldError {
+				t.Fatalf("Expected an error but didn't get one for %q", tc.Input)
+			}
+
+			if hasErrors && !tc.ShouldError {
+				t.Fatalf("Expected to get no errors for %q but got %d", tc.Input, len(errors))
+			}
+		})
+	}
+}
+
+func TestWindowsComputerName(t *testing.T) {
+	cases := []struct {
+		Input       string
+		ShouldError bool
+	}{
+		{
+			Input:       "",
+			ShouldError: true,
+		},
+		{
+			Input:       "hello",
+			ShouldError: false,
+		},
+		{
+			Input:       "hello-123",
+			ShouldError: false,
+		},
+		{
+			Input:       "hello.123",
+			ShouldError: true,
+		},
+		{
+			Input:       "12345",
+			ShouldError: true,
+		},
+		{
+			Input:       acctest.RandString(15),
+			ShouldError: false,
+		},
+		{
+			Input:       acctest.RandString(16),
+			ShouldError: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Input, func(t *testing.T) {
+			_, errors := WindowsComputerName(tc.Input, "test")
+
+			hasErrors := len(errors) > 0
+			if !hasErrors && tc.ShouldError {
+				t.Fatalf("Expected an error but didn't get one for %q", tc.Input)
+			}
+
+			if hasErrors && !tc.ShouldError {
+				t.Fatalf("Expected to get no errors for %q but got %d", tc.Input, len(errors))
+			}
+		})
+	}
+}
@@ -0,0 +1,78 @@
+package common
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/terraform/httpclient"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceproviders"
+	"github.com/terraform-providers/terraform-provider-azurerm/version"
+)
+
+// ClientOptions contains the values required to construct the SDK Clients for each Service
+type ClientOptions struct {
+	SubscriptionId string
+	TenantId       string
+	PartnerId      string
+
+	GraphAuthorizer           autorest.Authorizer
+	GraphEndpoint             string
+	KeyVaultAuthorizer        autorest.Authorizer
+	ResourceManagerAuthorizer autorest.Authorizer
+	ResourceManagerEndpoint   string
+
+	// AuxiliaryTenantsAuthorizer authorizes Resource Manager requests which can span multiple tenants, and
+	// should be used by the Clients for Resources supporting cross-tenant operations
+	AuxiliaryTenantsAuthorizer autorest.Authorizer
+
+	SkipProviderReg bool
+	RetryOptions    azure.RetryOptions
+	LogRedaction    azure.LogRedactionOptions
+
+	// ResourceProviderRegistrar registers the Resource Provider for a Resource the first time a Resource
+	// within that namespace is created - this is nil when Resource Provider Registration is skipped
+	ResourceProviderRegistrar *resourceproviders.Registrar
+}
+
+// ConfigureClient sets the User Agent, Authorizer and Sender used by the specified SDK Client
+func (o ClientOptions) ConfigureClient(c *autorest.Client, authorizer autorest.Authorizer) {
+	setUserAgent(c, o.PartnerId)
+	c.Authorizer = authorizer
+	c.RequestInspector = azure.WithCorrelationRequestID(azure.CorrelationRequestID())
+	c.Sender = azure.BuildSender(o.RetryOptions, o.LogRedaction)
+	// retries are handled by the Sender, so the SDK's own retries are disabled to avoid these multiplying - the SDK
+	// doesn't count throttled requests as an attempt, so the Sender returns a permanent error once its retries for a
+	// throttled request are exhausted, rather than the 429 response (which the SDK would retry until the context expires)
+	c.RetryAttempts = 0
+	c.RetryDuration = 0
+	if o.ResourceProviderRegistrar != nil {
+		c.Sender = autorest.DecorateSender(c.Sender, o.ResourceProviderRegistrar.WithRegistration())
+	}
+	c.SkipResourceProviderRegistration = o.SkipProviderReg
+	c.PollingDuration = 60 * time.Minute
+}
+
+func setUserAgent(client *autorest.Client, partnerID string) {
+	// TODO: This is the SDK version not the CLI version, once we are on 0.12, should revisit
+	tfUserAgent := httpclient.UserAgentString()
+
+	pv := version.ProviderVersion
+	providerUserAgent := fmt.Sprintf("%s terraform-provider-azurerm/%s", tfUserAgent, pv)
+	client.UserAgent = strings.TrimSpace(fmt.Sprintf("%s %s", client.UserAgent, providerUserAgent))
+
+	// append the CloudShell version to the user agent if it exists
+	if azureAgent := os.Getenv("AZURE_HTTP_USER_AGENT"); azureAgent != "" {
+		client.UserAgent = fmt.Sprintf("%s %s", client.UserAgent, azureAgent)
+	}
+
+	if partnerID != "" {
+		client.UserAgent = fmt.Sprintf("%s pid-%s", client.UserAgent, partnerID)
+	}
+
+	log.Printf("[DEBUG] AzureRM Client User Agent: %s\n", client.UserAgent)
+}
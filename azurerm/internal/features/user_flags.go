@@ -0,0 +1,71 @@
+package features
+
+import "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/flags"
+
+// UserFeatures are the behaviours which can be toggled by users within the `features` block of the Provider
+type UserFeatures struct {
+	ComputeCapacity        ComputeCapacityFeatures
+	ExistingResources      ExistingResourcesFeatures
+	KeyVault               KeyVaultFeatures
+	TemplateDeployment     TemplateDeploymentFeatures
+	VirtualMachine         VirtualMachineFeatures
+	VirtualMachineScaleSet VirtualMachineScaleSetFeatures
+}
+
+type ComputeCapacityFeatures struct {
+	// ValidateDuringPlan determines whether the availability of the SKUs (and the vCPU quota) used by
+	// Compute resources should be validated during the plan, rather than failing during the apply
+	ValidateDuringPlan bool
+}
+
+type ExistingResourcesFeatures struct {
+	// RequireImport determines whether an error should be raised when a resource being created already
+	// exists (requiring that it's imported into the State) - rather than adopting the existing resource
+	RequireImport bool
+}
+
+type KeyVaultFeatures struct {
+	PurgeSoftDeleteOnDestroy bool
+}
+
+type TemplateDeploymentFeatures struct {
+	// DeleteNestedItemsDuringDeletion determines whether the resources provisioned by a Template Deployment
+	// should be deleted when the Template Deployment is deleted
+	DeleteNestedItemsDuringDeletion bool
+}
+
+type VirtualMachineFeatures struct {
+	DeleteOSDiskOnDeletion    bool
+	DeleteDataDisksOnDeletion bool
+}
+
+type VirtualMachineScaleSetFeatures struct {
+	RollInstancesWhenRequired bool
+	WaitForRollingUpgrades    bool
+}
+
+// Default returns the behaviours used when the `features` block isn't specified
+func Default() UserFeatures {
+	return UserFeatures{
+		ComputeCapacity: ComputeCapacityFeatures{
+			ValidateDuringPlan: false,
+		},
+		ExistingResources: ExistingResourcesFeatures{
+			RequireImport: flags.RequireResourcesToBeImported,
+		},
+		KeyVault: KeyVaultFeatures{
+			PurgeSoftDeleteOnDestroy: false,
+		},
+		TemplateDeployment: TemplateDeploymentFeatures{
+			DeleteNestedItemsDuringDeletion: false,
+		},
+		VirtualMachine: VirtualMachineFeatures{
+			DeleteOSDiskOnDeletion:    false,
+			DeleteDataDisksOnDeletion: false,
+		},
+		VirtualMachineScaleSet: VirtualMachineScaleSetFeatures{
+			RollInstancesWhenRequired: false,
+			WaitForRollingUpgrades:    false,
+		},
+	}
+}
@@ -0,0 +1,188 @@
+package resourceproviders
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/2017-03-09/resources/mgmt/resources"
+	"github.com/Azure/go-autorest/autorest"
+)
+
+const registeredState = "Registered"
+
+// Registrar registers Resource Providers within the Subscription lazily, the first time a Resource within
+// the Resource Provider's namespace is created - rather than registering every Resource Provider up-front,
+// which requires permission to register Resource Providers which may never be used
+type Registrar struct {
+	client         resources.ProvidersClient
+	host           string
+	subscriptionId string
+
+	// PollInterval is the interval at which the Registration State is checked whilst registering
+	PollInterval time.Duration
+
+	lock       sync.Mutex
+	locks      map[string]*sync.Mutex
+	registered map[string]bool
+}
+
+// NewRegistrar returns a Registrar for the Subscription of the specified Providers Client, which registers
+// Resource Providers when Resources are created using the specified Resource Manager endpoint
+func NewRegistrar(client resources.ProvidersClient, resourceManagerEndpoint string) (*Registrar, error) {
+	endpoint, err := url.Parse(resourceManagerEndpoint)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Resource Manager Endpoint %q: %+v", resourceManagerEndpoint, err)
+	}
+
+	return &Registrar{
+		client:         client,
+		host:           strings.ToLower(endpoint.Host),
+		subscriptionId: client.SubscriptionID,
+		PollInterval:   10 * time.Second,
+		locks:          make(map[string]*sync.Mutex),
+		registered:     make(map[string]bool),
+	}, nil
+}
+
+// Seed records which of the specified Resource Providers are already registered, avoiding the need to
+// check the Registration State of each Resource Provider when it's first used
+func (r *Registrar) Seed(providers []resources.Provider) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	for _, provider := range providers {
+		if provider.Namespace == nil || provider.RegistrationState == nil {
+			continue
+		}
+
+		if strings.EqualFold(*provider.RegistrationState, registeredState) {
+			r.registered[strings.ToLower(*provider.Namespace)] = true
+		}
+	}
+}
+
+// EnsureRegistered ensures the specified Resource Provider is registered within the Subscription,
+// registering it (and waiting for the registration to complete) if necessary
+func (r *Registrar) EnsureRegistered(ctx context.Context, namespace string) error {
+	key := strings.ToLower(namespace)
+
+	r.lock.Lock()
+	lock, ok := r.locks[key]
+	if !ok {
+		lock = &sync.Mutex{}
+		r.locks[key] = lock
+	}
+	r.lock.Unlock()
+
+	// only one registration should happen per Resource Provider at once
+	lock.Lock()
+	defer lock.Unlock()
+
+	if r.isRegistered(key) {
+		return nil
+	}
+
+	provider, err := r.client.Get(ctx, namespace, "")
+	if err != nil {
+		if provider.Response.Response != nil && provider.Response.StatusCode == http.StatusForbidden {
+			// we can't tell whether it's registered - so the request is attempted regardless
+			log.Printf("[WARN] Unable to determine the Registration State of the Resource Provider %q: %+v", namespace, err)
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving the Registration State of the Resource Provider %q: %+v", namespace, err)
+	}
+
+	if provider.RegistrationState != nil && strings.EqualFold(*provider.RegistrationState, registeredState) {
+		r.setRegistered(key)
+		return nil
+	}
+
+	log.Printf("[DEBUG] Registering the Resource Provider %q in Subscription %q", namespace, r.subscriptionId)
+	resp, err := r.client.Register(ctx, namespace)
+	if err != nil {
+		if resp.Response.Response != nil && resp.Response.StatusCode == http.StatusForbidden {
+			return fmt.Errorf("The Resource Provider %q isn't registered in Subscription %q and the credentials being used don't have permission to register it (`%s/register/action`). "+
+				"Either register this Resource Provider (for example using `az provider register --namespace %s`) or grant the credentials permission to register it: %+v", namespace, r.subscriptionId, namespace, namespace, err)
+		}
+
+		return fmt.Errorf("Error registering the Resource Provider %q in Subscription %q: %+v", namespace, r.subscriptionId, err)
+	}
+
+	for {
+		provider, err := r.client.Get(ctx, namespace, "")
+		if err != nil {
+			return fmt.Errorf("Error retrieving the Registration State of the Resource Provider %q: %+v", namespace, err)
+		}
+
+		if provider.RegistrationState != nil && strings.EqualFold(*provider.RegistrationState, registeredState) {
+			break
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("Error waiting for the Resource Provider %q to be registered in Subscription %q: %+v", namespace, r.subscriptionId, ctx.Err())
+		case <-time.After(r.PollInterval):
+		}
+	}
+
+	log.Printf("[DEBUG] Registered the Resource Provider %q in Subscription %q", namespace, r.subscriptionId)
+	r.setRegistered(key)
+	return nil
+}
+
+// WithRegistration returns a SendDecorator which ensures the Resource Provider for a Resource is registered
+// before the Resource is created (or updated) within this Subscription
+func (r *Registrar) WithRegistration() autorest.SendDecorator {
+	return func(s autorest.Sender) autorest.Sender {
+		return autorest.SenderFunc(func(req *http.Request) (*http.Response, error) {
+			if req.Method == http.MethodPut && strings.EqualFold(req.URL.Host, r.host) {
+				if namespace, ok := ResourceProviderNamespace(req.URL.Path, r.subscriptionId); ok {
+					if err := r.EnsureRegistered(req.Context(), namespace); err != nil {
+						return nil, err
+					}
+				}
+			}
+
+			return s.Do(req)
+		})
+	}
+}
+
+// ResourceProviderNamespace returns the namespace of the Resource Provider for the Resource at the specified
+// path - providing the Resource is within the specified Subscription
+func ResourceProviderNamespace(path string, subscriptionId string) (string, bool) {
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	if len(segments) < 2 || !strings.EqualFold(segments[0], "subscriptions") || !strings.EqualFold(segments[1], subscriptionId) {
+		return "", false
+	}
+
+	// extension Resources (e.g. Locks) are nested within their parent, so the last namespace is used
+	for i := len(segments) - 2; i >= 2; i-- {
+		if strings.EqualFold(segments[i], "providers") && segments[i+1] != "" {
+			return segments[i+1], true
+		}
+	}
+
+	return "", false
+}
+
+func (r *Registrar) isRegistered(key string) bool {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	return r.registered[key]
+}
+
+func (r *Registrar) setRegistered(key string) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	r.registered[key] = true
+}
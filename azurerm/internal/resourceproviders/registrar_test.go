@@ -0,0 +1,161 @@
+package resourceproviders
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/2017-03-09/resources/mgmt/resources"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+const testSubscriptionId = "00000000-0000-0000-0000-000000000000"
+
+func TestResourceProviderNamespace(t *testing.T) {
+	testData := []struct {
+		Path     string
+		Expected string
+	}{
+		{
+			Path:     "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1",
+			Expected: "",
+		},
+		{
+			Path:     "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1",
+			Expected: "Microsoft.Network",
+		},
+		{
+			Path:     "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1/subnets/subnet1",
+			Expected: "Microsoft.Network",
+		},
+		{
+			Path:     "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1/providers/Microsoft.Authorization/locks/lock1",
+			Expected: "Microsoft.Authorization",
+		},
+		{
+			Path:     "/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.Resources/deployments/deployment1",
+			Expected: "Microsoft.Resources",
+		},
+		{
+			// Resources in other Subscriptions are registered in that Subscription
+			Path:     "/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1",
+			Expected: "",
+		},
+		{
+			Path:     "/providers/Microsoft.Management/managementGroups/group1",
+			Expected: "",
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Path)
+
+		actual, ok := ResourceProviderNamespace(v.Path, testSubscriptionId)
+		if ok != (v.Expected != "") {
+			t.Fatalf("Expected a namespace to be found to be %t but got %t", v.Expected != "", ok)
+		}
+
+		if actual != v.Expected {
+			t.Fatalf("Expected %q but got %q", v.Expected, actual)
+		}
+	}
+}
+
+type testProvidersServer struct {
+	lock          sync.Mutex
+	state         string
+	forbidden     bool
+	registrations int
+}
+
+func (s *testProvidersServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if strings.HasSuffix(r.URL.Path, "/register") {
+		if s.forbidden {
+			w.WriteHeader(http.StatusForbidden)
+			fmt.Fprint(w, `{"error":{"code":"AuthorizationFailed","message":"forbidden"}}`)
+			return
+		}
+
+		s.registrations++
+		s.state = "Registered"
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"namespace":"Microsoft.Network","registrationState":"Registering"}`)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	fmt.Fprintf(w, `{"namespace":"Microsoft.Network","registrationState":%q}`, s.state)
+}
+
+func testRegistrar(t *testing.T, server *httptest.Server) *Registrar {
+	client := resources.NewProvidersClientWithBaseURI(server.URL, testSubscriptionId)
+	registrar, err := NewRegistrar(client, server.URL)
+	if err != nil {
+		t.Fatalf("Error building Registrar: %+v", err)
+	}
+	registrar.PollInterval = 10 * time.Millisecond
+	return registrar
+}
+
+func TestRegistrar_registersOnce(t *testing.T) {
+	providers := &testProvidersServer{state: "NotRegistered"}
+	server := httptest.NewServer(providers)
+	defer server.Close()
+
+	registrar := testRegistrar(t, server)
+	for i := 0; i < 3; i++ {
+		if err := registrar.EnsureRegistered(context.TODO(), "Microsoft.Network"); err != nil {
+			t.Fatalf("Expected no error but got: %+v", err)
+		}
+	}
+
+	if providers.registrations != 1 {
+		t.Fatalf("Expected 1 registration but got %d", providers.registrations)
+	}
+}
+
+func TestRegistrar_seeded(t *testing.T) {
+	providers := &testProvidersServer{state: "NotRegistered"}
+	server := httptest.NewServer(providers)
+	defer server.Close()
+
+	registrar := testRegistrar(t, server)
+	registrar.Seed([]resources.Provider{
+		{
+			Namespace:         utils.String("Microsoft.Network"),
+			RegistrationState: utils.String("Registered"),
+		},
+	})
+
+	if err := registrar.EnsureRegistered(context.TODO(), "microsoft.network"); err != nil {
+		t.Fatalf("Expected no error but got: %+v", err)
+	}
+
+	if providers.registrations != 0 {
+		t.Fatalf("Expected no registrations but got %d", providers.registrations)
+	}
+}
+
+func TestRegistrar_forbidden(t *testing.T) {
+	providers := &testProvidersServer{state: "NotRegistered", forbidden: true}
+	server := httptest.NewServer(providers)
+	defer server.Close()
+
+	registrar := testRegistrar(t, server)
+	err := registrar.EnsureRegistered(context.TODO(), "Microsoft.Network")
+	if err == nil {
+		t.Fatalf("Expected an error but didn't get one")
+	}
+
+	if !strings.Contains(err.Error(), "Microsoft.Network/register/action") {
+		t.Fatalf("Expected the error to mention the missing permission but got: %+v", err)
+	}
+}
@@ -0,0 +1,29 @@
+package applicationinsights
+
+import (
+	"github.com/Azure/azure-sdk-for-go/services/appinsights/mgmt/2015-05-01/insights"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/common"
+)
+
+type Client struct {
+	ComponentsClient insights.ComponentsClient
+	APIKeyClient     insights.APIKeysClient
+	WebTestsClient   insights.WebTestsClient
+}
+
+func BuildClient(o *common.ClientOptions) *Client {
+	componentsClient := insights.NewComponentsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&componentsClient.Client, o.ResourceManagerAuthorizer)
+
+	apiKeyClient := insights.NewAPIKeysClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&apiKeyClient.Client, o.ResourceManagerAuthorizer)
+
+	webTestsClient := insights.NewWebTestsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&webTestsClient.Client, o.ResourceManagerAuthorizer)
+
+	return &Client{
+		ComponentsClient: componentsClient,
+		APIKeyClient:     apiKeyClient,
+		WebTestsClient:   webTestsClient,
+	}
+}
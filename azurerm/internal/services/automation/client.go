@@ -1,6 +1,9 @@
 package automation
 
-import "github.com/Azure/azure-sdk-for-go/services/automation/mgmt/2015-10-31/automation"
+import (
+	"github.com/Azure/azure-sdk-for-go/services/automation/mgmt/2015-10-31/automation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/common"
+)
 
 type Client struct {
 	AccountClient               automation.AccountClient
@@ -14,3 +17,48 @@ type Client struct {
 	ScheduleClient              automation.ScheduleClient
 	VariableClient              automation.VariableClient
 }
+
+func BuildClient(o *common.ClientOptions) *Client {
+	accountClient := automation.NewAccountClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&accountClient.Client, o.ResourceManagerAuthorizer)
+
+	agentRegistrationInfoClient := automation.NewAgentRegistrationInformationClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&agentRegistrationInfoClient.Client, o.ResourceManagerAuthorizer)
+
+	credentialClient := automation.NewCredentialClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&credentialClient.Client, o.ResourceManagerAuthorizer)
+
+	dscConfigurationClient := automation.NewDscConfigurationClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&dscConfigurationClient.Client, o.ResourceManagerAuthorizer)
+
+	dscNodeConfigurationClient := automation.NewDscNodeConfigurationClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&dscNodeConfigurationClient.Client, o.ResourceManagerAuthorizer)
+
+	moduleClient := automation.NewModuleClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&moduleClient.Client, o.ResourceManagerAuthorizer)
+
+	runbookClient := automation.NewRunbookClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&runbookClient.Client, o.ResourceManagerAuthorizer)
+
+	scheduleClient := automation.NewScheduleClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&scheduleClient.Client, o.ResourceManagerAuthorizer)
+
+	runbookDraftClient := automation.NewRunbookDraftClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&runbookDraftClient.Client, o.ResourceManagerAuthorizer)
+
+	variableClient := automation.NewVariableClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&variableClient.Client, o.ResourceManagerAuthorizer)
+
+	return &Client{
+		AccountClient:               accountClient,
+		AgentRegistrationInfoClient: agentRegistrationInfoClient,
+		CredentialClient:            credentialClient,
+		DscConfigurationClient:      dscConfigurationClient,
+		DscNodeConfigurationClient:  dscNodeConfigurationClient,
+		ModuleClient:                moduleClient,
+		RunbookClient:               runbookClient,
+		ScheduleClient:              scheduleClient,
+		RunbookDraftClient:          runbookDraftClient,
+		VariableClient:              variableClient,
+	}
+}
@@ -2,6 +2,7 @@ package cdn
 
 import (
 	"github.com/Azure/azure-sdk-for-go/services/cdn/mgmt/2017-10-12/cdn"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/common"
 )
 
 type Client struct {
@@ -9,3 +10,20 @@ type Client struct {
 	EndpointsClient     cdn.EndpointsClient
 	ProfilesClient      cdn.ProfilesClient
 }
+
+func BuildClient(o *common.ClientOptions) *Client {
+	customDomainsClient := cdn.NewCustomDomainsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&customDomainsClient.Client, o.ResourceManagerAuthorizer)
+
+	endpointsClient := cdn.NewEndpointsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&endpointsClient.Client, o.ResourceManagerAuthorizer)
+
+	profilesClient := cdn.NewProfilesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&profilesClient.Client, o.ResourceManagerAuthorizer)
+
+	return &Client{
+		CustomDomainsClient: customDomainsClient,
+		EndpointsClient:     endpointsClient,
+		ProfilesClient:      profilesClient,
+	}
+}
@@ -0,0 +1,231 @@
+package compute
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2018-06-01/compute"
+)
+
+// AvailabilityCache caches the Resource SKUs and Usages retrieved from the API for the lifetime of the Provider,
+// since these are expensive to retrieve and are used when planning multiple resources
+type AvailabilityCache struct {
+	lock   sync.Mutex
+	skus   *[]compute.ResourceSku
+	usages map[string][]compute.Usage
+}
+
+// ResourceSkus returns the Resource SKUs available within the Subscription
+func (c *AvailabilityCache) ResourceSkus(ctx context.Context, client compute.ResourceSkusClient) ([]compute.ResourceSku, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if c.skus != nil {
+		return *c.skus, nil
+	}
+
+	skus := make([]compute.ResourceSku, 0)
+	iterator, err := client.ListComplete(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("Error listing Resource SKUs: %+v", err)
+	}
+	for iterator.NotDone() {
+		skus = append(skus, iterator.Value())
+		if err := iterator.NextWithContext(ctx); err != nil {
+			return nil, fmt.Errorf("Error listing Resource SKUs: %+v", err)
+		}
+	}
+
+	c.skus = &skus
+	return skus, nil
+}
+
+// Usages returns the Compute Usages (and Limits) within the specified Location
+func (c *AvailabilityCache) Usages(ctx context.Context, client compute.UsageClient, location string) ([]compute.Usage, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	location = normalizeLocation(location)
+	if usages, ok := c.usages[location]; ok {
+		return usages, nil
+	}
+
+	usages := make([]compute.Usage, 0)
+	iterator, err := client.ListComplete(ctx, location)
+	if err != nil {
+		return nil, fmt.Errorf("Error listing Compute Usages in %q: %+v", location, err)
+	}
+	for iterator.NotDone() {
+		usages = append(usages, iterator.Value())
+		if err := iterator.NextWithContext(ctx); err != nil {
+			return nil, fmt.Errorf("Error listing Compute Usages in %q: %+v", location, err)
+		}
+	}
+
+	if c.usages == nil {
+		c.usages = make(map[string][]compute.Usage)
+	}
+	c.usages[location] = usages
+	return usages, nil
+}
+
+// FindResourceSku returns the Resource SKU of the specified Resource Type (e.g. `virtualMachines` or `disks`)
+// with the specified name which is offered in the specified Location - or nil if it isn't offered
+func FindResourceSku(skus []compute.ResourceSku, resourceType string, name string, location string) *compute.ResourceSku {
+	location = normalizeLocation(location)
+
+	for _, sku := range skus {
+		if sku.ResourceType == nil || !strings.EqualFold(*sku.ResourceType, resourceType) {
+			continue
+		}
+
+		if sku.Name == nil || !strings.EqualFold(*sku.Name, name) {
+			continue
+		}
+
+		if sku.Locations == nil {
+			continue
+		}
+
+		for _, l := range *sku.Locations {
+			if normalizeLocation(l) == location {
+				s := sku
+				return &s
+			}
+		}
+	}
+
+	return nil
+}
+
+// ValidateSkuAvailability validates that the specified SKU is offered to this Subscription in the specified
+// Location and Zones, returning the matching Resource SKU
+func ValidateSkuAvailability(skus []compute.ResourceSku, resourceType string, name string, location string, zones []string) (*compute.ResourceSku, error) {
+	sku := FindResourceSku(skus, resourceType, name, location)
+	if sku == nil {
+		return nil, fmt.Errorf("The SKU %q is not available in %q", name, location)
+	}
+
+	location = normalizeLocation(location)
+
+	restrictedZones := make(map[string]bool)
+	if sku.Restrictions != nil {
+		for _, restriction := range *sku.Restrictions {
+			switch restriction.Type {
+			case compute.Location:
+				if restriction.Values == nil {
+					continue
+				}
+				for _, v := range *restriction.Values {
+					if normalizeLocation(v) == location {
+						return nil, fmt.Errorf("The SKU %q is not available to this Subscription in %q (Reason: %s)", name, location, string(restriction.ReasonCode))
+					}
+				}
+
+			case compute.Zone:
+				if info := restriction.RestrictionInfo; info != nil && info.Zones != nil {
+					for _, zone := range *info.Zones {
+						restrictedZones[zone] = true
+					}
+				}
+			}
+		}
+	}
+
+	if len(zones) == 0 {
+		return sku, nil
+	}
+
+	availableZones := make([]string, 0)
+	if sku.LocationInfo != nil {
+		for _, info := range *sku.LocationInfo {
+			if info.Location == nil || normalizeLocation(*info.Location) != location || info.Zones == nil {
+				continue
+			}
+
+			for _, zone := range *info.Zones {
+				if !restrictedZones[zone] {
+					availableZones = append(availableZones, zone)
+				}
+			}
+		}
+	}
+	sort.Strings(availableZones)
+
+	for _, zone := range zones {
+		found := false
+		for _, availableZone := range availableZones {
+			if zone == availableZone {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			if len(availableZones) == 0 {
+				return nil, fmt.Errorf("The SKU %q is not available in any Availability Zones in %q", name, location)
+			}
+
+			return nil, fmt.Errorf("The SKU %q is not available in Availability Zone %q in %q (Available Zones: %s)", name, zone, location, strings.Join(availableZones, ", "))
+		}
+	}
+
+	return sku, nil
+}
+
+// ResourceSkuVCPUs returns the number of vCPUs for the specified Virtual Machine Resource SKU
+func ResourceSkuVCPUs(sku compute.ResourceSku) int64 {
+	if sku.Capabilities == nil {
+		return 0
+	}
+
+	for _, capability := range *sku.Capabilities {
+		if capability.Name == nil || capability.Value == nil || !strings.EqualFold(*capability.Name, "vCPUs") {
+			continue
+		}
+
+		if v, err := strconv.ParseInt(*capability.Value, 10, 64); err == nil {
+			return v
+		}
+	}
+
+	return 0
+}
+
+// ValidateCoreQuota validates that the specified number of additional vCPUs are available within the quota
+// with the specified name - either `cores` (Total Regional vCPUs) or a VM Family (e.g. `standardDSv2Family`)
+func ValidateCoreQuota(usages []compute.Usage, name string, cores int64) error {
+	if cores <= 0 {
+		return nil
+	}
+
+	for _, usage := range usages {
+		if usage.Name == nil || usage.Name.Value == nil || !strings.EqualFold(*usage.Name.Value, name) {
+			continue
+		}
+
+		if usage.Limit == nil || usage.CurrentValue == nil {
+			continue
+		}
+
+		available := *usage.Limit - int64(*usage.CurrentValue)
+		if cores > available {
+			description := name
+			if usage.Name.LocalizedValue != nil {
+				description = *usage.Name.LocalizedValue
+			}
+
+			return fmt.Errorf("Insufficient quota for %q: %d additional vCPUs are required but only %d of %d are available", description, cores, available, *usage.Limit)
+		}
+	}
+
+	return nil
+}
+
+func normalizeLocation(input string) string {
+	return strings.Replace(strings.ToLower(input), " ", "", -1)
+}
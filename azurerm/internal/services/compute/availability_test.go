@@ -0,0 +1,233 @@
+package compute
+
+import (
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2018-06-01/compute"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func testResourceSkus() []compute.ResourceSku {
+	return []compute.ResourceSku{
+		{
+			ResourceType: utils.String("virtualMachines"),
+			Name:         utils.String("Standard_DS2_v2"),
+			Family:       utils.String("standardDSv2Family"),
+			Locations:    &[]string{"westeurope"},
+			LocationInfo: &[]compute.ResourceSkuLocationInfo{
+				{
+					Location: utils.String("westeurope"),
+					Zones:    &[]string{"1", "2", "3"},
+				},
+			},
+			Capabilities: &[]compute.ResourceSkuCapabilities{
+				{
+					Name:  utils.String("vCPUs"),
+					Value: utils.String("2"),
+				},
+			},
+			Restrictions: &[]compute.ResourceSkuRestrictions{
+				{
+					Type:   compute.Zone,
+					Values: &[]string{"westeurope"},
+					RestrictionInfo: &compute.ResourceSkuRestrictionInfo{
+						Locations: &[]string{"westeurope"},
+						Zones:     &[]string{"3"},
+					},
+					ReasonCode: compute.NotAvailableForSubscription,
+				},
+			},
+		},
+		{
+			ResourceType: utils.String("virtualMachines"),
+			Name:         utils.String("Standard_M128s"),
+			Family:       utils.String("standardMSFamily"),
+			Locations:    &[]string{"westus"},
+			Restrictions: &[]compute.ResourceSkuRestrictions{
+				{
+					Type:       compute.Location,
+					Values:     &[]string{"westus"},
+					ReasonCode: compute.NotAvailableForSubscription,
+				},
+			},
+		},
+		{
+			ResourceType: utils.String("disks"),
+			Name:         utils.String("Premium_LRS"),
+			Locations:    &[]string{"westeurope"},
+		},
+	}
+}
+
+func TestValidateSkuAvailability(t *testing.T) {
+	testData := []struct {
+		Name         string
+		ResourceType string
+		Sku          string
+		Location     string
+		Zones        []string
+		Error        bool
+	}{
+		{
+			Name:         "Available",
+			ResourceType: "virtualMachines",
+			Sku:          "standard_ds2_v2",
+			Location:     "West Europe",
+		},
+		{
+			Name:         "Different Resource Type",
+			ResourceType: "disks",
+			Sku:          "Standard_DS2_v2",
+			Location:     "West Europe",
+			Error:        true,
+		},
+		{
+			Name:         "Not Offered in Location",
+			ResourceType: "virtualMachines",
+			Sku:          "Standard_DS2_v2",
+			Location:     "East US",
+			Error:        true,
+		},
+		{
+			Name:         "Restricted in Location",
+			ResourceType: "virtualMachines",
+			Sku:          "Standard_M128s",
+			Location:     "westus",
+			Error:        true,
+		},
+		{
+			Name:         "Available in Zones",
+			ResourceType: "virtualMachines",
+			Sku:          "Standard_DS2_v2",
+			Location:     "westeurope",
+			Zones:        []string{"1", "2"},
+		},
+		{
+			Name:         "Restricted in Zone",
+			ResourceType: "virtualMachines",
+			Sku:          "Standard_DS2_v2",
+			Location:     "westeurope",
+			Zones:        []string{"3"},
+			Error:        true,
+		},
+		{
+			Name:         "No Zones available",
+			ResourceType: "disks",
+			Sku:          "Premium_LRS",
+			Location:     "westeurope",
+			Zones:        []string{"1"},
+			Error:        true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		sku, err := ValidateSkuAvailability(testResourceSkus(), v.ResourceType, v.Sku, v.Location, v.Zones)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expected no error but got: %+v", err)
+		}
+
+		if v.Error {
+			t.Fatalf("Expected an error but didn't get one")
+		}
+
+		if sku == nil {
+			t.Fatalf("Expected a SKU but got nil")
+		}
+	}
+}
+
+func TestResourceSkuVCPUs(t *testing.T) {
+	skus := testResourceSkus()
+
+	if actual := ResourceSkuVCPUs(skus[0]); actual != 2 {
+		t.Fatalf("Expected 2 vCPUs but got %d", actual)
+	}
+
+	if actual := ResourceSkuVCPUs(skus[1]); actual != 0 {
+		t.Fatalf("Expected 0 vCPUs but got %d", actual)
+	}
+}
+
+func TestValidateCoreQuota(t *testing.T) {
+	usages := []compute.Usage{
+		{
+			Name: &compute.UsageName{
+				Value:          utils.String("cores"),
+				LocalizedValue: utils.String("Total Regional vCPUs"),
+			},
+			CurrentValue: utils.Int32(10),
+			Limit:        utils.Int64(20),
+		},
+		{
+			Name: &compute.UsageName{
+				Value:          utils.String("standardDSv2Family"),
+				LocalizedValue: utils.String("Standard DSv2 Family vCPUs"),
+			},
+			CurrentValue: utils.Int32(4),
+			Limit:        utils.Int64(8),
+		},
+	}
+
+	testData := []struct {
+		Name      string
+		UsageName string
+		Cores     int64
+		Error     bool
+	}{
+		{
+			Name:      "No additional Cores",
+			UsageName: "standardDSv2Family",
+			Cores:     0,
+		},
+		{
+			Name:      "Within Family Quota",
+			UsageName: "standardDSv2Family",
+			Cores:     4,
+		},
+		{
+			Name:      "Exceeds Family Quota",
+			UsageName: "standardDSv2Family",
+			Cores:     6,
+			Error:     true,
+		},
+		{
+			Name:      "Within Regional Quota",
+			UsageName: "cores",
+			Cores:     10,
+		},
+		{
+			Name:      "Exceeds Regional Quota",
+			UsageName: "cores",
+			Cores:     12,
+			Error:     true,
+		},
+		{
+			Name:      "Unknown Usage",
+			UsageName: "standardFSv2Family",
+			Cores:     100,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		err := ValidateCoreQuota(usages, v.UsageName, v.Cores)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expected no error but got: %+v", err)
+		}
+
+		if v.Error {
+			t.Fatalf("Expected an error but didn't get one")
+		}
+	}
+}
@@ -0,0 +1,96 @@
+package compute
+
+import (
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2018-06-01/compute"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/common"
+)
+
+type Client struct {
+	AvailabilityCache               *AvailabilityCache
+	AvailabilitySetsClient          compute.AvailabilitySetsClient
+	DisksClient                     compute.DisksClient
+	ImagesClient                    compute.ImagesClient
+	ResourceSkusClient              compute.ResourceSkusClient
+	SnapshotsClient                 compute.SnapshotsClient
+	UsageClient                     compute.UsageClient
+	VMExtensionImageClient          compute.VirtualMachineExtensionImagesClient
+	VMExtensionClient               compute.VirtualMachineExtensionsClient
+	VMImageClient                   compute.VirtualMachineImagesClient
+	VMScaleSetClient                compute.VirtualMachineScaleSetsClient
+	VMScaleSetRollingUpgradesClient compute.VirtualMachineScaleSetRollingUpgradesClient
+	VMScaleSetVMsClient             compute.VirtualMachineScaleSetVMsClient
+	VMClient                        compute.VirtualMachinesClient
+	GalleriesClient                 compute.GalleriesClient
+	GalleryImagesClient             compute.GalleryImagesClient
+	GalleryImageVersionsClient      compute.GalleryImageVersionsClient
+}
+
+func BuildClient(o *common.ClientOptions) *Client {
+	availabilitySetsClient := compute.NewAvailabilitySetsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&availabilitySetsClient.Client, o.ResourceManagerAuthorizer)
+
+	disksClient := compute.NewDisksClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&disksClient.Client, o.ResourceManagerAuthorizer)
+
+	imagesClient := compute.NewImagesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&imagesClient.Client, o.ResourceManagerAuthorizer)
+
+	resourceSkusClient := compute.NewResourceSkusClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&resourceSkusClient.Client, o.ResourceManagerAuthorizer)
+
+	snapshotsClient := compute.NewSnapshotsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&snapshotsClient.Client, o.ResourceManagerAuthorizer)
+
+	usageClient := compute.NewUsageClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&usageClient.Client, o.ResourceManagerAuthorizer)
+
+	vmExtensionImageClient := compute.NewVirtualMachineExtensionImagesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&vmExtensionImageClient.Client, o.ResourceManagerAuthorizer)
+
+	vmExtensionClient := compute.NewVirtualMachineExtensionsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&vmExtensionClient.Client, o.ResourceManagerAuthorizer)
+
+	vmImageClient := compute.NewVirtualMachineImagesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&vmImageClient.Client, o.ResourceManagerAuthorizer)
+
+	vmScaleSetClient := compute.NewVirtualMachineScaleSetsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&vmScaleSetClient.Client, o.ResourceManagerAuthorizer)
+
+	vmScaleSetRollingUpgradesClient := compute.NewVirtualMachineScaleSetRollingUpgradesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&vmScaleSetRollingUpgradesClient.Client, o.ResourceManagerAuthorizer)
+
+	vmScaleSetVMsClient := compute.NewVirtualMachineScaleSetVMsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&vmScaleSetVMsClient.Client, o.ResourceManagerAuthorizer)
+
+	vmClient := compute.NewVirtualMachinesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&vmClient.Client, o.ResourceManagerAuthorizer)
+
+	galleriesClient := compute.NewGalleriesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&galleriesClient.Client, o.ResourceManagerAuthorizer)
+
+	galleryImagesClient := compute.NewGalleryImagesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&galleryImagesClient.Client, o.ResourceManagerAuthorizer)
+
+	galleryImageVersionsClient := compute.NewGalleryImageVersionsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&galleryImageVersionsClient.Client, o.AuxiliaryTenantsAuthorizer)
+
+	return &Client{
+		AvailabilityCache:               &AvailabilityCache{},
+		AvailabilitySetsClient:          availabilitySetsClient,
+		DisksClient:                     disksClient,
+		ImagesClient:                    imagesClient,
+		ResourceSkusClient:              resourceSkusClient,
+		SnapshotsClient:                 snapshotsClient,
+		UsageClient:                     usageClient,
+		VMExtensionImageClient:          vmExtensionImageClient,
+		VMExtensionClient:               vmExtensionClient,
+		VMImageClient:                   vmImageClient,
+		VMScaleSetClient:                vmScaleSetClient,
+		VMScaleSetRollingUpgradesClient: vmScaleSetRollingUpgradesClient,
+		VMScaleSetVMsClient:             vmScaleSetVMsClient,
+		VMClient:                        vmClient,
+		GalleriesClient:                 galleriesClient,
+		GalleryImagesClient:             galleryImagesClient,
+		GalleryImageVersionsClient:      galleryImageVersionsClient,
+	}
+}
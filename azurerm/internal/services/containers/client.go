@@ -4,6 +4,7 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/containerinstance/mgmt/2018-10-01/containerinstance"
 	"github.com/Azure/azure-sdk-for-go/services/containerregistry/mgmt/2017-10-01/containerregistry"
 	"github.com/Azure/azure-sdk-for-go/services/containerservice/mgmt/2019-02-01/containerservice"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/common"
 )
 
 type Client struct {
@@ -13,3 +14,30 @@ type Client struct {
 	RegistryReplicationsClient containerregistry.ReplicationsClient
 	ServicesClient             containerservice.ContainerServicesClient
 }
+
+func BuildClient(o *common.ClientOptions) *Client {
+	registryClient := containerregistry.NewRegistriesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&registryClient.Client, o.ResourceManagerAuthorizer)
+
+	registryReplicationsClient := containerregistry.NewReplicationsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&registryReplicationsClient.Client, o.ResourceManagerAuthorizer)
+
+	groupsClient := containerinstance.NewContainerGroupsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&groupsClient.Client, o.ResourceManagerAuthorizer)
+
+	// ACS
+	servicesClient := containerservice.NewContainerServicesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&servicesClient.Client, o.ResourceManagerAuthorizer)
+
+	// AKS
+	kubernetesClustersClient := containerservice.NewManagedClustersClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&kubernetesClustersClient.Client, o.ResourceManagerAuthorizer)
+
+	return &Client{
+		RegistryClient:             registryClient,
+		RegistryReplicationsClient: registryReplicationsClient,
+		GroupsClient:               groupsClient,
+		ServicesClient:             servicesClient,
+		KubernetesClustersClient:   kubernetesClustersClient,
+	}
+}
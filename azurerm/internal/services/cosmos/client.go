@@ -0,0 +1,19 @@
+package cosmos
+
+import (
+	"github.com/Azure/azure-sdk-for-go/services/cosmos-db/mgmt/2015-04-08/documentdb"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/common"
+)
+
+type Client struct {
+	DatabaseAccountsClient documentdb.DatabaseAccountsClient
+}
+
+func BuildClient(o *common.ClientOptions) *Client {
+	databaseAccountsClient := documentdb.NewDatabaseAccountsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&databaseAccountsClient.Client, o.ResourceManagerAuthorizer)
+
+	return &Client{
+		DatabaseAccountsClient: databaseAccountsClient,
+	}
+}
@@ -1,7 +1,19 @@
 package databricks
 
-import "github.com/Azure/azure-sdk-for-go/services/databricks/mgmt/2018-04-01/databricks"
+import (
+	"github.com/Azure/azure-sdk-for-go/services/databricks/mgmt/2018-04-01/databricks"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/common"
+)
 
 type Client struct {
 	WorkspacesClient databricks.WorkspacesClient
 }
+
+func BuildClient(o *common.ClientOptions) *Client {
+	workspacesClient := databricks.NewWorkspacesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&workspacesClient.Client, o.ResourceManagerAuthorizer)
+
+	return &Client{
+		WorkspacesClient: workspacesClient,
+	}
+}
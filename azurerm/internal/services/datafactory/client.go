@@ -1,6 +1,9 @@
 package datafactory
 
-import "github.com/Azure/azure-sdk-for-go/services/datafactory/mgmt/2018-06-01/datafactory"
+import (
+	"github.com/Azure/azure-sdk-for-go/services/datafactory/mgmt/2018-06-01/datafactory"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/common"
+)
 
 type Client struct {
 	DatasetClient       datafactory.DatasetsClient
@@ -8,3 +11,24 @@ type Client struct {
 	LinkedServiceClient datafactory.LinkedServicesClient
 	PipelinesClient     datafactory.PipelinesClient
 }
+
+func BuildClient(o *common.ClientOptions) *Client {
+	factoriesClient := datafactory.NewFactoriesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&factoriesClient.Client, o.ResourceManagerAuthorizer)
+
+	datasetClient := datafactory.NewDatasetsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&datasetClient.Client, o.ResourceManagerAuthorizer)
+
+	linkedServiceClient := datafactory.NewLinkedServicesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&linkedServiceClient.Client, o.ResourceManagerAuthorizer)
+
+	pipelinesClient := datafactory.NewPipelinesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&pipelinesClient.Client, o.ResourceManagerAuthorizer)
+
+	return &Client{
+		FactoriesClient:     factoriesClient,
+		DatasetClient:       datasetClient,
+		LinkedServiceClient: linkedServiceClient,
+		PipelinesClient:     pipelinesClient,
+	}
+}
@@ -0,0 +1,41 @@
+package datalake
+
+import (
+	analyticsAccount "github.com/Azure/azure-sdk-for-go/services/datalake/analytics/mgmt/2016-11-01/account"
+	"github.com/Azure/azure-sdk-for-go/services/datalake/store/2016-11-01/filesystem"
+	storeAccount "github.com/Azure/azure-sdk-for-go/services/datalake/store/mgmt/2016-11-01/account"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/common"
+)
+
+type Client struct {
+	StoreAccountsClient          storeAccount.AccountsClient
+	StoreFirewallRulesClient     storeAccount.FirewallRulesClient
+	AnalyticsAccountsClient      analyticsAccount.AccountsClient
+	StoreFilesClient             filesystem.Client
+	AnalyticsFirewallRulesClient analyticsAccount.FirewallRulesClient
+}
+
+func BuildClient(o *common.ClientOptions) *Client {
+	storeAccountsClient := storeAccount.NewAccountsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&storeAccountsClient.Client, o.ResourceManagerAuthorizer)
+
+	storeFirewallRulesClient := storeAccount.NewFirewallRulesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&storeFirewallRulesClient.Client, o.ResourceManagerAuthorizer)
+
+	analyticsAccountsClient := analyticsAccount.NewAccountsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&analyticsAccountsClient.Client, o.ResourceManagerAuthorizer)
+
+	storeFilesClient := filesystem.NewClient()
+	o.ConfigureClient(&storeFilesClient.Client, o.ResourceManagerAuthorizer)
+
+	analyticsFirewallRulesClient := analyticsAccount.NewFirewallRulesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&analyticsFirewallRulesClient.Client, o.ResourceManagerAuthorizer)
+
+	return &Client{
+		StoreAccountsClient:          storeAccountsClient,
+		StoreFirewallRulesClient:     storeFirewallRulesClient,
+		AnalyticsAccountsClient:      analyticsAccountsClient,
+		StoreFilesClient:             storeFilesClient,
+		AnalyticsFirewallRulesClient: analyticsFirewallRulesClient,
+	}
+}
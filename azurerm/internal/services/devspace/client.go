@@ -1,7 +1,19 @@
 package devspace
 
-import "github.com/Azure/azure-sdk-for-go/services/preview/devspaces/mgmt/2018-06-01-preview/devspaces"
+import (
+	"github.com/Azure/azure-sdk-for-go/services/preview/devspaces/mgmt/2018-06-01-preview/devspaces"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/common"
+)
 
 type Client struct {
 	ControllersClient devspaces.ControllersClient
 }
+
+func BuildClient(o *common.ClientOptions) *Client {
+	controllersClient := devspaces.NewControllersClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&controllersClient.Client, o.ResourceManagerAuthorizer)
+
+	return &Client{
+		ControllersClient: controllersClient,
+	}
+}
@@ -0,0 +1,34 @@
+package devtestlabs
+
+import (
+	"github.com/Azure/azure-sdk-for-go/services/devtestlabs/mgmt/2016-05-15/dtl"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/common"
+)
+
+type Client struct {
+	LabsClient            dtl.LabsClient
+	PoliciesClient        dtl.PoliciesClient
+	VirtualMachinesClient dtl.VirtualMachinesClient
+	VirtualNetworksClient dtl.VirtualNetworksClient
+}
+
+func BuildClient(o *common.ClientOptions) *Client {
+	labsClient := dtl.NewLabsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&labsClient.Client, o.ResourceManagerAuthorizer)
+
+	policiesClient := dtl.NewPoliciesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&policiesClient.Client, o.ResourceManagerAuthorizer)
+
+	virtualMachinesClient := dtl.NewVirtualMachinesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&virtualMachinesClient.Client, o.ResourceManagerAuthorizer)
+
+	virtualNetworksClient := dtl.NewVirtualNetworksClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&virtualNetworksClient.Client, o.ResourceManagerAuthorizer)
+
+	return &Client{
+		LabsClient:            labsClient,
+		PoliciesClient:        policiesClient,
+		VirtualMachinesClient: virtualMachinesClient,
+		VirtualNetworksClient: virtualNetworksClient,
+	}
+}
@@ -1,8 +1,24 @@
 package dns
 
-import "github.com/Azure/azure-sdk-for-go/services/preview/dns/mgmt/2018-03-01-preview/dns"
+import (
+	"github.com/Azure/azure-sdk-for-go/services/preview/dns/mgmt/2018-03-01-preview/dns"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/common"
+)
 
 type Client struct {
 	RecordSetsClient dns.RecordSetsClient
 	ZonesClient      dns.ZonesClient
 }
+
+func BuildClient(o *common.ClientOptions) *Client {
+	recordSetsClient := dns.NewRecordSetsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&recordSetsClient.Client, o.ResourceManagerAuthorizer)
+
+	zonesClient := dns.NewZonesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&zonesClient.Client, o.ResourceManagerAuthorizer)
+
+	return &Client{
+		RecordSetsClient: recordSetsClient,
+		ZonesClient:      zonesClient,
+	}
+}
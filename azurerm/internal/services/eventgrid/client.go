@@ -1,9 +1,29 @@
 package eventgrid
 
-import "github.com/Azure/azure-sdk-for-go/services/preview/eventgrid/mgmt/2018-09-15-preview/eventgrid"
+import (
+	"github.com/Azure/azure-sdk-for-go/services/preview/eventgrid/mgmt/2018-09-15-preview/eventgrid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/common"
+)
 
 type Client struct {
 	DomainsClient            eventgrid.DomainsClient
 	EventSubscriptionsClient eventgrid.EventSubscriptionsClient
 	TopicsClient             eventgrid.TopicsClient
 }
+
+func BuildClient(o *common.ClientOptions) *Client {
+	domainsClient := eventgrid.NewDomainsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&domainsClient.Client, o.ResourceManagerAuthorizer)
+
+	eventSubscriptionsClient := eventgrid.NewEventSubscriptionsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&eventSubscriptionsClient.Client, o.ResourceManagerAuthorizer)
+
+	topicsClient := eventgrid.NewTopicsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&topicsClient.Client, o.ResourceManagerAuthorizer)
+
+	return &Client{
+		DomainsClient:            domainsClient,
+		EventSubscriptionsClient: eventSubscriptionsClient,
+		TopicsClient:             topicsClient,
+	}
+}
@@ -2,6 +2,7 @@ package eventhub
 
 import (
 	"github.com/Azure/azure-sdk-for-go/services/eventhub/mgmt/2017-04-01/eventhub"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/common"
 )
 
 type Client struct {
@@ -9,3 +10,20 @@ type Client struct {
 	EventHubsClient     eventhub.EventHubsClient
 	NamespacesClient    eventhub.NamespacesClient
 }
+
+func BuildClient(o *common.ClientOptions) *Client {
+	eventHubsClient := eventhub.NewEventHubsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&eventHubsClient.Client, o.ResourceManagerAuthorizer)
+
+	consumerGroupClient := eventhub.NewConsumerGroupsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&consumerGroupClient.Client, o.ResourceManagerAuthorizer)
+
+	namespacesClient := eventhub.NewNamespacesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&namespacesClient.Client, o.ResourceManagerAuthorizer)
+
+	return &Client{
+		EventHubsClient:     eventHubsClient,
+		ConsumerGroupClient: consumerGroupClient,
+		NamespacesClient:    namespacesClient,
+	}
+}
@@ -0,0 +1,24 @@
+package graph
+
+import (
+	"github.com/Azure/azure-sdk-for-go/services/graphrbac/1.6/graphrbac"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/common"
+)
+
+type Client struct {
+	ApplicationsClient      graphrbac.ApplicationsClient
+	ServicePrincipalsClient graphrbac.ServicePrincipalsClient
+}
+
+func BuildClient(o *common.ClientOptions) *Client {
+	applicationsClient := graphrbac.NewApplicationsClientWithBaseURI(o.GraphEndpoint, o.TenantId)
+	o.ConfigureClient(&applicationsClient.Client, o.GraphAuthorizer)
+
+	servicePrincipalsClient := graphrbac.NewServicePrincipalsClientWithBaseURI(o.GraphEndpoint, o.TenantId)
+	o.ConfigureClient(&servicePrincipalsClient.Client, o.GraphAuthorizer)
+
+	return &Client{
+		ApplicationsClient:      applicationsClient,
+		ServicePrincipalsClient: servicePrincipalsClient,
+	}
+}
@@ -1,9 +1,29 @@
 package hdinsight
 
-import "github.com/Azure/azure-sdk-for-go/services/preview/hdinsight/mgmt/2018-06-01-preview/hdinsight"
+import (
+	"github.com/Azure/azure-sdk-for-go/services/preview/hdinsight/mgmt/2018-06-01-preview/hdinsight"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/common"
+)
 
 type Client struct {
 	ApplicationsClient   hdinsight.ApplicationsClient
 	ClustersClient       hdinsight.ClustersClient
 	ConfigurationsClient hdinsight.ConfigurationsClient
 }
+
+func BuildClient(o *common.ClientOptions) *Client {
+	applicationsClient := hdinsight.NewApplicationsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&applicationsClient.Client, o.ResourceManagerAuthorizer)
+
+	clustersClient := hdinsight.NewClustersClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&clustersClient.Client, o.ResourceManagerAuthorizer)
+
+	configurationsClient := hdinsight.NewConfigurationsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&configurationsClient.Client, o.ResourceManagerAuthorizer)
+
+	return &Client{
+		ApplicationsClient:   applicationsClient,
+		ClustersClient:       clustersClient,
+		ConfigurationsClient: configurationsClient,
+	}
+}
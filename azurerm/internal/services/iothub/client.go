@@ -1,7 +1,19 @@
 package iothub
 
-import "github.com/Azure/azure-sdk-for-go/services/preview/iothub/mgmt/2018-12-01-preview/devices"
+import (
+	"github.com/Azure/azure-sdk-for-go/services/preview/iothub/mgmt/2018-12-01-preview/devices"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/common"
+)
 
 type Client struct {
 	ResourceClient devices.IotHubResourceClient
 }
+
+func BuildClient(o *common.ClientOptions) *Client {
+	resourceClient := devices.NewIotHubResourceClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&resourceClient.Client, o.ResourceManagerAuthorizer)
+
+	return &Client{
+		ResourceClient: resourceClient,
+	}
+}
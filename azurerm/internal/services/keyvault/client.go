@@ -0,0 +1,25 @@
+package keyvault
+
+import (
+	keyVault "github.com/Azure/azure-sdk-for-go/services/keyvault/2016-10-01/keyvault"
+	"github.com/Azure/azure-sdk-for-go/services/keyvault/mgmt/2018-02-14/keyvault"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/common"
+)
+
+type Client struct {
+	VaultsClient     keyvault.VaultsClient
+	ManagementClient keyVault.BaseClient
+}
+
+func BuildClient(o *common.ClientOptions) *Client {
+	vaultsClient := keyvault.NewVaultsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&vaultsClient.Client, o.ResourceManagerAuthorizer)
+
+	managementClient := keyVault.New()
+	o.ConfigureClient(&managementClient.Client, o.KeyVaultAuthorizer)
+
+	return &Client{
+		VaultsClient:     vaultsClient,
+		ManagementClient: managementClient,
+	}
+}
@@ -3,6 +3,7 @@ package loganalytics
 import (
 	"github.com/Azure/azure-sdk-for-go/services/preview/operationalinsights/mgmt/2015-11-01-preview/operationalinsights"
 	"github.com/Azure/azure-sdk-for-go/services/preview/operationsmanagement/mgmt/2015-11-01-preview/operationsmanagement"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/common"
 )
 
 type Client struct {
@@ -10,3 +11,20 @@ type Client struct {
 	SolutionsClient      operationsmanagement.SolutionsClient
 	WorkspacesClient     operationalinsights.WorkspacesClient
 }
+
+func BuildClient(o *common.ClientOptions) *Client {
+	workspacesClient := operationalinsights.NewWorkspacesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&workspacesClient.Client, o.ResourceManagerAuthorizer)
+
+	solutionsClient := operationsmanagement.NewSolutionsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId, "Microsoft.OperationsManagement", "solutions", "testing")
+	o.ConfigureClient(&solutionsClient.Client, o.ResourceManagerAuthorizer)
+
+	linkedServicesClient := operationalinsights.NewLinkedServicesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&linkedServicesClient.Client, o.ResourceManagerAuthorizer)
+
+	return &Client{
+		WorkspacesClient:     workspacesClient,
+		SolutionsClient:      solutionsClient,
+		LinkedServicesClient: linkedServicesClient,
+	}
+}
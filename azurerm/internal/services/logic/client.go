@@ -0,0 +1,19 @@
+package logic
+
+import (
+	"github.com/Azure/azure-sdk-for-go/services/logic/mgmt/2016-06-01/logic"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/common"
+)
+
+type Client struct {
+	WorkflowsClient logic.WorkflowsClient
+}
+
+func BuildClient(o *common.ClientOptions) *Client {
+	workflowsClient := logic.NewWorkflowsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&workflowsClient.Client, o.ResourceManagerAuthorizer)
+
+	return &Client{
+		WorkflowsClient: workflowsClient,
+	}
+}
@@ -0,0 +1,24 @@
+package managementgroup
+
+import (
+	"github.com/Azure/azure-sdk-for-go/services/preview/resources/mgmt/2018-03-01-preview/managementgroups"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/common"
+)
+
+type Client struct {
+	GroupsClient       managementgroups.Client
+	SubscriptionClient managementgroups.SubscriptionsClient
+}
+
+func BuildClient(o *common.ClientOptions) *Client {
+	groupsClient := managementgroups.NewClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&groupsClient.Client, o.ResourceManagerAuthorizer)
+
+	subscriptionClient := managementgroups.NewSubscriptionsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&subscriptionClient.Client, o.ResourceManagerAuthorizer)
+
+	return &Client{
+		GroupsClient:       groupsClient,
+		SubscriptionClient: subscriptionClient,
+	}
+}
@@ -0,0 +1,24 @@
+package mariadb
+
+import (
+	"github.com/Azure/azure-sdk-for-go/services/mariadb/mgmt/2018-06-01/mariadb"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/common"
+)
+
+type Client struct {
+	DatabasesClient mariadb.DatabasesClient
+	ServersClient   mariadb.ServersClient
+}
+
+func BuildClient(o *common.ClientOptions) *Client {
+	databasesClient := mariadb.NewDatabasesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&databasesClient.Client, o.ResourceManagerAuthorizer)
+
+	serversClient := mariadb.NewServersClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&serversClient.Client, o.ResourceManagerAuthorizer)
+
+	return &Client{
+		DatabasesClient: databasesClient,
+		ServersClient:   serversClient,
+	}
+}
@@ -1,7 +1,19 @@
 package media
 
-import "github.com/Azure/azure-sdk-for-go/services/mediaservices/mgmt/2018-07-01/media"
+import (
+	"github.com/Azure/azure-sdk-for-go/services/mediaservices/mgmt/2018-07-01/media"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/common"
+)
 
 type Client struct {
 	ServicesClient media.MediaservicesClient
 }
+
+func BuildClient(o *common.ClientOptions) *Client {
+	servicesClient := media.NewMediaservicesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&servicesClient.Client, o.ResourceManagerAuthorizer)
+
+	return &Client{
+		ServicesClient: servicesClient,
+	}
+}
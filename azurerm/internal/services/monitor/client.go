@@ -0,0 +1,54 @@
+package monitor
+
+import (
+	"github.com/Azure/azure-sdk-for-go/services/preview/monitor/mgmt/2018-03-01/insights"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/common"
+)
+
+type Client struct {
+	ActionGroupsClient               insights.ActionGroupsClient
+	ActivityLogAlertsClient          insights.ActivityLogAlertsClient
+	AlertRulesClient                 insights.AlertRulesClient
+	LogProfilesClient                insights.LogProfilesClient
+	MetricAlertsClient               insights.MetricAlertsClient
+	AutoscaleSettingsClient          insights.AutoscaleSettingsClient
+	DiagnosticSettingsClient         insights.DiagnosticSettingsClient
+	DiagnosticSettingsCategoryClient insights.DiagnosticSettingsCategoryClient
+}
+
+func BuildClient(o *common.ClientOptions) *Client {
+	actionGroupsClient := insights.NewActionGroupsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&actionGroupsClient.Client, o.ResourceManagerAuthorizer)
+
+	activityLogAlertsClient := insights.NewActivityLogAlertsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&activityLogAlertsClient.Client, o.ResourceManagerAuthorizer)
+
+	alertRulesClient := insights.NewAlertRulesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&alertRulesClient.Client, o.ResourceManagerAuthorizer)
+
+	logProfilesClient := insights.NewLogProfilesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&logProfilesClient.Client, o.ResourceManagerAuthorizer)
+
+	metricAlertsClient := insights.NewMetricAlertsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&metricAlertsClient.Client, o.ResourceManagerAuthorizer)
+
+	autoscaleSettingsClient := insights.NewAutoscaleSettingsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&autoscaleSettingsClient.Client, o.ResourceManagerAuthorizer)
+
+	diagnosticSettingsClient := insights.NewDiagnosticSettingsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&diagnosticSettingsClient.Client, o.ResourceManagerAuthorizer)
+
+	diagnosticSettingsCategoryClient := insights.NewDiagnosticSettingsCategoryClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&diagnosticSettingsCategoryClient.Client, o.ResourceManagerAuthorizer)
+
+	return &Client{
+		ActionGroupsClient:               actionGroupsClient,
+		ActivityLogAlertsClient:          activityLogAlertsClient,
+		AlertRulesClient:                 alertRulesClient,
+		LogProfilesClient:                logProfilesClient,
+		MetricAlertsClient:               metricAlertsClient,
+		AutoscaleSettingsClient:          autoscaleSettingsClient,
+		DiagnosticSettingsClient:         diagnosticSettingsClient,
+		DiagnosticSettingsCategoryClient: diagnosticSettingsCategoryClient,
+	}
+}
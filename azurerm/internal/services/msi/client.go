@@ -1,7 +1,19 @@
 package msi
 
-import "github.com/Azure/azure-sdk-for-go/services/preview/msi/mgmt/2015-08-31-preview/msi"
+import (
+	"github.com/Azure/azure-sdk-for-go/services/preview/msi/mgmt/2015-08-31-preview/msi"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/common"
+)
 
 type Client struct {
 	UserAssignedIdentitiesClient msi.UserAssignedIdentitiesClient
 }
+
+func BuildClient(o *common.ClientOptions) *Client {
+	userAssignedIdentitiesClient := msi.NewUserAssignedIdentitiesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&userAssignedIdentitiesClient.Client, o.ResourceManagerAuthorizer)
+
+	return &Client{
+		UserAssignedIdentitiesClient: userAssignedIdentitiesClient,
+	}
+}
@@ -0,0 +1,40 @@
+package mysql
+
+import (
+	"github.com/Azure/azure-sdk-for-go/services/mysql/mgmt/2017-12-01/mysql"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/common"
+)
+
+type Client struct {
+	ConfigurationsClient      mysql.ConfigurationsClient
+	DatabasesClient           mysql.DatabasesClient
+	FirewallRulesClient       mysql.FirewallRulesClient
+	ServersClient             mysql.ServersClient
+	VirtualNetworkRulesClient mysql.VirtualNetworkRulesClient
+}
+
+func BuildClient(o *common.ClientOptions) *Client {
+	// MySQL
+	configurationsClient := mysql.NewConfigurationsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&configurationsClient.Client, o.ResourceManagerAuthorizer)
+
+	databasesClient := mysql.NewDatabasesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&databasesClient.Client, o.ResourceManagerAuthorizer)
+
+	firewallRulesClient := mysql.NewFirewallRulesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&firewallRulesClient.Client, o.ResourceManagerAuthorizer)
+
+	serversClient := mysql.NewServersClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&serversClient.Client, o.ResourceManagerAuthorizer)
+
+	virtualNetworkRulesClient := mysql.NewVirtualNetworkRulesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&virtualNetworkRulesClient.Client, o.ResourceManagerAuthorizer)
+
+	return &Client{
+		ConfigurationsClient:      configurationsClient,
+		DatabasesClient:           databasesClient,
+		FirewallRulesClient:       firewallRulesClient,
+		ServersClient:             serversClient,
+		VirtualNetworkRulesClient: virtualNetworkRulesClient,
+	}
+}
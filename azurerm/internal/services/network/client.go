@@ -0,0 +1,139 @@
+package network
+
+import (
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-12-01/network"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/common"
+)
+
+type Client struct {
+	ApplicationGatewaysClient       network.ApplicationGatewaysClient
+	ApplicationSecurityGroupsClient network.ApplicationSecurityGroupsClient
+	AzureFirewallsClient            network.AzureFirewallsClient
+	ConnectionMonitorsClient        network.ConnectionMonitorsClient
+	DDOSProtectionPlansClient       network.DdosProtectionPlansClient
+	ExpressRouteAuthsClient         network.ExpressRouteCircuitAuthorizationsClient
+	ExpressRouteCircuitsClient      network.ExpressRouteCircuitsClient
+	ExpressRoutePeeringsClient      network.ExpressRouteCircuitPeeringsClient
+	InterfacesClient                network.InterfacesClient
+	LoadBalancersClient             network.LoadBalancersClient
+	LocalNetworkGatewaysClient      network.LocalNetworkGatewaysClient
+	VnetGatewayClient               network.VirtualNetworkGatewaysClient
+	VnetGatewayConnectionsClient    network.VirtualNetworkGatewayConnectionsClient
+	ProfileClient                   network.ProfilesClient
+	VnetClient                      network.VirtualNetworksClient
+	PacketCapturesClient            network.PacketCapturesClient
+	VnetPeeringsClient              network.VirtualNetworkPeeringsClient
+	PublicIPsClient                 network.PublicIPAddressesClient
+	PublicIPPrefixesClient          network.PublicIPPrefixesClient
+	RoutesClient                    network.RoutesClient
+	RouteTablesClient               network.RouteTablesClient
+	SecurityGroupClient             network.SecurityGroupsClient
+	SecurityRuleClient              network.SecurityRulesClient
+	SubnetsClient                   network.SubnetsClient
+	WatcherClient                   network.WatchersClient
+}
+
+func BuildClient(o *common.ClientOptions) *Client {
+	applicationGatewaysClient := network.NewApplicationGatewaysClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&applicationGatewaysClient.Client, o.ResourceManagerAuthorizer)
+
+	applicationSecurityGroupsClient := network.NewApplicationSecurityGroupsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&applicationSecurityGroupsClient.Client, o.ResourceManagerAuthorizer)
+
+	azureFirewallsClient := network.NewAzureFirewallsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&azureFirewallsClient.Client, o.ResourceManagerAuthorizer)
+
+	connectionMonitorsClient := network.NewConnectionMonitorsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&connectionMonitorsClient.Client, o.ResourceManagerAuthorizer)
+
+	ddosProtectionPlansClient := network.NewDdosProtectionPlansClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&ddosProtectionPlansClient.Client, o.ResourceManagerAuthorizer)
+
+	expressRouteAuthsClient := network.NewExpressRouteCircuitAuthorizationsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&expressRouteAuthsClient.Client, o.ResourceManagerAuthorizer)
+
+	expressRouteCircuitsClient := network.NewExpressRouteCircuitsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&expressRouteCircuitsClient.Client, o.ResourceManagerAuthorizer)
+
+	expressRoutePeeringsClient := network.NewExpressRouteCircuitPeeringsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&expressRoutePeeringsClient.Client, o.ResourceManagerAuthorizer)
+
+	interfacesClient := network.NewInterfacesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&interfacesClient.Client, o.ResourceManagerAuthorizer)
+
+	loadBalancersClient := network.NewLoadBalancersClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&loadBalancersClient.Client, o.ResourceManagerAuthorizer)
+
+	localNetworkGatewaysClient := network.NewLocalNetworkGatewaysClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&localNetworkGatewaysClient.Client, o.ResourceManagerAuthorizer)
+
+	vnetGatewayClient := network.NewVirtualNetworkGatewaysClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&vnetGatewayClient.Client, o.ResourceManagerAuthorizer)
+
+	vnetGatewayConnectionsClient := network.NewVirtualNetworkGatewayConnectionsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&vnetGatewayConnectionsClient.Client, o.AuxiliaryTenantsAuthorizer)
+
+	profileClient := network.NewProfilesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&profileClient.Client, o.ResourceManagerAuthorizer)
+
+	vnetClient := network.NewVirtualNetworksClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&vnetClient.Client, o.ResourceManagerAuthorizer)
+
+	packetCapturesClient := network.NewPacketCapturesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&packetCapturesClient.Client, o.ResourceManagerAuthorizer)
+
+	vnetPeeringsClient := network.NewVirtualNetworkPeeringsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&vnetPeeringsClient.Client, o.AuxiliaryTenantsAuthorizer)
+
+	publicIPsClient := network.NewPublicIPAddressesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&publicIPsClient.Client, o.ResourceManagerAuthorizer)
+
+	publicIPPrefixesClient := network.NewPublicIPPrefixesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&publicIPPrefixesClient.Client, o.ResourceManagerAuthorizer)
+
+	routesClient := network.NewRoutesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&routesClient.Client, o.ResourceManagerAuthorizer)
+
+	routeTablesClient := network.NewRouteTablesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&routeTablesClient.Client, o.ResourceManagerAuthorizer)
+
+	securityGroupClient := network.NewSecurityGroupsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&securityGroupClient.Client, o.ResourceManagerAuthorizer)
+
+	securityRuleClient := network.NewSecurityRulesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&securityRuleClient.Client, o.ResourceManagerAuthorizer)
+
+	subnetsClient := network.NewSubnetsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&subnetsClient.Client, o.ResourceManagerAuthorizer)
+
+	watcherClient := network.NewWatchersClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&watcherClient.Client, o.ResourceManagerAuthorizer)
+
+	return &Client{
+		ApplicationGatewaysClient:       applicationGatewaysClient,
+		ApplicationSecurityGroupsClient: applicationSecurityGroupsClient,
+		AzureFirewallsClient:            azureFirewallsClient,
+		ConnectionMonitorsClient:        connectionMonitorsClient,
+		DDOSProtectionPlansClient:       ddosProtectionPlansClient,
+		ExpressRouteAuthsClient:         expressRouteAuthsClient,
+		ExpressRouteCircuitsClient:      expressRouteCircuitsClient,
+		ExpressRoutePeeringsClient:      expressRoutePeeringsClient,
+		InterfacesClient:                interfacesClient,
+		LoadBalancersClient:             loadBalancersClient,
+		LocalNetworkGatewaysClient:      localNetworkGatewaysClient,
+		VnetGatewayClient:               vnetGatewayClient,
+		VnetGatewayConnectionsClient:    vnetGatewayConnectionsClient,
+		ProfileClient:                   profileClient,
+		VnetClient:                      vnetClient,
+		PacketCapturesClient:            packetCapturesClient,
+		VnetPeeringsClient:              vnetPeeringsClient,
+		PublicIPsClient:                 publicIPsClient,
+		PublicIPPrefixesClient:          publicIPPrefixesClient,
+		RoutesClient:                    routesClient,
+		RouteTablesClient:               routeTablesClient,
+		SecurityGroupClient:             securityGroupClient,
+		SecurityRuleClient:              securityRuleClient,
+		SubnetsClient:                   subnetsClient,
+		WatcherClient:                   watcherClient,
+	}
+}
@@ -2,9 +2,23 @@ package notificationhub
 
 import (
 	"github.com/Azure/azure-sdk-for-go/services/notificationhubs/mgmt/2017-04-01/notificationhubs"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/common"
 )
 
 type Client struct {
 	HubsClient       notificationhubs.Client
 	NamespacesClient notificationhubs.NamespacesClient
 }
+
+func BuildClient(o *common.ClientOptions) *Client {
+	namespacesClient := notificationhubs.NewNamespacesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&namespacesClient.Client, o.ResourceManagerAuthorizer)
+
+	hubsClient := notificationhubs.NewClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&hubsClient.Client, o.ResourceManagerAuthorizer)
+
+	return &Client{
+		NamespacesClient: namespacesClient,
+		HubsClient:       hubsClient,
+	}
+}
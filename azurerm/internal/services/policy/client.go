@@ -1,9 +1,29 @@
 package policy
 
-import "github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2018-05-01/policy"
+import (
+	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2018-05-01/policy"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/common"
+)
 
 type Client struct {
 	AssignmentsClient    policy.AssignmentsClient
 	DefinitionsClient    policy.DefinitionsClient
 	SetDefinitionsClient policy.SetDefinitionsClient
 }
+
+func BuildClient(o *common.ClientOptions) *Client {
+	assignmentsClient := policy.NewAssignmentsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&assignmentsClient.Client, o.ResourceManagerAuthorizer)
+
+	definitionsClient := policy.NewDefinitionsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&definitionsClient.Client, o.ResourceManagerAuthorizer)
+
+	setDefinitionsClient := policy.NewSetDefinitionsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&setDefinitionsClient.Client, o.ResourceManagerAuthorizer)
+
+	return &Client{
+		AssignmentsClient:    assignmentsClient,
+		DefinitionsClient:    definitionsClient,
+		SetDefinitionsClient: setDefinitionsClient,
+	}
+}
@@ -0,0 +1,30 @@
+package recoveryservices
+
+import (
+	"github.com/Azure/azure-sdk-for-go/services/recoveryservices/mgmt/2016-06-01/recoveryservices"
+	"github.com/Azure/azure-sdk-for-go/services/recoveryservices/mgmt/2017-07-01/backup"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/common"
+)
+
+type Client struct {
+	VaultsClient             recoveryservices.VaultsClient
+	ProtectedItemsClient     backup.ProtectedItemsGroupClient
+	ProtectionPoliciesClient backup.ProtectionPoliciesClient
+}
+
+func BuildClient(o *common.ClientOptions) *Client {
+	vaultsClient := recoveryservices.NewVaultsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&vaultsClient.Client, o.ResourceManagerAuthorizer)
+
+	protectedItemsClient := backup.NewProtectedItemsGroupClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&protectedItemsClient.Client, o.ResourceManagerAuthorizer)
+
+	protectionPoliciesClient := backup.NewProtectionPoliciesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&protectionPoliciesClient.Client, o.ResourceManagerAuthorizer)
+
+	return &Client{
+		VaultsClient:             vaultsClient,
+		ProtectedItemsClient:     protectedItemsClient,
+		ProtectionPoliciesClient: protectionPoliciesClient,
+	}
+}
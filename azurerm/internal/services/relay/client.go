@@ -1,7 +1,19 @@
 package relay
 
-import "github.com/Azure/azure-sdk-for-go/services/relay/mgmt/2017-04-01/relay"
+import (
+	"github.com/Azure/azure-sdk-for-go/services/relay/mgmt/2017-04-01/relay"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/common"
+)
 
 type Client struct {
 	NamespacesClient relay.NamespacesClient
 }
+
+func BuildClient(o *common.ClientOptions) *Client {
+	namespacesClient := relay.NewNamespacesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&namespacesClient.Client, o.ResourceManagerAuthorizer)
+
+	return &Client{
+		NamespacesClient: namespacesClient,
+	}
+}
@@ -0,0 +1,94 @@
+package resource
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	resourcesprofile "github.com/Azure/azure-sdk-for-go/profiles/2017-03-09/resources/mgmt/resources"
+)
+
+// ParseResourceType returns the Resource Provider namespace and the Resource Type (including the types of
+// any parent Resources, e.g. `virtualNetworks/subnets`) of the specified Resource ID
+func ParseResourceType(resourceId string) (string, string, error) {
+	segments := strings.Split(strings.Trim(resourceId, "/"), "/")
+
+	// extension resources are nested beneath another resource, so the last provider is the relevant one
+	providerIndex := -1
+	for i := len(segments) - 2; i >= 0; i-- {
+		if strings.EqualFold(segments[i], "providers") {
+			providerIndex = i
+			break
+		}
+	}
+	if providerIndex == -1 {
+		return "", "", fmt.Errorf("Expected a Resource Provider in the Resource ID %q", resourceId)
+	}
+
+	namespace := segments[providerIndex+1]
+	components := segments[providerIndex+2:]
+	if len(components) == 0 || len(components)%2 != 0 {
+		return "", "", fmt.Errorf("Expected the Resource ID %q to contain Type/Name pairs after the Resource Provider", resourceId)
+	}
+
+	types := make([]string, 0)
+	for i := 0; i < len(components); i += 2 {
+		types = append(types, components[i])
+	}
+
+	return namespace, strings.Join(types, "/"), nil
+}
+
+// LatestAPIVersion returns the most recent stable API Version from those specified, falling back to the
+// most recent preview API Version when there's no stable API Version available
+func LatestAPIVersion(apiVersions []string) string {
+	latest := ""
+	latestPreview := ""
+	for _, v := range apiVersions {
+		if strings.Contains(strings.ToLower(v), "preview") {
+			if v > latestPreview {
+				latestPreview = v
+			}
+			continue
+		}
+
+		if v > latest {
+			latest = v
+		}
+	}
+
+	if latest == "" {
+		return latestPreview
+	}
+
+	return latest
+}
+
+// ResolveAPIVersion returns the latest API Version supported by the Resource Type of the specified Resource ID
+func ResolveAPIVersion(ctx context.Context, client resourcesprofile.ProvidersClient, resourceId string) (string, error) {
+	namespace, resourceType, err := ParseResourceType(resourceId)
+	if err != nil {
+		return "", err
+	}
+
+	provider, err := client.Get(ctx, namespace, "")
+	if err != nil {
+		return "", fmt.Errorf("Error retrieving Resource Provider %q: %+v", namespace, err)
+	}
+
+	if types := provider.ResourceTypes; types != nil {
+		for _, v := range *types {
+			if v.ResourceType == nil || !strings.EqualFold(*v.ResourceType, resourceType) {
+				continue
+			}
+
+			if v.APIVersions != nil {
+				if apiVersion := LatestAPIVersion(*v.APIVersions); apiVersion != "" {
+					return apiVersion, nil
+				}
+			}
+		}
+	}
+
+	return "", fmt.Errorf("Unable to determine the API Version for Resource Type %q within Resource Provider %q", resourceType, namespace)
+}
@@ -0,0 +1,106 @@
+package resource
+
+import "testing"
+
+func TestParseResourceType(t *testing.T) {
+	testData := []struct {
+		Input             string
+		ExpectedNamespace string
+		ExpectedType      string
+		Error             bool
+	}{
+		{
+			Input: "",
+			Error: true,
+		},
+		{
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1",
+			Error: true,
+		},
+		{
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network",
+			Error: true,
+		},
+		{
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks",
+			Error: true,
+		},
+		{
+			Input:             "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1",
+			ExpectedNamespace: "Microsoft.Network",
+			ExpectedType:      "virtualNetworks",
+		},
+		{
+			Input:             "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1/subnets/subnet1",
+			ExpectedNamespace: "Microsoft.Network",
+			ExpectedType:      "virtualNetworks/subnets",
+		},
+		{
+			Input:             "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1/providers/Microsoft.Authorization/locks/lock1",
+			ExpectedNamespace: "Microsoft.Authorization",
+			ExpectedType:      "locks",
+		},
+		{
+			Input:             "/subscriptions/00000000-0000-0000-0000-000000000000/PROVIDERS/Microsoft.Authorization/policyDefinitions/policy1",
+			ExpectedNamespace: "Microsoft.Authorization",
+			ExpectedType:      "policyDefinitions",
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		namespace, resourceType, err := ParseResourceType(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expected no error but got: %+v", err)
+		}
+
+		if v.Error {
+			t.Fatalf("Expected an error but didn't get one")
+		}
+
+		if namespace != v.ExpectedNamespace {
+			t.Fatalf("Expected the Namespace to be %q but got %q", v.ExpectedNamespace, namespace)
+		}
+
+		if resourceType != v.ExpectedType {
+			t.Fatalf("Expected the Type to be %q but got %q", v.ExpectedType, resourceType)
+		}
+	}
+}
+
+func TestLatestAPIVersion(t *testing.T) {
+	testData := []struct {
+		Input    []string
+		Expected string
+	}{
+		{
+			Input:    []string{},
+			Expected: "",
+		},
+		{
+			Input:    []string{"2018-01-01", "2019-06-01", "2017-03-01"},
+			Expected: "2019-06-01",
+		},
+		{
+			Input:    []string{"2019-12-01-preview", "2019-06-01", "2019-09-01"},
+			Expected: "2019-09-01",
+		},
+		{
+			Input:    []string{"2019-06-01-preview", "2019-09-01-preview"},
+			Expected: "2019-09-01-preview",
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %+v", v.Input)
+
+		if actual := LatestAPIVersion(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %q but got %q", v.Expected, actual)
+		}
+	}
+}
@@ -0,0 +1,75 @@
+package resource
+
+import (
+	resourcesprofile "github.com/Azure/azure-sdk-for-go/profiles/2017-03-09/resources/mgmt/resources"
+	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2016-06-01/subscriptions"
+	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2016-09-01/locks"
+	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2018-05-01/resources"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/common"
+)
+
+type Client struct {
+	LocksClient                      locks.ManagementLocksClient
+	DeploymentsClient                resources.DeploymentsGroupClient
+	DeploymentOperationsClient       DeploymentOperationsClient
+	DeploymentsWhatIfClient          DeploymentsWhatIfClient
+	LocationsCache                   *LocationsCache
+	LocationsClient                  LocationsClient
+	ManagementGroupDeploymentsClient ManagementGroupDeploymentsClient
+	ResourcesClient                  resources.GroupClient
+	ResourcesByIDClient              ResourcesByIDClient
+	GroupsClient                     resources.GroupsGroupClient
+	SubscriptionsClient              subscriptions.GroupClient
+	ProvidersClient                  resourcesprofile.ProvidersClient
+}
+
+func BuildClient(o *common.ClientOptions) *Client {
+	locksClient := locks.NewManagementLocksClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&locksClient.Client, o.ResourceManagerAuthorizer)
+
+	deploymentsClient := resources.NewDeploymentsGroupClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&deploymentsClient.Client, o.ResourceManagerAuthorizer)
+
+	deploymentOperationsClient := NewDeploymentOperationsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&deploymentOperationsClient.Client, o.ResourceManagerAuthorizer)
+
+	deploymentsWhatIfClient := NewDeploymentsWhatIfClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&deploymentsWhatIfClient.Client, o.ResourceManagerAuthorizer)
+
+	locationsClient := NewLocationsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&locationsClient.Client, o.ResourceManagerAuthorizer)
+
+	managementGroupDeploymentsClient := NewManagementGroupDeploymentsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&managementGroupDeploymentsClient.Client, o.ResourceManagerAuthorizer)
+
+	resourcesClient := resources.NewGroupClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&resourcesClient.Client, o.ResourceManagerAuthorizer)
+
+	resourcesByIdClient := NewResourcesByIDClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&resourcesByIdClient.Client, o.ResourceManagerAuthorizer)
+
+	groupsClient := resources.NewGroupsGroupClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&groupsClient.Client, o.ResourceManagerAuthorizer)
+
+	subscriptionsClient := subscriptions.NewGroupClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&subscriptionsClient.Client, o.ResourceManagerAuthorizer)
+
+	// this has to come from the Profile since this is shared with Stack
+	providersClient := resourcesprofile.NewProvidersClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&providersClient.Client, o.ResourceManagerAuthorizer)
+
+	return &Client{
+		LocksClient:                      locksClient,
+		DeploymentsClient:                deploymentsClient,
+		DeploymentOperationsClient:       deploymentOperationsClient,
+		DeploymentsWhatIfClient:          deploymentsWhatIfClient,
+		LocationsCache:                   &LocationsCache{},
+		LocationsClient:                  locationsClient,
+		ManagementGroupDeploymentsClient: managementGroupDeploymentsClient,
+		ResourcesClient:                  resourcesClient,
+		ResourcesByIDClient:              resourcesByIdClient,
+		GroupsClient:                     groupsClient,
+		SubscriptionsClient:              subscriptionsClient,
+		ProvidersClient:                  providersClient,
+	}
+}
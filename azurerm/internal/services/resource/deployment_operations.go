@@ -0,0 +1,122 @@
+package resource
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2018-05-01/resources"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/Azure/go-autorest/autorest/date"
+)
+
+// deploymentOperationsAPIVersion is the API Version used to list the Operations of a Template Deployment, since the
+// API Version of the Resources SDK in use doesn't return the `provisioningOperation` of each Operation
+const deploymentOperationsAPIVersion = "2019-10-01"
+
+// DeploymentOperation is a single Operation performed by a Template Deployment
+type DeploymentOperation struct {
+	ID          *string                        `json:"id,omitempty"`
+	OperationID *string                        `json:"operationId,omitempty"`
+	Properties  *DeploymentOperationProperties `json:"properties,omitempty"`
+}
+
+// DeploymentOperationProperties contains the details of an Operation performed by a Template Deployment
+type DeploymentOperationProperties struct {
+	// ProvisioningOperation is the kind of Operation performed, e.g. `Create`, `Action` or `Read`
+	ProvisioningOperation *string                   `json:"provisioningOperation,omitempty"`
+	ProvisioningState     *string                   `json:"provisioningState,omitempty"`
+	Timestamp             *date.Time                `json:"timestamp,omitempty"`
+	TargetResource        *resources.TargetResource `json:"targetResource,omitempty"`
+}
+
+type deploymentOperationsListResult struct {
+	autorest.Response `json:"-"`
+	Value             *[]DeploymentOperation `json:"value,omitempty"`
+	NextLink          *string                `json:"nextLink,omitempty"`
+}
+
+// DeploymentOperationsClient is the client for listing the Operations performed by Template Deployments
+type DeploymentOperationsClient struct {
+	autorest.Client
+	BaseURI        string
+	SubscriptionID string
+}
+
+// NewDeploymentOperationsClientWithBaseURI creates an instance of the DeploymentOperationsClient
+func NewDeploymentOperationsClientWithBaseURI(baseURI string, subscriptionID string) DeploymentOperationsClient {
+	return DeploymentOperationsClient{
+		Client:         autorest.NewClientWithUserAgent(""),
+		BaseURI:        baseURI,
+		SubscriptionID: subscriptionID,
+	}
+}
+
+// List returns all of the Operations performed by the specified Template Deployment within the specified Resource Group
+func (client DeploymentOperationsClient) List(ctx context.Context, resourceGroupName string, deploymentName string) ([]DeploymentOperation, error) {
+	pathParameters := map[string]interface{}{
+		"deploymentName":    autorest.Encode("path", deploymentName),
+		"resourceGroupName": autorest.Encode("path", resourceGroupName),
+		"subscriptionId":    autorest.Encode("path", client.SubscriptionID),
+	}
+
+	queryParameters := map[string]interface{}{
+		"api-version": deploymentOperationsAPIVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsGet(),
+		autorest.WithBaseURL(client.BaseURI),
+		autorest.WithPathParameters("/subscriptions/{subscriptionId}/resourcegroups/{resourceGroupName}/deployments/{deploymentName}/operations", pathParameters),
+		autorest.WithQueryParameters(queryParameters))
+	req, err := preparer.Prepare((&http.Request{}).WithContext(ctx))
+	if err != nil {
+		return nil, autorest.NewErrorWithError(err, "resource.DeploymentOperationsClient", "List", nil, "Failure preparing request")
+	}
+
+	operations := make([]DeploymentOperation, 0)
+	for req != nil {
+		page, err := client.listPage(req)
+		if err != nil {
+			return nil, err
+		}
+
+		if page.Value != nil {
+			operations = append(operations, *page.Value...)
+		}
+
+		req = nil
+		if page.NextLink != nil && *page.NextLink != "" {
+			preparer := autorest.CreatePreparer(
+				autorest.AsGet(),
+				autorest.WithBaseURL(*page.NextLink))
+			req, err = preparer.Prepare((&http.Request{}).WithContext(ctx))
+			if err != nil {
+				return nil, autorest.NewErrorWithError(err, "resource.DeploymentOperationsClient", "List", nil, "Failure preparing next results request")
+			}
+		}
+	}
+
+	return operations, nil
+}
+
+func (client DeploymentOperationsClient) listPage(req *http.Request) (result deploymentOperationsListResult, err error) {
+	resp, err := autorest.SendWithSender(client, req,
+		azure.DoRetryWithRegistration(client.Client))
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		return result, autorest.NewErrorWithError(err, "resource.DeploymentOperationsClient", "List", resp, "Failure sending request")
+	}
+
+	err = autorest.Respond(
+		resp,
+		client.ByInspecting(),
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result),
+		autorest.ByClosing())
+	result.Response = autorest.Response{Response: resp}
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "resource.DeploymentOperationsClient", "List", resp, "Failure responding to request")
+	}
+	return result, err
+}
@@ -0,0 +1,109 @@
+package resource
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2018-05-01/resources"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+// deploymentsWhatIfAPIVersion is the API Version used to preview the changes made by a Template Deployment,
+// which isn't supported by the API Version of the Resources SDK in use
+const deploymentsWhatIfAPIVersion = "2019-07-01"
+
+// WhatIfOperationResult is the result of previewing the changes which would be made by a Template Deployment
+type WhatIfOperationResult struct {
+	autorest.Response `json:"-"`
+	Status            *string                               `json:"status,omitempty"`
+	Properties        *WhatIfOperationProperties            `json:"properties,omitempty"`
+	Error             *resources.ManagementErrorWithDetails `json:"error,omitempty"`
+}
+
+// WhatIfOperationProperties contains the changes which would be made by a Template Deployment
+type WhatIfOperationProperties struct {
+	Changes *[]WhatIfChange `json:"changes,omitempty"`
+}
+
+// WhatIfChange is a change to a single Resource which would be made by a Template Deployment
+type WhatIfChange struct {
+	ResourceID *string `json:"resourceId,omitempty"`
+	ChangeType *string `json:"changeType,omitempty"`
+}
+
+// DeploymentsWhatIfFuture is the Future used to wait for the changes made by a Template Deployment to be previewed
+type DeploymentsWhatIfFuture struct {
+	azure.Future
+}
+
+// Result returns the changes which would be made by the Template Deployment, once the Future has completed
+func (future *DeploymentsWhatIfFuture) Result(client DeploymentsWhatIfClient) (result WhatIfOperationResult, err error) {
+	resp, err := future.GetResult(client)
+	if err != nil {
+		return result, autorest.NewErrorWithError(err, "resource.DeploymentsWhatIfFuture", "Result", nil, "Failure retrieving the result")
+	}
+
+	err = autorest.Respond(
+		resp,
+		client.ByInspecting(),
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result),
+		autorest.ByClosing())
+	result.Response = autorest.Response{Response: resp}
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "resource.DeploymentsWhatIfFuture", "Result", resp, "Failure responding to request")
+	}
+	return result, err
+}
+
+// DeploymentsWhatIfClient is the client for previewing the changes made by Template Deployments
+type DeploymentsWhatIfClient struct {
+	autorest.Client
+	BaseURI        string
+	SubscriptionID string
+}
+
+// NewDeploymentsWhatIfClientWithBaseURI creates an instance of the DeploymentsWhatIfClient
+func NewDeploymentsWhatIfClientWithBaseURI(baseURI string, subscriptionID string) DeploymentsWhatIfClient {
+	return DeploymentsWhatIfClient{
+		Client:         autorest.NewClientWithUserAgent(""),
+		BaseURI:        baseURI,
+		SubscriptionID: subscriptionID,
+	}
+}
+
+// WhatIf previews the changes which would be made by deploying the specified Template Deployment into the
+// specified Resource Group, returning a Future which can be used to retrieve the changes
+func (client DeploymentsWhatIfClient) WhatIf(ctx context.Context, resourceGroupName string, deploymentName string, parameters resources.Deployment) (result DeploymentsWhatIfFuture, err error) {
+	pathParameters := map[string]interface{}{
+		"deploymentName":    autorest.Encode("path", deploymentName),
+		"resourceGroupName": autorest.Encode("path", resourceGroupName),
+		"subscriptionId":    autorest.Encode("path", client.SubscriptionID),
+	}
+
+	queryParameters := map[string]interface{}{
+		"api-version": deploymentsWhatIfAPIVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPost(),
+		autorest.WithBaseURL(client.BaseURI),
+		autorest.WithPathParameters("/subscriptions/{subscriptionId}/resourcegroups/{resourceGroupName}/providers/Microsoft.Resources/deployments/{deploymentName}/whatIf", pathParameters),
+		autorest.WithJSON(parameters),
+		autorest.WithQueryParameters(queryParameters))
+	req, err := preparer.Prepare((&http.Request{}).WithContext(ctx))
+	if err != nil {
+		return result, autorest.NewErrorWithError(err, "resource.DeploymentsWhatIfClient", "WhatIf", nil, "Failure preparing request")
+	}
+
+	resp, err := autorest.SendWithSender(client, req,
+		azure.DoRetryWithRegistration(client.Client))
+	if err != nil {
+		return result, autorest.NewErrorWithError(err, "resource.DeploymentsWhatIfClient", "WhatIf", resp, "Failure sending request")
+	}
+
+	result.Future, err = azure.NewFutureFromResponse(resp)
+	return result, err
+}
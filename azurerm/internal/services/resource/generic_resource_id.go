@@ -0,0 +1,63 @@
+package resource
+
+import (
+	"fmt"
+	"strings"
+)
+
+// GenericResourceID is the ID of an arbitrary Resource, represented as the ID of the Parent (a Subscription,
+// Resource Group or another Resource), the Resource Type (e.g. `Microsoft.Network/virtualNetworks/subnets`) and the Name
+type GenericResourceID struct {
+	ParentID string
+	Type     string
+	Name     string
+}
+
+// ID returns the Resource ID, which is nested beneath the Parent when the Resource Type is a child of the
+// Parent's Resource Type - otherwise the Resource is scoped to the Parent (e.g. a Resource within a Resource Group,
+// or an extension Resource such as a Lock)
+func (id GenericResourceID) ID() (string, error) {
+	parentId := strings.TrimSuffix(id.ParentID, "/")
+
+	segments := strings.Split(id.Type, "/")
+	if len(segments) < 2 {
+		return "", fmt.Errorf("Expected the Resource Type %q to be in the format `{namespace}/{type}`", id.Type)
+	}
+
+	if parentNamespace, parentType, err := ParseResourceType(parentId); err == nil {
+		parentFullType := fmt.Sprintf("%s/%s", parentNamespace, parentType)
+		if len(segments) > 2 && strings.EqualFold(parentFullType, strings.Join(segments[:len(segments)-1], "/")) {
+			return fmt.Sprintf("%s/%s/%s", parentId, segments[len(segments)-1], id.Name), nil
+		}
+	}
+
+	if len(segments) > 2 {
+		return "", fmt.Errorf("Expected the Parent ID %q to be a Resource of Type %q", id.ParentID, strings.Join(segments[:len(segments)-1], "/"))
+	}
+
+	return fmt.Sprintf("%s/providers/%s/%s", parentId, id.Type, id.Name), nil
+}
+
+// ParseGenericResourceID parses the specified Resource ID into a GenericResourceID
+func ParseGenericResourceID(input string) (*GenericResourceID, error) {
+	namespace, resourceType, err := ParseResourceType(input)
+	if err != nil {
+		return nil, err
+	}
+
+	segments := strings.Split(strings.TrimSuffix(input, "/"), "/")
+	name := segments[len(segments)-1]
+	types := strings.Split(resourceType, "/")
+
+	parentId := strings.Join(segments[:len(segments)-2], "/")
+	if len(types) == 1 {
+		// the Resource is scoped to the Parent, so the Provider segments need removing
+		parentId = strings.Join(segments[:len(segments)-4], "/")
+	}
+
+	return &GenericResourceID{
+		ParentID: parentId,
+		Type:     fmt.Sprintf("%s/%s", namespace, resourceType),
+		Name:     name,
+	}, nil
+}
@@ -0,0 +1,151 @@
+package resource
+
+import "testing"
+
+func TestGenericResourceID(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    GenericResourceID
+		Expected string
+		Error    bool
+	}{
+		{
+			Name: "Invalid Type",
+			Input: GenericResourceID{
+				ParentID: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1",
+				Type:     "virtualNetworks",
+				Name:     "network1",
+			},
+			Error: true,
+		},
+		{
+			Name: "Subscription",
+			Input: GenericResourceID{
+				ParentID: "/subscriptions/00000000-0000-0000-0000-000000000000",
+				Type:     "Microsoft.Authorization/policyDefinitions",
+				Name:     "policy1",
+			},
+			Expected: "/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.Authorization/policyDefinitions/policy1",
+		},
+		{
+			Name: "Resource Group",
+			Input: GenericResourceID{
+				ParentID: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1",
+				Type:     "Microsoft.Network/virtualNetworks",
+				Name:     "network1",
+			},
+			Expected: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1",
+		},
+		{
+			Name: "Child Resource",
+			Input: GenericResourceID{
+				ParentID: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1",
+				Type:     "Microsoft.Network/virtualNetworks/subnets",
+				Name:     "subnet1",
+			},
+			Expected: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1/subnets/subnet1",
+		},
+		{
+			Name: "Child Resource of a different Parent",
+			Input: GenericResourceID{
+				ParentID: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/networkSecurityGroups/group1",
+				Type:     "Microsoft.Network/virtualNetworks/subnets",
+				Name:     "subnet1",
+			},
+			Error: true,
+		},
+		{
+			Name: "Extension Resource",
+			Input: GenericResourceID{
+				ParentID: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1",
+				Type:     "Microsoft.Authorization/locks",
+				Name:     "lock1",
+			},
+			Expected: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1/providers/Microsoft.Authorization/locks/lock1",
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		actual, err := v.Input.ID()
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expected no error but got: %+v", err)
+		}
+
+		if v.Error {
+			t.Fatalf("Expected an error but didn't get one")
+		}
+
+		if actual != v.Expected {
+			t.Fatalf("Expected %q but got %q", v.Expected, actual)
+		}
+
+		// the ID should also round-trip
+		parsed, err := ParseGenericResourceID(actual)
+		if err != nil {
+			t.Fatalf("Expected no error parsing %q but got: %+v", actual, err)
+		}
+
+		if *parsed != v.Input {
+			t.Fatalf("Expected %+v but got %+v", v.Input, *parsed)
+		}
+	}
+}
+
+func TestParseGenericResourceID(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected *GenericResourceID
+	}{
+		{
+			Input:    "",
+			Expected: nil,
+		},
+		{
+			Input:    "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1",
+			Expected: nil,
+		},
+		{
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1",
+			Expected: &GenericResourceID{
+				ParentID: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1",
+				Type:     "Microsoft.Network/virtualNetworks",
+				Name:     "network1",
+			},
+		},
+		{
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1/subnets/subnet1",
+			Expected: &GenericResourceID{
+				ParentID: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1",
+				Type:     "Microsoft.Network/virtualNetworks/subnets",
+				Name:     "subnet1",
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := ParseGenericResourceID(v.Input)
+		if err != nil {
+			if v.Expected == nil {
+				continue
+			}
+
+			t.Fatalf("Expected no error but got: %+v", err)
+		}
+
+		if v.Expected == nil {
+			t.Fatalf("Expected an error but didn't get one")
+		}
+
+		if *actual != *v.Expected {
+			t.Fatalf("Expected %+v but got %+v", *v.Expected, *actual)
+		}
+	}
+}
@@ -0,0 +1,240 @@
+package resource
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+// locationsAPIVersion is the API Version used to list the Locations available to a Subscription, since the
+// API Version of the Subscriptions SDK in use doesn't return the metadata (such as the Paired Regions)
+const locationsAPIVersion = "2020-01-01"
+
+// LocationListResult is the list of Locations available to a Subscription
+type LocationListResult struct {
+	autorest.Response `json:"-"`
+	Value             *[]Location `json:"value,omitempty"`
+}
+
+// Location is a Location (Region) available to a Subscription
+type Location struct {
+	ID                  *string           `json:"id,omitempty"`
+	Name                *string           `json:"name,omitempty"`
+	DisplayName         *string           `json:"displayName,omitempty"`
+	RegionalDisplayName *string           `json:"regionalDisplayName,omitempty"`
+	Metadata            *LocationMetadata `json:"metadata,omitempty"`
+}
+
+// LocationMetadata contains additional information about a Location
+type LocationMetadata struct {
+	RegionType     *string         `json:"regionType,omitempty"`
+	RegionCategory *string         `json:"regionCategory,omitempty"`
+	GeographyGroup *string         `json:"geographyGroup,omitempty"`
+	PairedRegion   *[]PairedRegion `json:"pairedRegion,omitempty"`
+}
+
+// PairedRegion is the Location which a Location is paired with for disaster recovery
+type PairedRegion struct {
+	ID   *string `json:"id,omitempty"`
+	Name *string `json:"name,omitempty"`
+}
+
+// LocationsClient is the client for listing the Locations available to a Subscription
+type LocationsClient struct {
+	autorest.Client
+	BaseURI        string
+	SubscriptionID string
+}
+
+// NewLocationsClientWithBaseURI creates an instance of the LocationsClient
+func NewLocationsClientWithBaseURI(baseURI string, subscriptionID string) LocationsClient {
+	return LocationsClient{
+		Client:         autorest.NewClientWithUserAgent(""),
+		BaseURI:        baseURI,
+		SubscriptionID: subscriptionID,
+	}
+}
+
+// List returns the Locations available to the Subscription
+func (client LocationsClient) List(ctx context.Context) (result LocationListResult, err error) {
+	pathParameters := map[string]interface{}{
+		"subscriptionId": autorest.Encode("path", client.SubscriptionID),
+	}
+
+	queryParameters := map[string]interface{}{
+		"api-version": locationsAPIVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsGet(),
+		autorest.WithBaseURL(client.BaseURI),
+		autorest.WithPathParameters("/subscriptions/{subscriptionId}/locations", pathParameters),
+		autorest.WithQueryParameters(queryParameters))
+	req, err := preparer.Prepare((&http.Request{}).WithContext(ctx))
+	if err != nil {
+		return result, autorest.NewErrorWithError(err, "resource.LocationsClient", "List", nil, "Failure preparing request")
+	}
+
+	resp, err := autorest.SendWithSender(client, req,
+		autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		return result, autorest.NewErrorWithError(err, "resource.LocationsClient", "List", resp, "Failure sending request")
+	}
+
+	err = autorest.Respond(
+		resp,
+		client.ByInspecting(),
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result),
+		autorest.ByClosing())
+	result.Response = autorest.Response{Response: resp}
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "resource.LocationsClient", "List", resp, "Failure responding to request")
+	}
+	return result, err
+}
+
+// LocationsCache caches the Locations available to the Subscription for the lifetime of the Provider
+type LocationsCache struct {
+	lock      sync.Mutex
+	locations *[]Location
+}
+
+// Locations returns the Locations available to the Subscription
+func (c *LocationsCache) Locations(ctx context.Context, client LocationsClient) ([]Location, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if c.locations != nil {
+		return *c.locations, nil
+	}
+
+	resp, err := client.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("Error listing Locations: %+v", err)
+	}
+
+	locations := make([]Location, 0)
+	if resp.Value != nil {
+		locations = *resp.Value
+	}
+
+	c.locations = &locations
+	return locations, nil
+}
+
+// FindLocation returns the Location matching the specified name or display name (e.g. `westeurope` or
+// `West Europe`) - or nil if the Location isn't available to the Subscription
+func FindLocation(locations []Location, input string) *Location {
+	normalized := normalizeLocationName(input)
+
+	for _, location := range locations {
+		if location.Name != nil && normalizeLocationName(*location.Name) == normalized {
+			l := location
+			return &l
+		}
+
+		if location.DisplayName != nil && normalizeLocationName(*location.DisplayName) == normalized {
+			l := location
+			return &l
+		}
+	}
+
+	return nil
+}
+
+// SuggestLocations returns the names of the Locations most similar to the specified (invalid) Location,
+// ordered by similarity
+func SuggestLocations(locations []Location, input string) []string {
+	normalized := normalizeLocationName(input)
+
+	// suggestions are limited to those which are a few typos away, or where one contains the other
+	maxDistance := len(normalized) / 3
+	if maxDistance < 2 {
+		maxDistance = 2
+	}
+
+	type suggestion struct {
+		name     string
+		distance int
+	}
+	suggestions := make([]suggestion, 0)
+	for _, location := range locations {
+		if location.Name == nil {
+			continue
+		}
+
+		name := normalizeLocationName(*location.Name)
+		distance := levenshteinDistance(normalized, name)
+		if distance > maxDistance && !strings.Contains(name, normalized) && !strings.Contains(normalized, name) {
+			continue
+		}
+
+		suggestions = append(suggestions, suggestion{
+			name:     *location.Name,
+			distance: distance,
+		})
+	}
+
+	sort.SliceStable(suggestions, func(i, j int) bool {
+		if suggestions[i].distance == suggestions[j].distance {
+			return suggestions[i].name < suggestions[j].name
+		}
+
+		return suggestions[i].distance < suggestions[j].distance
+	})
+
+	output := make([]string, 0)
+	for i, v := range suggestions {
+		if i == 3 {
+			break
+		}
+
+		output = append(output, v.name)
+	}
+	return output
+}
+
+func normalizeLocationName(input string) string {
+	return strings.Replace(strings.ToLower(input), " ", "", -1)
+}
+
+func levenshteinDistance(first string, second string) int {
+	previous := make([]int, len(second)+1)
+	for i := range previous {
+		previous[i] = i
+	}
+
+	for i := 1; i <= len(first); i++ {
+		current := make([]int, len(second)+1)
+		current[0] = i
+
+		for j := 1; j <= len(second); j++ {
+			cost := 1
+			if first[i-1] == second[j-1] {
+				cost = 0
+			}
+
+			current[j] = minInt(previous[j]+1, minInt(current[j-1]+1, previous[j-1]+cost))
+		}
+
+		previous = current
+	}
+
+	return previous[len(second)]
+}
+
+func minInt(first int, second int) int {
+	if first < second {
+		return first
+	}
+
+	return second
+}
@@ -0,0 +1,106 @@
+package resource
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func testLocations() []Location {
+	return []Location{
+		{
+			Name:        utils.String("westeurope"),
+			DisplayName: utils.String("West Europe"),
+		},
+		{
+			Name:        utils.String("westus"),
+			DisplayName: utils.String("West US"),
+		},
+		{
+			Name:        utils.String("westus2"),
+			DisplayName: utils.String("West US 2"),
+		},
+		{
+			Name:        utils.String("uksouth"),
+			DisplayName: utils.String("UK South"),
+		},
+	}
+}
+
+func TestFindLocation(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected string
+	}{
+		{
+			Input:    "westeurope",
+			Expected: "westeurope",
+		},
+		{
+			Input:    "West Europe",
+			Expected: "westeurope",
+		},
+		{
+			Input:    "UKSouth",
+			Expected: "uksouth",
+		},
+		{
+			Input:    "westeurope2",
+			Expected: "",
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual := FindLocation(testLocations(), v.Input)
+		if v.Expected == "" {
+			if actual != nil {
+				t.Fatalf("Expected no Location but got %q", *actual.Name)
+			}
+			continue
+		}
+
+		if actual == nil {
+			t.Fatalf("Expected %q but didn't find a Location", v.Expected)
+		}
+
+		if *actual.Name != v.Expected {
+			t.Fatalf("Expected %q but got %q", v.Expected, *actual.Name)
+		}
+	}
+}
+
+func TestSuggestLocations(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected []string
+	}{
+		{
+			Input:    "westeurope2",
+			Expected: []string{"westeurope"},
+		},
+		{
+			Input:    "West Uss",
+			Expected: []string{"westus", "westus2"},
+		},
+		{
+			Input:    "uk-south",
+			Expected: []string{"uksouth"},
+		},
+		{
+			Input:    "australiaeast",
+			Expected: []string{},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual := SuggestLocations(testLocations(), v.Input)
+		if !reflect.DeepEqual(actual, v.Expected) {
+			t.Fatalf("Expected %+v but got %+v", v.Expected, actual)
+		}
+	}
+}
@@ -0,0 +1,112 @@
+package resource
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2018-05-01/resources"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+// managementGroupDeploymentsAPIVersion is the API Version used for Template Deployments scoped to a
+// Management Group, which isn't supported by the API Version of the Resources SDK in use
+const managementGroupDeploymentsAPIVersion = "2019-10-01"
+
+// ManagementGroupDeploymentsClient is the client for Template Deployments scoped to a Management Group
+type ManagementGroupDeploymentsClient struct {
+	autorest.Client
+	BaseURI string
+}
+
+// NewManagementGroupDeploymentsClientWithBaseURI creates an instance of the ManagementGroupDeploymentsClient
+func NewManagementGroupDeploymentsClientWithBaseURI(baseURI string) ManagementGroupDeploymentsClient {
+	return ManagementGroupDeploymentsClient{
+		Client:  autorest.NewClientWithUserAgent(""),
+		BaseURI: baseURI,
+	}
+}
+
+// CreateOrUpdate deploys resources to the specified Management Group, returning a Future which
+// can be used to wait for the Deployment to complete
+func (client ManagementGroupDeploymentsClient) CreateOrUpdate(ctx context.Context, groupId string, deploymentName string, parameters resources.Deployment) (result azure.Future, err error) {
+	req, err := client.preparer(ctx, groupId, deploymentName,
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPut(),
+		autorest.WithJSON(parameters))
+	if err != nil {
+		return result, autorest.NewErrorWithError(err, "resource.ManagementGroupDeploymentsClient", "CreateOrUpdate", nil, "Failure preparing request")
+	}
+
+	resp, err := client.send(req)
+	if err != nil {
+		return result, autorest.NewErrorWithError(err, "resource.ManagementGroupDeploymentsClient", "CreateOrUpdate", resp, "Failure sending request")
+	}
+
+	return azure.NewFutureFromResponse(resp)
+}
+
+// Get retrieves the specified Template Deployment within the specified Management Group
+func (client ManagementGroupDeploymentsClient) Get(ctx context.Context, groupId string, deploymentName string) (result resources.DeploymentExtended, err error) {
+	req, err := client.preparer(ctx, groupId, deploymentName, autorest.AsGet())
+	if err != nil {
+		return result, autorest.NewErrorWithError(err, "resource.ManagementGroupDeploymentsClient", "Get", nil, "Failure preparing request")
+	}
+
+	resp, err := client.send(req)
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		return result, autorest.NewErrorWithError(err, "resource.ManagementGroupDeploymentsClient", "Get", resp, "Failure sending request")
+	}
+
+	err = autorest.Respond(
+		resp,
+		client.ByInspecting(),
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result),
+		autorest.ByClosing())
+	result.Response = autorest.Response{Response: resp}
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "resource.ManagementGroupDeploymentsClient", "Get", resp, "Failure responding to request")
+	}
+	return result, err
+}
+
+// Delete removes the specified Template Deployment from the specified Management Group, returning a
+// Future which can be used to wait for the Deployment to be deleted
+func (client ManagementGroupDeploymentsClient) Delete(ctx context.Context, groupId string, deploymentName string) (result azure.Future, err error) {
+	req, err := client.preparer(ctx, groupId, deploymentName, autorest.AsDelete())
+	if err != nil {
+		return result, autorest.NewErrorWithError(err, "resource.ManagementGroupDeploymentsClient", "Delete", nil, "Failure preparing request")
+	}
+
+	resp, err := client.send(req)
+	if err != nil {
+		return result, autorest.NewErrorWithError(err, "resource.ManagementGroupDeploymentsClient", "Delete", resp, "Failure sending request")
+	}
+
+	return azure.NewFutureFromResponse(resp)
+}
+
+func (client ManagementGroupDeploymentsClient) preparer(ctx context.Context, groupId string, deploymentName string, decorators ...autorest.PrepareDecorator) (*http.Request, error) {
+	pathParameters := map[string]interface{}{
+		"deploymentName": autorest.Encode("path", deploymentName),
+		"groupId":        autorest.Encode("path", groupId),
+	}
+
+	queryParameters := map[string]interface{}{
+		"api-version": managementGroupDeploymentsAPIVersion,
+	}
+
+	decorators = append(decorators,
+		autorest.WithBaseURL(client.BaseURI),
+		autorest.WithPathParameters("/providers/Microsoft.Management/managementGroups/{groupId}/providers/Microsoft.Resources/deployments/{deploymentName}", pathParameters),
+		autorest.WithQueryParameters(queryParameters))
+	return autorest.CreatePreparer(decorators...).Prepare((&http.Request{}).WithContext(ctx))
+}
+
+func (client ManagementGroupDeploymentsClient) send(req *http.Request) (*http.Response, error) {
+	// Resource Provider registration requires a Subscription, which these requests aren't scoped to
+	return autorest.SendWithSender(client, req,
+		autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
+}
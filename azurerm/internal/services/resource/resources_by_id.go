@@ -0,0 +1,114 @@
+package resource
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+// RawResource is a Resource returned by the ResourcesByIDClient, retaining the entire response body since
+// the schema of the Resource isn't known
+type RawResource struct {
+	autorest.Response `json:"-"`
+	Body              map[string]interface{}
+}
+
+// ResourcesByIDClient is the client for managing arbitrary Resources by their ID, using the API Version
+// supported by the Resource Type - rather than the fixed API Version used by the Resources SDK
+type ResourcesByIDClient struct {
+	autorest.Client
+	BaseURI string
+}
+
+// NewResourcesByIDClientWithBaseURI creates an instance of the ResourcesByIDClient
+func NewResourcesByIDClientWithBaseURI(baseURI string) ResourcesByIDClient {
+	return ResourcesByIDClient{
+		Client:  autorest.NewClientWithUserAgent(""),
+		BaseURI: baseURI,
+	}
+}
+
+// CreateOrUpdateByID creates or updates the Resource with the specified ID using the specified API Version and
+// request body, returning a Future which can be used to wait for the Resource to be provisioned
+func (client ResourcesByIDClient) CreateOrUpdateByID(ctx context.Context, resourceId string, apiVersion string, body interface{}) (result azure.Future, err error) {
+	req, err := client.preparer(ctx, resourceId, apiVersion,
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPut(),
+		autorest.WithJSON(body))
+	if err != nil {
+		return result, autorest.NewErrorWithError(err, "resource.ResourcesByIDClient", "CreateOrUpdateByID", nil, "Failure preparing request")
+	}
+
+	resp, err := client.send(req)
+	if err != nil {
+		return result, autorest.NewErrorWithError(err, "resource.ResourcesByIDClient", "CreateOrUpdateByID", resp, "Failure sending request")
+	}
+
+	return azure.NewFutureFromResponse(resp)
+}
+
+// GetByID retrieves the Resource with the specified ID using the specified API Version
+func (client ResourcesByIDClient) GetByID(ctx context.Context, resourceId string, apiVersion string) (result RawResource, err error) {
+	req, err := client.preparer(ctx, resourceId, apiVersion, autorest.AsGet())
+	if err != nil {
+		return result, autorest.NewErrorWithError(err, "resource.ResourcesByIDClient", "GetByID", nil, "Failure preparing request")
+	}
+
+	resp, err := client.send(req)
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		return result, autorest.NewErrorWithError(err, "resource.ResourcesByIDClient", "GetByID", resp, "Failure sending request")
+	}
+
+	err = autorest.Respond(
+		resp,
+		client.ByInspecting(),
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result.Body),
+		autorest.ByClosing())
+	result.Response = autorest.Response{Response: resp}
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "resource.ResourcesByIDClient", "GetByID", resp, "Failure responding to request")
+	}
+	return result, err
+}
+
+// DeleteByID deletes the Resource with the specified ID using the specified API Version, returning a Future
+// which can be used to wait for the Resource to be deleted
+func (client ResourcesByIDClient) DeleteByID(ctx context.Context, resourceId string, apiVersion string) (result azure.Future, err error) {
+	req, err := client.preparer(ctx, resourceId, apiVersion, autorest.AsDelete())
+	if err != nil {
+		return result, autorest.NewErrorWithError(err, "resource.ResourcesByIDClient", "DeleteByID", nil, "Failure preparing request")
+	}
+
+	resp, err := client.send(req)
+	if err != nil {
+		return result, autorest.NewErrorWithError(err, "resource.ResourcesByIDClient", "DeleteByID", resp, "Failure sending request")
+	}
+
+	return azure.NewFutureFromResponse(resp)
+}
+
+func (client ResourcesByIDClient) preparer(ctx context.Context, resourceId string, apiVersion string, decorators ...autorest.PrepareDecorator) (*http.Request, error) {
+	pathParameters := map[string]interface{}{
+		"resourceId": strings.TrimPrefix(resourceId, "/"),
+	}
+
+	queryParameters := map[string]interface{}{
+		"api-version": apiVersion,
+	}
+
+	decorators = append(decorators,
+		autorest.WithBaseURL(client.BaseURI),
+		autorest.WithPathParameters("/{resourceId}", pathParameters),
+		autorest.WithQueryParameters(queryParameters))
+	return autorest.CreatePreparer(decorators...).Prepare((&http.Request{}).WithContext(ctx))
+}
+
+func (client ResourcesByIDClient) send(req *http.Request) (*http.Response, error) {
+	return autorest.SendWithSender(client, req,
+		autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
+}
@@ -0,0 +1,31 @@
+package securitycenter
+
+import (
+	"github.com/Azure/azure-sdk-for-go/services/preview/security/mgmt/v1.0/security"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/common"
+)
+
+type Client struct {
+	PricingClient   security.PricingsClient
+	ContactsClient  security.ContactsClient
+	WorkspaceClient security.WorkspaceSettingsClient
+}
+
+func BuildClient(o *common.ClientOptions) *Client {
+	ascLocation := "Global"
+
+	pricingClient := security.NewPricingsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId, ascLocation)
+	o.ConfigureClient(&pricingClient.Client, o.ResourceManagerAuthorizer)
+
+	contactsClient := security.NewContactsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId, ascLocation)
+	o.ConfigureClient(&contactsClient.Client, o.ResourceManagerAuthorizer)
+
+	workspaceClient := security.NewWorkspaceSettingsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId, ascLocation)
+	o.ConfigureClient(&workspaceClient.Client, o.ResourceManagerAuthorizer)
+
+	return &Client{
+		PricingClient:   pricingClient,
+		ContactsClient:  contactsClient,
+		WorkspaceClient: workspaceClient,
+	}
+}
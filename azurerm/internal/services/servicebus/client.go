@@ -1,6 +1,9 @@
 package servicebus
 
-import "github.com/Azure/azure-sdk-for-go/services/servicebus/mgmt/2017-04-01/servicebus"
+import (
+	"github.com/Azure/azure-sdk-for-go/services/servicebus/mgmt/2017-04-01/servicebus"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/common"
+)
 
 type Client struct {
 	QueuesClient            servicebus.QueuesClient
@@ -9,3 +12,28 @@ type Client struct {
 	SubscriptionsClient     servicebus.SubscriptionsClient
 	SubscriptionRulesClient servicebus.RulesClient
 }
+
+func BuildClient(o *common.ClientOptions) *Client {
+	queuesClient := servicebus.NewQueuesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&queuesClient.Client, o.ResourceManagerAuthorizer)
+
+	namespacesClient := servicebus.NewNamespacesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&namespacesClient.Client, o.ResourceManagerAuthorizer)
+
+	topicsClient := servicebus.NewTopicsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&topicsClient.Client, o.ResourceManagerAuthorizer)
+
+	subscriptionsClient := servicebus.NewSubscriptionsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&subscriptionsClient.Client, o.ResourceManagerAuthorizer)
+
+	subscriptionRulesClient := servicebus.NewRulesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&subscriptionRulesClient.Client, o.ResourceManagerAuthorizer)
+
+	return &Client{
+		QueuesClient:            queuesClient,
+		NamespacesClient:        namespacesClient,
+		TopicsClient:            topicsClient,
+		SubscriptionsClient:     subscriptionsClient,
+		SubscriptionRulesClient: subscriptionRulesClient,
+	}
+}
@@ -0,0 +1,24 @@
+package storage
+
+import (
+	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2018-02-01/storage"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/common"
+)
+
+type Client struct {
+	AccountsClient storage.AccountsClient
+	UsageClient    storage.UsageClient
+}
+
+func BuildClient(o *common.ClientOptions) *Client {
+	accountsClient := storage.NewAccountsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&accountsClient.Client, o.ResourceManagerAuthorizer)
+
+	usageClient := storage.NewUsageClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&usageClient.Client, o.ResourceManagerAuthorizer)
+
+	return &Client{
+		AccountsClient: accountsClient,
+		UsageClient:    usageClient,
+	}
+}
@@ -0,0 +1,29 @@
+package trafficmanager
+
+import (
+	"github.com/Azure/azure-sdk-for-go/services/trafficmanager/mgmt/2018-04-01/trafficmanager"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/common"
+)
+
+type Client struct {
+	EndpointsClient             trafficmanager.EndpointsClient
+	GeographicHierarchiesClient trafficmanager.GeographicHierarchiesClient
+	ProfilesClient              trafficmanager.ProfilesClient
+}
+
+func BuildClient(o *common.ClientOptions) *Client {
+	endpointsClient := trafficmanager.NewEndpointsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&endpointsClient.Client, o.ResourceManagerAuthorizer)
+
+	geographicHierarchiesClient := trafficmanager.NewGeographicHierarchiesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&geographicHierarchiesClient.Client, o.ResourceManagerAuthorizer)
+
+	profilesClient := trafficmanager.NewProfilesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&profilesClient.Client, o.ResourceManagerAuthorizer)
+
+	return &Client{
+		EndpointsClient:             endpointsClient,
+		GeographicHierarchiesClient: geographicHierarchiesClient,
+		ProfilesClient:              profilesClient,
+	}
+}
@@ -0,0 +1,24 @@
+package web
+
+import (
+	"github.com/Azure/azure-sdk-for-go/services/web/mgmt/2018-02-01/web"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/common"
+)
+
+type Client struct {
+	AppServicePlansClient web.AppServicePlansClient
+	AppServicesClient     web.AppsClient
+}
+
+func BuildClient(o *common.ClientOptions) *Client {
+	appServicePlansClient := web.NewAppServicePlansClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&appServicePlansClient.Client, o.ResourceManagerAuthorizer)
+
+	appServicesClient := web.NewAppsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&appServicesClient.Client, o.ResourceManagerAuthorizer)
+
+	return &Client{
+		AppServicePlansClient: appServicePlansClient,
+		AppServicesClient:     appServicesClient,
+	}
+}
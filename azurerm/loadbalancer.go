@@ -1,6 +1,7 @@
 package azurerm
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"regexp"
@@ -8,6 +9,7 @@ import (
 
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-12-01/network"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 )
 
 func resourceGroupAndLBNameFromId(loadBalancerId string) (string, string, error) {
@@ -21,9 +23,8 @@ func resourceGroupAndLBNameFromId(loadBalancerId string) (string, string, error)
 	return resGroup, name, nil
 }
 
-func retrieveLoadBalancerById(loadBalancerId string, meta interface{}) (*network.LoadBalancer, bool, error) {
-	client := meta.(*ArmClient).loadBalancerClient
-	ctx := meta.(*ArmClient).StopContext
+func retrieveLoadBalancerById(ctx context.Context, loadBalancerId string, meta interface{}) (*network.LoadBalancer, bool, error) {
+	client := meta.(*ArmClient).Network().LoadBalancersClient
 
 	resGroup, name, err := resourceGroupAndLBNameFromId(loadBalancerId)
 	if err != nil {
@@ -155,13 +156,8 @@ func loadBalancerSubResourceStateImporter(d *schema.ResourceData, _ interface{})
 	}
 
 	lbID := strings.TrimSuffix(r.FindString(d.Id()), "/")
-	parsed, err := parseAzureResourceID(lbID)
-	if err != nil {
-		return nil, fmt.Errorf("unable to parse loadbalancer id from %s", d.Id())
-	}
-
-	if parsed.Path["loadBalancers"] == "" {
-		return nil, fmt.Errorf("parsed ID is invalid")
+	if _, err := azure.ParseLoadBalancerID(lbID); err != nil {
+		return nil, fmt.Errorf("unable to parse loadbalancer id from %s: %+v", d.Id(), err)
 	}
 
 	d.Set("loadbalancer_id", lbID)
@@ -0,0 +1,72 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	resourceSvc "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/resource"
+)
+
+// addLocationValidationToResource validates the `location` of a Resource is available to the Subscription during
+// the plan, rather than this being rejected by the API during the apply
+func addLocationValidationToResource(r *schema.Resource) {
+	location, ok := r.Schema["location"]
+	if !ok || location.Type != schema.TypeString || !(location.Required || location.Optional) || location.Deprecated != "" {
+		return
+	}
+
+	customizeDiff := r.CustomizeDiff
+	r.CustomizeDiff = func(d *schema.ResourceDiff, meta interface{}) error {
+		if customizeDiff != nil {
+			if err := customizeDiff(d, meta); err != nil {
+				return err
+			}
+		}
+
+		if meta == nil || !d.NewValueKnown("location") {
+			return nil
+		}
+
+		if d.Id() != "" && !d.HasChange("location") {
+			return nil
+		}
+
+		return validateLocationIsAvailable(meta, d.Get("location").(string))
+	}
+}
+
+func validateLocationIsAvailable(meta interface{}, location string) error {
+	// Resources which aren't deployed into a Region (e.g. Front Door) use the `global` Location
+	if location == "" || strings.EqualFold(location, "global") {
+		return nil
+	}
+
+	armClient := meta.(*ArmClient)
+	client := armClient.Resource()
+	ctx := armClient.StopContext
+
+	locations, err := client.LocationsCache.Locations(ctx, client.LocationsClient)
+	if err != nil {
+		// the plan shouldn't fail if the Locations can't be retrieved, since the API validates this during the apply
+		log.Printf("[WARN] Unable to validate the Location %q: %+v", location, err)
+		return nil
+	}
+
+	if len(locations) == 0 || resourceSvc.FindLocation(locations, location) != nil {
+		return nil
+	}
+
+	suggestions := resourceSvc.SuggestLocations(locations, location)
+	if len(suggestions) == 0 {
+		return fmt.Errorf("The Location %q isn't available to Subscription %q - the available Locations can be found using the `azurerm_locations` Data Source", location, armClient.subscriptionId)
+	}
+
+	quoted := make([]string, 0)
+	for _, v := range suggestions {
+		quoted = append(quoted, fmt.Sprintf("%q", v))
+	}
+
+	return fmt.Errorf("The Location %q isn't available to Subscription %q - did you mean %s?", location, armClient.subscriptionId, strings.Join(quoted, " or "))
+}
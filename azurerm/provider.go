@@ -6,13 +6,17 @@ import (
 	"encoding/hex"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/go-azure-helpers/authentication"
 	"github.com/hashicorp/terraform/helper/mutexkv"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 // Provider returns a terraform.ResourceProvider.
@@ -37,12 +41,38 @@ func Provider() terraform.ResourceProvider {
 				DefaultFunc: schema.EnvDefaultFunc("ARM_TENANT_ID", ""),
 			},
 
+			// Tenants which tokens are also acquired for, to allow operations which span tenants
+			"auxiliary_tenant_ids": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: azure.AuxiliaryTenantsMax,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validate.UUID,
+				},
+			},
+
 			"environment": {
 				Type:        schema.TypeString,
 				Required:    true,
 				DefaultFunc: schema.EnvDefaultFunc("ARM_ENVIRONMENT", "public"),
 			},
 
+			// Custom Clouds (e.g. Azure Stack) where the endpoints are loaded from the Metadata Host or a file
+			"metadata_host": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				DefaultFunc:   schema.EnvDefaultFunc("ARM_METADATA_HOST", ""),
+				ConflictsWith: []string{"environment_file"},
+			},
+
+			"environment_file": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				DefaultFunc:   schema.EnvDefaultFunc("ARM_ENVIRONMENT_FILE", ""),
+				ConflictsWith: []string{"metadata_host"},
+			},
+
 			// Client Certificate specific fields
 			"client_certificate_password": {
 				Type:        schema.TypeString,
@@ -95,6 +125,88 @@ func Provider() terraform.ResourceProvider {
 				Optional:    true,
 				DefaultFunc: schema.EnvDefaultFunc("ARM_SKIP_PROVIDER_REGISTRATION", false),
 			},
+
+			"resource_providers_to_register": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validate.NoEmptyStrings,
+				},
+			},
+
+			"storage_use_azuread": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ARM_STORAGE_USE_AZUREAD", false),
+			},
+
+			"storage_use_key_fallback": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ARM_STORAGE_USE_KEY_FALLBACK", false),
+			},
+
+			// Retrying of throttled/failed requests
+			"retry_max_attempts": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      azure.DefaultRetryOptions().MaxAttempts,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+
+			"retry_max_backoff": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      int(azure.DefaultRetryOptions().MaxBackoff / time.Second),
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+
+			// Redaction of secrets within the debug logs
+			"log_redaction": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"allowlist": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validate.NoEmptyStrings,
+							},
+						},
+
+						"denylist": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validate.NoEmptyStrings,
+							},
+						},
+					},
+				},
+			},
+
+			"features": schemaFeatures(),
+
+			// Tags which are assigned to every resource which supports tags
+			"default_tags": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"tags": {
+							Type:         schema.TypeMap,
+							Optional:     true,
+							ValidateFunc: validateAzureRMTags,
+						},
+					},
+				},
+			},
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
@@ -138,6 +250,7 @@ func Provider() terraform.ResourceProvider {
 			"azurerm_kubernetes_cluster":                     dataSourceArmKubernetesCluster(),
 			"azurerm_lb":                                     dataSourceArmLoadBalancer(),
 			"azurerm_lb_backend_address_pool":                dataSourceArmLoadBalancerBackendAddressPool(),
+			"azurerm_locations":                              dataSourceArmLocations(),
 			"azurerm_log_analytics_workspace":                dataSourceLogAnalyticsWorkspace(),
 			"azurerm_logic_app_workflow":                     dataSourceArmLogicAppWorkflow(),
 			"azurerm_managed_disk":                           dataSourceArmManagedDisk(),
@@ -157,7 +270,9 @@ func Provider() terraform.ResourceProvider {
 			"azurerm_recovery_services_vault":                dataSourceArmRecoveryServicesVault(),
 			"azurerm_recovery_services_protection_policy_vm": dataSourceArmRecoveryServicesProtectionPolicyVm(),
 			"azurerm_redis_cache":                            dataSourceArmRedisCache(),
+			"azurerm_resource":                               dataSourceArmResource(),
 			"azurerm_resource_group":                         dataSourceArmResourceGroup(),
+			"azurerm_resources":                              dataSourceArmResources(),
 			"azurerm_role_definition":                        dataSourceArmRoleDefinition(),
 			"azurerm_route_table":                            dataSourceArmRouteTable(),
 			"azurerm_scheduler_job_collection":               dataSourceArmSchedulerJobCollection(),
@@ -316,6 +431,7 @@ func Provider() terraform.ResourceProvider {
 			"azurerm_lb_outbound_rule":                                   resourceArmLoadBalancerOutboundRule(),
 			"azurerm_lb_rule":                                            resourceArmLoadBalancerRule(),
 			"azurerm_lb":                                                 resourceArmLoadBalancer(),
+			"azurerm_linux_virtual_machine":                              resourceArmLinuxVirtualMachine(),
 			"azurerm_local_network_gateway":                              resourceArmLocalNetworkGateway(),
 			"azurerm_log_analytics_solution":                             resourceArmLogAnalyticsSolution(),
 			"azurerm_log_analytics_linked_service":                       resourceArmLogAnalyticsLinkedService(),
@@ -329,6 +445,7 @@ func Provider() terraform.ResourceProvider {
 			"azurerm_logic_app_workflow":                                 resourceArmLogicAppWorkflow(),
 			"azurerm_managed_disk":                                       resourceArmManagedDisk(),
 			"azurerm_management_group":                                   resourceArmManagementGroup(),
+			"azurerm_management_group_template_deployment":               resourceArmManagementGroupTemplateDeployment(),
 			"azurerm_management_lock":                                    resourceArmManagementLock(),
 			"azurerm_mariadb_database":                                   resourceArmMariaDbDatabase(),
 			"azurerm_mariadb_server":                                     resourceArmMariaDbServer(),
@@ -379,6 +496,7 @@ func Provider() terraform.ResourceProvider {
 			"azurerm_redis_cache":                                                            resourceArmRedisCache(),
 			"azurerm_redis_firewall_rule":                                                    resourceArmRedisFirewallRule(),
 			"azurerm_relay_namespace":                                                        resourceArmRelayNamespace(),
+			"azurerm_resource":                                                               resourceArmResource(),
 			"azurerm_resource_group":                                                         resourceArmResourceGroup(),
 			"azurerm_role_assignment":                                                        resourceArmRoleAssignment(),
 			"azurerm_role_definition":                                                        resourceArmRoleDefinition(),
@@ -427,21 +545,29 @@ func Provider() terraform.ResourceProvider {
 			"azurerm_subnet_network_security_group_association":                              resourceArmSubnetNetworkSecurityGroupAssociation(),
 			"azurerm_subnet_route_table_association":                                         resourceArmSubnetRouteTableAssociation(),
 			"azurerm_subnet":                                                                 resourceArmSubnet(),
+			"azurerm_subscription_template_deployment":                                       resourceArmSubscriptionTemplateDeployment(),
 			"azurerm_template_deployment":                                                    resourceArmTemplateDeployment(),
 			"azurerm_traffic_manager_endpoint":                                               resourceArmTrafficManagerEndpoint(),
 			"azurerm_traffic_manager_profile":                                                resourceArmTrafficManagerProfile(),
 			"azurerm_user_assigned_identity":                                                 resourceArmUserAssignedIdentity(),
 			"azurerm_virtual_machine_data_disk_attachment":                                   resourceArmVirtualMachineDataDiskAttachment(),
 			"azurerm_virtual_machine_extension":                                              resourceArmVirtualMachineExtensions(),
+			"azurerm_virtual_machine_run_command":                                            resourceArmVirtualMachineRunCommand(),
 			"azurerm_virtual_machine_scale_set":                                              resourceArmVirtualMachineScaleSet(),
 			"azurerm_virtual_machine":                                                        resourceArmVirtualMachine(),
 			"azurerm_virtual_network_gateway_connection":                                     resourceArmVirtualNetworkGatewayConnection(),
 			"azurerm_virtual_network_gateway":                                                resourceArmVirtualNetworkGateway(),
 			"azurerm_virtual_network_peering":                                                resourceArmVirtualNetworkPeering(),
 			"azurerm_virtual_network":                                                        resourceArmVirtualNetwork(),
+			"azurerm_windows_virtual_machine":                                                resourceArmWindowsVirtualMachine(),
 		},
 	}
 
+	for _, r := range p.ResourcesMap {
+		addDefaultTagsToResource(r)
+		addLocationValidationToResource(r)
+	}
+
 	p.ConfigureFunc = providerConfigure(p)
 
 	return p
@@ -473,13 +599,27 @@ func providerConfigure(p *schema.Provider) schema.ConfigureFunc {
 
 		partnerId := d.Get("partner_id").(string)
 		skipProviderRegistration := d.Get("skip_provider_registration").(bool)
-		client, err := getArmClient(config, skipProviderRegistration, partnerId)
+		retryOptions := azure.RetryOptions{
+			MaxAttempts: d.Get("retry_max_attempts").(int),
+			MaxBackoff:  time.Duration(d.Get("retry_max_backoff").(int)) * time.Second,
+		}
+		logRedaction := expandProviderLogRedaction(d.Get("log_redaction").([]interface{}))
+		storageUseAzureAD := d.Get("storage_use_azuread").(bool)
+		storageUseKeyFallback := d.Get("storage_use_key_fallback").(bool)
+		environmentOptions := azure.EnvironmentOptions{
+			MetadataHost:    d.Get("metadata_host").(string),
+			EnvironmentFile: d.Get("environment_file").(string),
+		}
+		auxiliaryTenantIds := *utils.ExpandStringSlice(d.Get("auxiliary_tenant_ids").([]interface{}))
+		client, err := getArmClient(config, skipProviderRegistration, partnerId, retryOptions, logRedaction, storageUseAzureAD, storageUseKeyFallback, environmentOptions, auxiliaryTenantIds)
 
 		if err != nil {
 			return nil, err
 		}
 
 		client.StopContext = p.StopContext()
+		client.defaultTags = expandProviderDefaultTags(d.Get("default_tags").([]interface{}))
+		client.features = expandFeatures(d.Get("features").([]interface{}))
 
 		// replaces the context between tests
 		p.MetaReset = func() error {
@@ -487,12 +627,12 @@ func providerConfigure(p *schema.Provider) schema.ConfigureFunc {
 			return nil
 		}
 
+		ctx := client.StopContext
 		skipCredentialsValidation := d.Get("skip_credentials_validation").(bool)
 		if !skipCredentialsValidation {
 			// List all the available providers and their registration state to avoid unnecessary
 			// requests. This also lets us check if the provider credentials are correct.
-			ctx := client.StopContext
-			providerList, err := client.providersClient.List(ctx, nil, "")
+			providerList, err := client.Resource().ProvidersClient.List(ctx, nil, "")
 			if err != nil {
 				return nil, fmt.Errorf("Unable to list provider registration status, it is possible that this is due to invalid "+
 					"credentials or the service principal does not have permission to use the Resource Manager API, Azure "+
@@ -500,11 +640,14 @@ func providerConfigure(p *schema.Provider) schema.ConfigureFunc {
 			}
 
 			if !skipProviderRegistration {
-				availableResourceProviders := providerList.Values()
-				requiredResourceProviders := requiredResourceProviders()
+				client.clientOptions.ResourceProviderRegistrar.Seed(providerList.Values())
+			}
+		}
 
-				err := ensureResourceProvidersAreRegistered(ctx, client.providersClient, availableResourceProviders, requiredResourceProviders)
-				if err != nil {
+		// Resource Providers are otherwise registered the first time a Resource within them is created
+		if !skipProviderRegistration {
+			for _, namespace := range *utils.ExpandStringSlice(d.Get("resource_providers_to_register").([]interface{})) {
+				if err := client.clientOptions.ResourceProviderRegistrar.EnsureRegistered(ctx, namespace); err != nil {
 					return nil, fmt.Errorf("Error ensuring Resource Providers are registered: %s", err)
 				}
 			}
@@ -517,6 +660,39 @@ func providerConfigure(p *schema.Provider) schema.ConfigureFunc {
 // armMutexKV is the instance of MutexKV for ARM resources
 var armMutexKV = mutexkv.NewMutexKV()
 
+func expandProviderLogRedaction(input []interface{}) azure.LogRedactionOptions {
+	options := azure.LogRedactionOptions{}
+	if len(input) == 0 || input[0] == nil {
+		return options
+	}
+
+	v := input[0].(map[string]interface{})
+	for _, name := range v["allowlist"].([]interface{}) {
+		options.Allowlist = append(options.Allowlist, name.(string))
+	}
+	for _, name := range v["denylist"].([]interface{}) {
+		options.Denylist = append(options.Denylist, name.(string))
+	}
+
+	return options
+}
+
+func expandProviderDefaultTags(input []interface{}) map[string]interface{} {
+	tags := make(map[string]interface{})
+	if len(input) == 0 || input[0] == nil {
+		return tags
+	}
+
+	v := input[0].(map[string]interface{})
+	for key, value := range v["tags"].(map[string]interface{}) {
+		// validation should have ignored this error already
+		tag, _ := tagValueToString(value)
+		tags[key] = tag
+	}
+
+	return tags
+}
+
 // Deprecated: use `suppress.CaseDifference` instead
 func ignoreCaseDiffSuppressFunc(k, old, new string, d *schema.ResourceData) bool {
 	return suppress.CaseDifference(k, old, new, d)
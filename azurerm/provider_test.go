@@ -11,11 +11,16 @@ import (
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/flags"
 )
 
 var testAccProviders map[string]terraform.ResourceProvider
 var testAccProvider *schema.Provider
 
+// requireResourcesToBeImported determines whether the `requiresImport` acceptance tests should be run - which is
+// the case when the `existing_resources` feature isn't configured and `ARM_PROVIDER_STRICT` is set
+var requireResourcesToBeImported = flags.RequireResourcesToBeImported
+
 func init() {
 	testAccProvider = Provider().(*schema.Provider)
 	testAccProviders = map[string]terraform.ResourceProvider{
@@ -1,7 +1,10 @@
 package azurerm
 
 import (
+	"time"
+
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 )
 
 func resourceArmAutomationVariableBool() *schema.Resource {
@@ -11,8 +14,13 @@ func resourceArmAutomationVariableBool() *schema.Resource {
 		Update: resourceArmAutomationVariableBoolCreateUpdate,
 		Delete: resourceArmAutomationVariableBoolDelete,
 
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+		Importer: azure.ValidateResourceIDPriorToImport(azure.ValidateAutomationVariableID),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
 		Schema: resourceAutomationVariableCommonSchema(schema.TypeBool, nil),
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"log"
 	"regexp"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/batch/mgmt/2018-12-01/batch"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -11,17 +12,23 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func resourceArmBatchAccount() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmBatchAccountCreate,
-		Read:   resourceArmBatchAccountRead,
-		Update: resourceArmBatchAccountUpdate,
-		Delete: resourceArmBatchAccountDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+		Create:   resourceArmBatchAccountCreate,
+		Read:     resourceArmBatchAccountRead,
+		Update:   resourceArmBatchAccountUpdate,
+		Delete:   resourceArmBatchAccountDelete,
+		Importer: azure.ValidateResourceIDPriorToImport(azure.ValidateBatchAccountID),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -41,7 +48,7 @@ func resourceArmBatchAccount() *schema.Resource {
 				Type:         schema.TypeString,
 				Optional:     true,
 				Computed:     true,
-				ValidateFunc: azure.ValidateResourceIDOrEmpty,
+				ValidateFunc: azure.ValidateOrEmpty(azure.ValidateStorageAccountID),
 			},
 			"pool_allocation_mode": {
 				Type:     schema.TypeString,
@@ -72,8 +79,9 @@ func resourceArmBatchAccount() *schema.Resource {
 }
 
 func resourceArmBatchAccountCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).batchAccountClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).Batch().AccountClient
+	ctx, cancel := timeouts.ForCreate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for Azure Batch account creation.")
 
@@ -84,7 +92,7 @@ func resourceArmBatchAccountCreate(d *schema.ResourceData, meta interface{}) err
 	poolAllocationMode := d.Get("pool_allocation_mode").(string)
 	tags := d.Get("tags").(map[string]interface{})
 
-	if requireResourcesToBeImported && d.IsNewResource() {
+	if meta.(*ArmClient).features.ExistingResources.RequireImport && d.IsNewResource() {
 		existing, err := client.Get(ctx, resourceGroup, name)
 		if err != nil {
 			if !utils.ResponseWasNotFound(existing.Response) {
@@ -135,14 +143,15 @@ func resourceArmBatchAccountCreate(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceArmBatchAccountRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).batchAccountClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).Batch().AccountClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := azure.ParseBatchAccountID(d.Id())
 	if err != nil {
 		return err
 	}
-	name := id.Path["batchAccounts"]
+	name := id.Name
 	resourceGroup := id.ResourceGroup
 
 	resp, err := client.Get(ctx, resourceGroup, name)
@@ -187,16 +196,17 @@ func resourceArmBatchAccountRead(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceArmBatchAccountUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).batchAccountClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).Batch().AccountClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for Azure Batch account update.")
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := azure.ParseBatchAccountID(d.Id())
 	if err != nil {
 		return err
 	}
-	name := id.Path["batchAccounts"]
+	name := id.Name
 	resourceGroup := id.ResourceGroup
 
 	storageAccountId := d.Get("storage_account_id").(string)
@@ -230,14 +240,15 @@ func resourceArmBatchAccountUpdate(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceArmBatchAccountDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).batchAccountClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).Batch().AccountClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := azure.ParseBatchAccountID(d.Id())
 	if err != nil {
 		return err
 	}
-	name := id.Path["batchAccounts"]
+	name := id.Name
 	resourceGroup := id.ResourceGroup
 
 	future, err := client.Delete(ctx, resourceGroup, name)
@@ -3,6 +3,7 @@ package azurerm
 import (
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/batch/mgmt/2018-12-01/batch"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -11,17 +12,23 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func resourceArmBatchCertificate() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmBatchCertificateCreate,
-		Read:   resourceArmBatchCertificateRead,
-		Update: resourceArmBatchCertificateUpdate,
-		Delete: resourceArmBatchCertificateDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+		Create:   resourceArmBatchCertificateCreate,
+		Read:     resourceArmBatchCertificateRead,
+		Update:   resourceArmBatchCertificateUpdate,
+		Delete:   resourceArmBatchCertificateDelete,
+		Importer: azure.ValidateResourceIDPriorToImport(azure.ValidateBatchCertificateID),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -86,8 +93,9 @@ func resourceArmBatchCertificate() *schema.Resource {
 }
 
 func resourceArmBatchCertificateCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).batchCertificateClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).Batch().CertificateClient
+	ctx, cancel := timeouts.ForCreate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for Azure Batch certificate creation.")
 
@@ -104,7 +112,7 @@ func resourceArmBatchCertificateCreate(d *schema.ResourceData, meta interface{})
 		return err
 	}
 
-	if requireResourcesToBeImported && d.IsNewResource() {
+	if meta.(*ArmClient).features.ExistingResources.RequireImport && d.IsNewResource() {
 		existing, err := client.Get(ctx, resourceGroupName, accountName, name)
 		if err != nil {
 			if !utils.ResponseWasNotFound(existing.Response) {
@@ -148,15 +156,16 @@ func resourceArmBatchCertificateCreate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceArmBatchCertificateRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).batchCertificateClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).Batch().CertificateClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := azure.ParseBatchCertificateID(d.Id())
 	if err != nil {
 		return err
 	}
-	accountName := id.Path["batchAccounts"]
-	name := id.Path["certificates"]
+	accountName := id.AccountName
+	name := id.Name
 	resourceGroupName := id.ResourceGroup
 
 	resp, err := client.Get(ctx, resourceGroupName, accountName, name)
@@ -184,17 +193,18 @@ func resourceArmBatchCertificateRead(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceArmBatchCertificateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).batchCertificateClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).Batch().CertificateClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for Azure Batch certificate update.")
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := azure.ParseBatchCertificateID(d.Id())
 	if err != nil {
 		return err
 	}
-	accountName := id.Path["batchAccounts"]
-	name := id.Path["certificates"]
+	accountName := id.AccountName
+	name := id.Name
 	resourceGroupName := id.ResourceGroup
 
 	certificate := d.Get("certificate").(string)
@@ -235,15 +245,16 @@ func resourceArmBatchCertificateUpdate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceArmBatchCertificateDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).batchCertificateClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).Batch().CertificateClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := azure.ParseBatchCertificateID(d.Id())
 	if err != nil {
 		return err
 	}
-	accountName := id.Path["batchAccounts"]
-	name := id.Path["certificates"]
+	accountName := id.AccountName
+	name := id.Name
 	resourceGroupName := id.ResourceGroup
 
 	future, err := client.Delete(ctx, resourceGroupName, accountName, name)
@@ -17,17 +17,23 @@ import (
 	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func resourceArmBatchPool() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmBatchPoolCreate,
-		Read:   resourceArmBatchPoolRead,
-		Update: resourceArmBatchPoolUpdate,
-		Delete: resourceArmBatchPoolDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+		Create:   resourceArmBatchPoolCreate,
+		Read:     resourceArmBatchPoolRead,
+		Update:   resourceArmBatchPoolUpdate,
+		Delete:   resourceArmBatchPoolDelete,
+		Importer: azure.ValidateResourceIDPriorToImport(azure.ValidateBatchPoolID),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -334,8 +340,9 @@ func resourceArmBatchPool() *schema.Resource {
 }
 
 func resourceArmBatchPoolCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).batchPoolClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).Batch().PoolClient
+	ctx, cancel := timeouts.ForCreate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for Azure Batch pool creation.")
 
@@ -346,7 +353,7 @@ func resourceArmBatchPoolCreate(d *schema.ResourceData, meta interface{}) error
 	vmSize := d.Get("vm_size").(string)
 	maxTasksPerNode := int32(d.Get("max_tasks_per_node").(int))
 
-	if requireResourcesToBeImported && d.IsNewResource() {
+	if meta.(*ArmClient).features.ExistingResources.RequireImport && d.IsNewResource() {
 		existing, err := client.Get(ctx, resourceGroup, accountName, poolName)
 		if err != nil {
 			if !utils.ResponseWasNotFound(existing.Response) {
@@ -455,16 +462,17 @@ func resourceArmBatchPoolCreate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceArmBatchPoolUpdate(d *schema.ResourceData, meta interface{}) error {
-	ctx := meta.(*ArmClient).StopContext
-	client := meta.(*ArmClient).batchPoolClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+	client := meta.(*ArmClient).Batch().PoolClient
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := azure.ParseBatchPoolID(d.Id())
 	if err != nil {
 		return err
 	}
 	resourceGroup := id.ResourceGroup
-	poolName := id.Path["pools"]
-	accountName := id.Path["batchAccounts"]
+	poolName := id.Name
+	accountName := id.AccountName
 
 	resp, err := client.Get(ctx, resourceGroup, accountName, poolName)
 	if err != nil {
@@ -543,16 +551,17 @@ func resourceArmBatchPoolUpdate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceArmBatchPoolRead(d *schema.ResourceData, meta interface{}) error {
-	ctx := meta.(*ArmClient).StopContext
-	client := meta.(*ArmClient).batchPoolClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+	client := meta.(*ArmClient).Batch().PoolClient
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := azure.ParseBatchPoolID(d.Id())
 	if err != nil {
 		return err
 	}
 	resourceGroup := id.ResourceGroup
-	poolName := id.Path["pools"]
-	accountName := id.Path["batchAccounts"]
+	poolName := id.Name
+	accountName := id.AccountName
 
 	resp, err := client.Get(ctx, resourceGroup, accountName, poolName)
 	if err != nil {
@@ -605,16 +614,17 @@ func resourceArmBatchPoolRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceArmBatchPoolDelete(d *schema.ResourceData, meta interface{}) error {
-	ctx := meta.(*ArmClient).StopContext
-	client := meta.(*ArmClient).batchPoolClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+	client := meta.(*ArmClient).Batch().PoolClient
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := azure.ParseBatchPoolID(d.Id())
 	if err != nil {
 		return err
 	}
 	resourceGroup := id.ResourceGroup
-	poolName := id.Path["pools"]
-	accountName := id.Path["batchAccounts"]
+	poolName := id.Name
+	accountName := id.AccountName
 
 	future, err := client.Delete(ctx, resourceGroup, accountName, poolName)
 	if err != nil {
@@ -109,7 +109,7 @@ func TestAccAzureRMCosmosDbMongoCollection_update(t *testing.T) {
 }
 
 func testCheckAzureRMCosmosDbMongoCollectionDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*ArmClient).cosmosAccountsClient
+	client := testAccProvider.Meta().(*ArmClient).Cosmos().DatabaseAccountsClient
 	ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 	for _, rs := range s.RootModule().Resources {
@@ -139,7 +139,7 @@ func testCheckAzureRMCosmosDbMongoCollectionDestroy(s *terraform.State) error {
 
 func testCheckAzureRMCosmosDbMongoCollectionExists(resourceName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		client := testAccProvider.Meta().(*ArmClient).cosmosAccountsClient
+		client := testAccProvider.Meta().(*ArmClient).Cosmos().DatabaseAccountsClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		// Ensure we have enough information in state to look up in API
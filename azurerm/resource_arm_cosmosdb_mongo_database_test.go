@@ -36,7 +36,7 @@ func TestAccAzureRMCosmosDbMongoDatabase_basic(t *testing.T) {
 }
 
 func testCheckAzureRMCosmosDbMongoDatabaseDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*ArmClient).cosmosAccountsClient
+	client := testAccProvider.Meta().(*ArmClient).Cosmos().DatabaseAccountsClient
 	ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 	for _, rs := range s.RootModule().Resources {
@@ -65,7 +65,7 @@ func testCheckAzureRMCosmosDbMongoDatabaseDestroy(s *terraform.State) error {
 
 func testCheckAzureRMCosmosDbMongoDatabaseExists(resourceName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		client := testAccProvider.Meta().(*ArmClient).cosmosAccountsClient
+		client := testAccProvider.Meta().(*ArmClient).Cosmos().DatabaseAccountsClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		// Ensure we have enough information in state to look up in API
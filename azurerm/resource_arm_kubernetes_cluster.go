@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/containerservice/mgmt/2019-02-01/containerservice"
 	"github.com/hashicorp/terraform/helper/hashcode"
@@ -15,20 +16,23 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func resourceArmKubernetesCluster() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmKubernetesClusterCreateUpdate,
-		Read:   resourceArmKubernetesClusterRead,
-		Update: resourceArmKubernetesClusterCreateUpdate,
-		Delete: resourceArmKubernetesClusterDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceArmKubernetesClusterCreateUpdate,
+		Read:     resourceArmKubernetesClusterRead,
+		Update:   resourceArmKubernetesClusterCreateUpdate,
+		Delete:   resourceArmKubernetesClusterDelete,
+		Importer: azure.ValidateResourceIDPriorToImport(azure.ValidateKubernetesClusterID),
 
 		CustomizeDiff: func(diff *schema.ResourceDiff, v interface{}) error {
+			if err := resourceArmKubernetesClusterValidateComputeCapacity(diff, v); err != nil {
+				return err
+			}
+
 			if v, exists := diff.GetOk("network_profile"); exists {
 				rawProfiles := v.([]interface{})
 				if len(rawProfiles) == 0 {
@@ -63,6 +67,13 @@ func resourceArmKubernetesCluster() *schema.Resource {
 			return nil
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(90 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(90 * time.Minute),
+			Delete: schema.DefaultTimeout(90 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -152,7 +163,7 @@ func resourceArmKubernetesCluster() *schema.Resource {
 							Type:         schema.TypeString,
 							Optional:     true,
 							ForceNew:     true,
-							ValidateFunc: azure.ValidateResourceID,
+							ValidateFunc: azure.ValidateSubnetID,
 						},
 
 						"os_type": {
@@ -244,7 +255,7 @@ func resourceArmKubernetesCluster() *schema.Resource {
 									"log_analytics_workspace_id": {
 										Type:         schema.TypeString,
 										Required:     true,
-										ValidateFunc: azure.ValidateResourceID,
+										ValidateFunc: azure.ValidateLogAnalyticsWorkspaceID,
 									},
 								},
 							},
@@ -536,8 +547,9 @@ func resourceArmKubernetesCluster() *schema.Resource {
 }
 
 func resourceArmKubernetesClusterCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).containers.KubernetesClustersClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).Containers().KubernetesClustersClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 	tenantId := meta.(*ArmClient).tenantId
 
 	log.Printf("[INFO] preparing arguments for Managed Kubernetes Cluster create/update.")
@@ -545,7 +557,7 @@ func resourceArmKubernetesClusterCreateUpdate(d *schema.ResourceData, meta inter
 	resGroup := d.Get("resource_group_name").(string)
 	name := d.Get("name").(string)
 
-	if requireResourcesToBeImported && d.IsNewResource() {
+	if meta.(*ArmClient).features.ExistingResources.RequireImport && d.IsNewResource() {
 		existing, err := client.Get(ctx, resGroup, name)
 		if err != nil {
 			if !utils.ResponseWasNotFound(existing.Response) {
@@ -630,15 +642,16 @@ func resourceArmKubernetesClusterCreateUpdate(d *schema.ResourceData, meta inter
 }
 
 func resourceArmKubernetesClusterRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).containers.KubernetesClustersClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).Containers().KubernetesClustersClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := azure.ParseKubernetesClusterID(d.Id())
 	if err != nil {
 		return err
 	}
 	resGroup := id.ResourceGroup
-	name := id.Path["managedClusters"]
+	name := id.Name
 
 	resp, err := client.Get(ctx, resGroup, name)
 	if err != nil {
@@ -733,15 +746,16 @@ func resourceArmKubernetesClusterRead(d *schema.ResourceData, meta interface{})
 }
 
 func resourceArmKubernetesClusterDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).containers.KubernetesClustersClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).Containers().KubernetesClustersClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := azure.ParseKubernetesClusterID(d.Id())
 	if err != nil {
 		return err
 	}
 	resGroup := id.ResourceGroup
-	name := id.Path["managedClusters"]
+	name := id.Name
 
 	future, err := client.Delete(ctx, resGroup, name)
 	if err != nil {
@@ -1299,3 +1313,37 @@ func flattenKubernetesClusterKubeConfigAAD(config kubernetes.KubeConfigAAD) []in
 
 	return []interface{}{values}
 }
+
+func resourceArmKubernetesClusterValidateComputeCapacity(d *schema.ResourceDiff, meta interface{}) error {
+	if !shouldValidateComputeCapacity(d, meta, "location", "agent_pool_profile") {
+		return nil
+	}
+
+	existing := make([]computeCapacityRequest, 0)
+	if d.Id() != "" && !d.HasChange("location") {
+		oldProfiles, _ := d.GetChange("agent_pool_profile")
+		existing = expandKubernetesClusterComputeCapacity(oldProfiles.([]interface{}))
+	}
+
+	requested := expandKubernetesClusterComputeCapacity(d.Get("agent_pool_profile").([]interface{}))
+	return validateComputeCapacity(meta, d.Get("location").(string), nil, existing, requested)
+}
+
+func expandKubernetesClusterComputeCapacity(input []interface{}) []computeCapacityRequest {
+	output := make([]computeCapacityRequest, 0)
+
+	for _, v := range input {
+		if v == nil {
+			continue
+		}
+
+		profile := v.(map[string]interface{})
+		output = append(output, computeCapacityRequest{
+			ResourceType: computeCapacityResourceTypeVirtualMachines,
+			Sku:          profile["vm_size"].(string),
+			Instances:    int64(profile["count"].(int)),
+		})
+	}
+
+	return output
+}
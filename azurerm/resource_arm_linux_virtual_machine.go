@@ -0,0 +1,566 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2018-06-01/compute"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmLinuxVirtualMachine() *schema.Resource {
+	return &schema.Resource{
+		Create:   resourceArmLinuxVirtualMachineCreate,
+		Read:     resourceArmLinuxVirtualMachineRead,
+		Update:   resourceArmLinuxVirtualMachineUpdate,
+		Delete:   resourceArmLinuxVirtualMachineDelete,
+		Importer: virtualMachineImporter(compute.Linux, "azurerm_linux_virtual_machine"),
+
+		CustomizeDiff: resourceArmLinuxVirtualMachineCustomizeDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.VirtualMachineName,
+			},
+
+			"resource_group_name": azure.SchemaResourceGroupName(),
+
+			"location": azure.SchemaLocation(),
+
+			"size": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"admin_username": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+
+			"admin_password": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Sensitive:    true,
+				ValidateFunc: validation.StringLenBetween(6, 72),
+			},
+
+			"admin_ssh_key": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"public_key": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+
+						"username": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+					},
+				},
+			},
+
+			"disable_password_authentication": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  true,
+			},
+
+			"network_interface_ids": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: azure.ValidateResourceID,
+				},
+			},
+
+			"os_disk": virtualMachineOSDiskSchema(),
+
+			"source_image_reference": virtualMachineSourceImageReferenceSchema(),
+
+			"source_image_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ValidateFunc:  azure.ValidateResourceID,
+				ConflictsWith: []string{"source_image_reference"},
+			},
+
+			"plan": virtualMachinePlanSchema(),
+
+			"availability_set_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateAvailabilitySetID,
+				// the API returns this in upper-case
+				DiffSuppressFunc: ignoreCaseDiffSuppressFunc,
+				ConflictsWith:    []string{"zone"},
+			},
+
+			"zone": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"availability_set_id"},
+			},
+
+			"boot_diagnostics": virtualMachineBootDiagnosticsSchema(),
+
+			"identity": virtualMachineIdentitySchema(),
+
+			"computer_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				// defaults to the `name` of the Virtual Machine
+				ValidateFunc: validate.VirtualMachineName,
+			},
+
+			"custom_data": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Sensitive:    true,
+				ValidateFunc: validate.Base64String(),
+			},
+
+			"provision_vm_agent": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  true,
+			},
+
+			"allow_extension_operations": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"secret": virtualMachineSecretSchema(map[string]*schema.Schema{
+				"url": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: azure.ValidateKeyVaultChildId,
+				},
+			}),
+
+			"tags": tagsSchema(),
+
+			"virtual_machine_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceArmLinuxVirtualMachineCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).Compute().VMClient
+	ctx, cancel := timeouts.ForCreate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+
+	if meta.(*ArmClient).features.ExistingResources.RequireImport {
+		existing, err := client.Get(ctx, resourceGroup, name, "")
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for presence of existing Linux Virtual Machine %q (Resource Group %q): %+v", name, resourceGroup, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_linux_virtual_machine", *existing.ID)
+		}
+	}
+
+	computerName := d.Get("computer_name").(string)
+	if computerName == "" {
+		computerName = name
+	}
+
+	disablePasswordAuthentication := d.Get("disable_password_authentication").(bool)
+	adminUsername := d.Get("admin_username").(string)
+
+	osProfile := compute.OSProfile{
+		AdminUsername:            utils.String(adminUsername),
+		ComputerName:             utils.String(computerName),
+		AllowExtensionOperations: utils.Bool(d.Get("allow_extension_operations").(bool)),
+		LinuxConfiguration: &compute.LinuxConfiguration{
+			DisablePasswordAuthentication: utils.Bool(disablePasswordAuthentication),
+			ProvisionVMAgent:              utils.Bool(d.Get("provision_vm_agent").(bool)),
+			SSH: &compute.SSHConfiguration{
+				PublicKeys: expandLinuxVirtualMachineSSHKeys(d.Get("admin_ssh_key").(*schema.Set).List()),
+			},
+		},
+		Secrets: expandVirtualMachineSecrets(d.Get("secret").([]interface{})),
+	}
+
+	if !disablePasswordAuthentication {
+		osProfile.AdminPassword = utils.String(d.Get("admin_password").(string))
+	}
+
+	if v := d.Get("custom_data").(string); v != "" {
+		osProfile.CustomData = utils.String(v)
+	}
+
+	params := compute.VirtualMachine{
+		Name:     utils.String(name),
+		Location: utils.String(azure.NormalizeLocation(d.Get("location").(string))),
+		VirtualMachineProperties: &compute.VirtualMachineProperties{
+			HardwareProfile: &compute.HardwareProfile{
+				VMSize: compute.VirtualMachineSizeTypes(d.Get("size").(string)),
+			},
+			OsProfile:          &osProfile,
+			NetworkProfile:     expandVirtualMachineNetworkInterfaceIDs(d.Get("network_interface_ids").([]interface{})),
+			DiagnosticsProfile: expandVirtualMachineBootDiagnostics(d.Get("boot_diagnostics").([]interface{})),
+			StorageProfile: &compute.StorageProfile{
+				ImageReference: expandVirtualMachineStorageProfileImageReference(d),
+				OsDisk:         expandVirtualMachineOSDisk(d.Get("os_disk").([]interface{}), compute.Linux),
+			},
+		},
+		Tags: expandTags(d.Get("tags").(map[string]interface{})),
+	}
+
+	if _, ok := d.GetOk("identity"); ok {
+		params.Identity = expandAzureRmVirtualMachineIdentity(d)
+	}
+
+	if _, ok := d.GetOk("plan"); ok {
+		plan, err := expandAzureRmVirtualMachinePlan(d)
+		if err != nil {
+			return err
+		}
+		params.Plan = plan
+	}
+
+	if v := d.Get("availability_set_id").(string); v != "" {
+		params.VirtualMachineProperties.AvailabilitySet = &compute.SubResource{
+			ID: utils.String(v),
+		}
+	}
+
+	if v := d.Get("zone").(string); v != "" {
+		params.Zones = &[]string{v}
+	}
+
+	azureRMLockByName(name, virtualMachineResourceName)
+	defer azureRMUnlockByName(name, virtualMachineResourceName)
+
+	log.Printf("[DEBUG] Creating Linux Virtual Machine %q (Resource Group %q)..", name, resourceGroup)
+	future, err := client.CreateOrUpdate(ctx, resourceGroup, name, params)
+	if err != nil {
+		return fmt.Errorf("Error creating Linux Virtual Machine %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for creation of Linux Virtual Machine %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	read, err := client.Get(ctx, resourceGroup, name, "")
+	if err != nil {
+		return fmt.Errorf("Error retrieving Linux Virtual Machine %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if read.ID == nil {
+		return fmt.Errorf("Error retrieving Linux Virtual Machine %q (Resource Group %q): `id` was nil", name, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	ipAddress, err := determineVirtualMachineIPAddress(ctx, meta, read.VirtualMachineProperties)
+	if err != nil {
+		return fmt.Errorf("Error determining IP Address for Linux Virtual Machine %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	d.SetConnInfo(map[string]string{
+		"type": "ssh",
+		"host": ipAddress,
+	})
+
+	return resourceArmLinuxVirtualMachineRead(d, meta)
+}
+
+func resourceArmLinuxVirtualMachineRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).Compute().VMClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := azure.ParseVirtualMachineID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.Name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Linux Virtual Machine %q was not found in Resource Group %q - removing from state!", id.Name, id.ResourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Linux Virtual Machine %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", id.ResourceGroup)
+	if location := resp.Location; location != nil {
+		d.Set("location", azure.NormalizeLocation(*location))
+	}
+
+	zone := ""
+	if resp.Zones != nil && len(*resp.Zones) > 0 {
+		zone = (*resp.Zones)[0]
+	}
+	d.Set("zone", zone)
+
+	if err := d.Set("identity", flattenVirtualMachineIdentity(resp.Identity)); err != nil {
+		return fmt.Errorf("Error setting `identity`: %+v", err)
+	}
+
+	if err := d.Set("plan", flattenAzureRmVirtualMachinePlan(resp.Plan)); err != nil {
+		return fmt.Errorf("Error setting `plan`: %+v", err)
+	}
+
+	if props := resp.VirtualMachineProperties; props != nil {
+		availabilitySetId := ""
+		if props.AvailabilitySet != nil && props.AvailabilitySet.ID != nil {
+			availabilitySetId = *props.AvailabilitySet.ID
+		}
+		d.Set("availability_set_id", availabilitySetId)
+
+		if profile := props.HardwareProfile; profile != nil {
+			d.Set("size", string(profile.VMSize))
+		}
+
+		if err := d.Set("boot_diagnostics", flattenVirtualMachineBootDiagnostics(props.DiagnosticsProfile)); err != nil {
+			return fmt.Errorf("Error setting `boot_diagnostics`: %+v", err)
+		}
+
+		if err := d.Set("network_interface_ids", flattenVirtualMachineNetworkInterfaceIDs(props.NetworkProfile)); err != nil {
+			return fmt.Errorf("Error setting `network_interface_ids`: %+v", err)
+		}
+
+		if profile := props.OsProfile; profile != nil {
+			d.Set("admin_username", profile.AdminUsername)
+			d.Set("computer_name", profile.ComputerName)
+
+			allowExtensionOperations := true
+			if profile.AllowExtensionOperations != nil {
+				allowExtensionOperations = *profile.AllowExtensionOperations
+			}
+			d.Set("allow_extension_operations", allowExtensionOperations)
+
+			if config := profile.LinuxConfiguration; config != nil {
+				disablePasswordAuthentication := false
+				if config.DisablePasswordAuthentication != nil {
+					disablePasswordAuthentication = *config.DisablePasswordAuthentication
+				}
+				d.Set("disable_password_authentication", disablePasswordAuthentication)
+
+				provisionVMAgent := true
+				if config.ProvisionVMAgent != nil {
+					provisionVMAgent = *config.ProvisionVMAgent
+				}
+				d.Set("provision_vm_agent", provisionVMAgent)
+
+				sshKeys := make([]interface{}, 0)
+				if config.SSH != nil {
+					sshKeys = flattenLinuxVirtualMachineSSHKeys(config.SSH.PublicKeys)
+				}
+				if err := d.Set("admin_ssh_key", sshKeys); err != nil {
+					return fmt.Errorf("Error setting `admin_ssh_key`: %+v", err)
+				}
+			}
+
+			if err := d.Set("secret", flattenVirtualMachineSecrets(profile.Secrets, false)); err != nil {
+				return fmt.Errorf("Error setting `secret`: %+v", err)
+			}
+		}
+
+		if profile := props.StorageProfile; profile != nil {
+			if err := d.Set("os_disk", flattenVirtualMachineOSDisk(profile.OsDisk)); err != nil {
+				return fmt.Errorf("Error setting `os_disk`: %+v", err)
+			}
+
+			sourceImageId := ""
+			if profile.ImageReference != nil && profile.ImageReference.ID != nil {
+				sourceImageId = *profile.ImageReference.ID
+			}
+			d.Set("source_image_id", sourceImageId)
+
+			if err := d.Set("source_image_reference", flattenVirtualMachineSourceImageReference(profile.ImageReference)); err != nil {
+				return fmt.Errorf("Error setting `source_image_reference`: %+v", err)
+			}
+		}
+
+		d.Set("virtual_machine_id", props.VMID)
+	}
+
+	flattenAndSetTags(d, resp.Tags)
+
+	return nil
+}
+
+func resourceArmLinuxVirtualMachineUpdate(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := timeouts.ForUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	update := compute.VirtualMachineUpdate{
+		VirtualMachineProperties: &compute.VirtualMachineProperties{},
+	}
+
+	if d.HasChange("allow_extension_operations") || d.HasChange("secret") {
+		update.VirtualMachineProperties.OsProfile = &compute.OSProfile{
+			AllowExtensionOperations: utils.Bool(d.Get("allow_extension_operations").(bool)),
+			Secrets:                  expandVirtualMachineSecrets(d.Get("secret").([]interface{})),
+		}
+	}
+
+	if err := updateVirtualMachine(ctx, d, meta, "Linux", update); err != nil {
+		return err
+	}
+
+	return resourceArmLinuxVirtualMachineRead(d, meta)
+}
+
+func resourceArmLinuxVirtualMachineDelete(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	return deleteVirtualMachine(ctx, d, meta, "Linux")
+}
+
+func resourceArmLinuxVirtualMachineCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	if err := validateVirtualMachineSourceImage(d); err != nil {
+		return err
+	}
+
+	if err := validateVirtualMachineOSDisk(d); err != nil {
+		return err
+	}
+
+	if d.NewValueKnown("disable_password_authentication") && d.NewValueKnown("admin_password") && d.NewValueKnown("admin_ssh_key") {
+		disablePasswordAuthentication := d.Get("disable_password_authentication").(bool)
+		adminPassword := d.Get("admin_password").(string)
+		sshKeys := d.Get("admin_ssh_key").(*schema.Set).List()
+
+		if disablePasswordAuthentication {
+			if adminPassword != "" {
+				return fmt.Errorf("`admin_password` cannot be specified when `disable_password_authentication` is `true`")
+			}
+
+			if len(sshKeys) == 0 {
+				return fmt.Errorf("At least one `admin_ssh_key` must be specified when `disable_password_authentication` is `true`")
+			}
+		} else if adminPassword == "" {
+			return fmt.Errorf("`admin_password` must be specified when `disable_password_authentication` is `false`")
+		}
+
+		if d.NewValueKnown("admin_username") {
+			adminUsername := d.Get("admin_username").(string)
+			for _, raw := range sshKeys {
+				username := raw.(map[string]interface{})["username"].(string)
+				if username != "" && username != adminUsername {
+					return fmt.Errorf("The `username` of each `admin_ssh_key` must match the `admin_username` (%q) but got %q", adminUsername, username)
+				}
+			}
+		}
+	}
+
+	return validateVirtualMachineComputeCapacity(d, meta)
+}
+
+func expandLinuxVirtualMachineSSHKeys(input []interface{}) *[]compute.SSHPublicKey {
+	output := make([]compute.SSHPublicKey, 0)
+
+	for _, v := range input {
+		raw := v.(map[string]interface{})
+
+		username := raw["username"].(string)
+		output = append(output, compute.SSHPublicKey{
+			KeyData: utils.String(raw["public_key"].(string)),
+			Path:    utils.String(linuxVirtualMachineSSHKeyPath(username)),
+		})
+	}
+
+	return &output
+}
+
+func flattenLinuxVirtualMachineSSHKeys(input *[]compute.SSHPublicKey) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	output := make([]interface{}, 0)
+	for _, v := range *input {
+		if v.KeyData == nil || v.Path == nil {
+			continue
+		}
+
+		username, err := parseLinuxVirtualMachineSSHKeyPath(*v.Path)
+		if err != nil {
+			log.Printf("[DEBUG] Skipping SSH Key: %+v", err)
+			continue
+		}
+
+		output = append(output, map[string]interface{}{
+			// the API can return a trailing newline, which isn't present in the configuration
+			"public_key": strings.TrimSpace(*v.KeyData),
+			"username":   username,
+		})
+	}
+
+	return output
+}
+
+func linuxVirtualMachineSSHKeyPath(username string) string {
+	return fmt.Sprintf("/home/%s/.ssh/authorized_keys", username)
+}
+
+// parseLinuxVirtualMachineSSHKeyPath returns the username from an SSH Key Path in the format
+// `/home/{username}/.ssh/authorized_keys`
+func parseLinuxVirtualMachineSSHKeyPath(input string) (string, error) {
+	segments := strings.Split(strings.TrimPrefix(input, "/"), "/")
+	if len(segments) != 4 || segments[0] != "home" || segments[1] == "" || segments[2] != ".ssh" || segments[3] != "authorized_keys" {
+		return "", fmt.Errorf("Expected the SSH Key Path to be in the format `/home/{username}/.ssh/authorized_keys` but got %q", input)
+	}
+
+	return segments[1], nil
+}
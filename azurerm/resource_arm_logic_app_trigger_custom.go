@@ -3,6 +3,7 @@ package azurerm
 import (
 	"fmt"
 	"log"
+	"time"
 
 	"encoding/json"
 
@@ -14,12 +15,17 @@ import (
 
 func resourceArmLogicAppTriggerCustom() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmLogicAppTriggerCustomCreateUpdate,
-		Read:   resourceArmLogicAppTriggerCustomRead,
-		Update: resourceArmLogicAppTriggerCustomCreateUpdate,
-		Delete: resourceArmLogicAppTriggerCustomDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+		Create:   resourceArmLogicAppTriggerCustomCreateUpdate,
+		Read:     resourceArmLogicAppTriggerCustomRead,
+		Update:   resourceArmLogicAppTriggerCustomCreateUpdate,
+		Delete:   resourceArmLogicAppTriggerCustomDelete,
+		Importer: azure.ValidateResourceIDPriorToImport(azure.ValidateLogicAppTriggerID),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -33,7 +39,7 @@ func resourceArmLogicAppTriggerCustom() *schema.Resource {
 				Type:         schema.TypeString,
 				Required:     true,
 				ForceNew:     true,
-				ValidateFunc: azure.ValidateResourceID,
+				ValidateFunc: azure.ValidateLogicAppWorkflowID,
 			},
 
 			"body": {
@@ -64,16 +70,16 @@ func resourceArmLogicAppTriggerCustomCreateUpdate(d *schema.ResourceData, meta i
 }
 
 func resourceArmLogicAppTriggerCustomRead(d *schema.ResourceData, meta interface{}) error {
-	id, err := parseAzureResourceID(d.Id())
+	id, err := azure.ParseLogicAppTriggerID(d.Id())
 	if err != nil {
 		return err
 	}
 
 	resourceGroup := id.ResourceGroup
-	logicAppName := id.Path["workflows"]
-	name := id.Path["triggers"]
+	logicAppName := id.WorkflowName
+	name := id.Name
 
-	t, app, err := retrieveLogicAppTrigger(meta, resourceGroup, logicAppName, name)
+	t, app, err := retrieveLogicAppTrigger(d, meta, resourceGroup, logicAppName, name)
 	if err != nil {
 		return err
 	}
@@ -102,14 +108,14 @@ func resourceArmLogicAppTriggerCustomRead(d *schema.ResourceData, meta interface
 }
 
 func resourceArmLogicAppTriggerCustomDelete(d *schema.ResourceData, meta interface{}) error {
-	id, err := parseAzureResourceID(d.Id())
+	id, err := azure.ParseLogicAppTriggerID(d.Id())
 	if err != nil {
 		return err
 	}
 
 	resourceGroup := id.ResourceGroup
-	logicAppName := id.Path["workflows"]
-	name := id.Path["triggers"]
+	logicAppName := id.WorkflowName
+	name := id.Name
 
 	err = resourceLogicAppTriggerRemove(d, meta, resourceGroup, logicAppName, name)
 	if err != nil {
@@ -6,6 +6,7 @@ import (
 	"log"
 	"net/http"
 	"regexp"
+	"time"
 
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/structure"
@@ -15,13 +16,11 @@ import (
 
 func resourceArmLogicAppTriggerHttpRequest() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmLogicAppTriggerHttpRequestCreateUpdate,
-		Read:   resourceArmLogicAppTriggerHttpRequestRead,
-		Update: resourceArmLogicAppTriggerHttpRequestCreateUpdate,
-		Delete: resourceArmLogicAppTriggerHttpRequestDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceArmLogicAppTriggerHttpRequestCreateUpdate,
+		Read:     resourceArmLogicAppTriggerHttpRequestRead,
+		Update:   resourceArmLogicAppTriggerHttpRequestCreateUpdate,
+		Delete:   resourceArmLogicAppTriggerHttpRequestDelete,
+		Importer: azure.ValidateResourceIDPriorToImport(azure.ValidateLogicAppTriggerID),
 
 		CustomizeDiff: func(diff *schema.ResourceDiff, v interface{}) error {
 
@@ -36,6 +35,13 @@ func resourceArmLogicAppTriggerHttpRequest() *schema.Resource {
 			return nil
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -47,7 +53,7 @@ func resourceArmLogicAppTriggerHttpRequest() *schema.Resource {
 				Type:         schema.TypeString,
 				Required:     true,
 				ForceNew:     true,
-				ValidateFunc: azure.ValidateResourceID,
+				ValidateFunc: azure.ValidateLogicAppWorkflowID,
 			},
 
 			"schema": {
@@ -113,16 +119,16 @@ func resourceArmLogicAppTriggerHttpRequestCreateUpdate(d *schema.ResourceData, m
 }
 
 func resourceArmLogicAppTriggerHttpRequestRead(d *schema.ResourceData, meta interface{}) error {
-	id, err := parseAzureResourceID(d.Id())
+	id, err := azure.ParseLogicAppTriggerID(d.Id())
 	if err != nil {
 		return err
 	}
 
 	resourceGroup := id.ResourceGroup
-	logicAppName := id.Path["workflows"]
-	name := id.Path["triggers"]
+	logicAppName := id.WorkflowName
+	name := id.Name
 
-	t, app, err := retrieveLogicAppTrigger(meta, resourceGroup, logicAppName, name)
+	t, app, err := retrieveLogicAppTrigger(d, meta, resourceGroup, logicAppName, name)
 	if err != nil {
 		return err
 	}
@@ -169,14 +175,14 @@ func resourceArmLogicAppTriggerHttpRequestRead(d *schema.ResourceData, meta inte
 }
 
 func resourceArmLogicAppTriggerHttpRequestDelete(d *schema.ResourceData, meta interface{}) error {
-	id, err := parseAzureResourceID(d.Id())
+	id, err := azure.ParseLogicAppTriggerID(d.Id())
 	if err != nil {
 		return err
 	}
 
 	resourceGroup := id.ResourceGroup
-	logicAppName := id.Path["workflows"]
-	name := id.Path["triggers"]
+	logicAppName := id.WorkflowName
+	name := id.Name
 
 	err = resourceLogicAppTriggerRemove(d, meta, resourceGroup, logicAppName, name)
 	if err != nil {
@@ -0,0 +1,213 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2018-05-01/resources"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmManagementGroupTemplateDeployment() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmManagementGroupTemplateDeploymentCreateUpdate,
+		Read:   resourceArmManagementGroupTemplateDeploymentRead,
+		Update: resourceArmManagementGroupTemplateDeploymentCreateUpdate,
+		Delete: resourceArmManagementGroupTemplateDeploymentDelete,
+
+		Importer: azure.ValidateResourceIDPriorToImport(azure.ValidateManagementGroupTemplateDeploymentID),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(180 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(180 * time.Minute),
+			Delete: schema.DefaultTimeout(180 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"management_group_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			// the location the deployment data is stored in, rather than where resources are deployed
+			"location": azure.SchemaLocation(),
+
+			"template_body": {
+				Type:      schema.TypeString,
+				Optional:  true,
+				Computed:  true,
+				StateFunc: normalizeJson,
+			},
+
+			"parameters": {
+				Type:          schema.TypeMap,
+				Optional:      true,
+				ConflictsWith: []string{"parameters_body"},
+			},
+
+			"parameters_body": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				StateFunc:     normalizeJson,
+				ConflictsWith: []string{"parameters"},
+			},
+
+			"outputs": {
+				Type:     schema.TypeMap,
+				Computed: true,
+			},
+
+			"outputs_json": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"sensitive_outputs_json": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func resourceArmManagementGroupTemplateDeploymentCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient)
+	deployClient := client.Resource().ManagementGroupDeploymentsClient
+	ctx, cancel := timeouts.ForCreateUpdate(client.StopContext, d)
+	defer cancel()
+
+	name := d.Get("name").(string)
+	managementGroupId := d.Get("management_group_id").(string)
+
+	if client.features.ExistingResources.RequireImport && d.IsNewResource() {
+		existing, err := deployClient.Get(ctx, managementGroupId, name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for presence of existing Template Deployment %q (Management Group %q): %+v", name, managementGroupId, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_management_group_template_deployment", *existing.ID)
+		}
+	}
+
+	log.Printf("[INFO] preparing arguments for AzureRM Management Group Template Deployment creation.")
+	// Deployments scoped to a Management Group only support the Incremental mode
+	properties := resources.DeploymentProperties{
+		Mode: resources.Incremental,
+	}
+
+	parameters, err := expandTemplateDeploymentParameters(d)
+	if err != nil {
+		return err
+	}
+	properties.Parameters = parameters
+
+	if v, ok := d.GetOk("template_body"); ok {
+		template, err := expandTemplateBody(v.(string))
+		if err != nil {
+			return err
+		}
+
+		properties.Template = &template
+	}
+
+	deployment := resources.Deployment{
+		Location:   utils.String(azure.NormalizeLocation(d.Get("location"))),
+		Properties: &properties,
+	}
+
+	future, err := deployClient.CreateOrUpdate(ctx, managementGroupId, name, deployment)
+	if err != nil {
+		return fmt.Errorf("Error creating Template Deployment %q (Management Group %q): %+v", name, managementGroupId, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, deployClient.Client); err != nil {
+		return fmt.Errorf("Error waiting for Template Deployment %q (Management Group %q): %+v", name, managementGroupId, err)
+	}
+
+	read, err := deployClient.Get(ctx, managementGroupId, name)
+	if err != nil {
+		return err
+	}
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read Template Deployment %q (Management Group %q) ID", name, managementGroupId)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmManagementGroupTemplateDeploymentRead(d, meta)
+}
+
+func resourceArmManagementGroupTemplateDeploymentRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient)
+	deployClient := client.Resource().ManagementGroupDeploymentsClient
+	ctx, cancel := timeouts.ForRead(client.StopContext, d)
+	defer cancel()
+
+	id, err := azure.ParseManagementGroupTemplateDeploymentID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := deployClient.Get(ctx, id.ManagementGroup, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Template Deployment %q (Management Group %q) was not found - removing from state", id.Name, id.ManagementGroup)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error making Read request on Template Deployment %q (Management Group %q): %+v", id.Name, id.ManagementGroup, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("management_group_id", id.ManagementGroup)
+	if location := resp.Location; location != nil {
+		d.Set("location", azure.NormalizeLocation(*location))
+	}
+
+	var outputs interface{}
+	if props := resp.Properties; props != nil {
+		outputs = props.Outputs
+	}
+
+	return setTemplateDeploymentOutputs(d, outputs)
+}
+
+func resourceArmManagementGroupTemplateDeploymentDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient)
+	deployClient := client.Resource().ManagementGroupDeploymentsClient
+	ctx, cancel := timeouts.ForDelete(client.StopContext, d)
+	defer cancel()
+
+	id, err := azure.ParseManagementGroupTemplateDeploymentID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if _, err = deployClient.Delete(ctx, id.ManagementGroup, id.Name); err != nil {
+		return fmt.Errorf("Error deleting Template Deployment %q (Management Group %q): %+v", id.Name, id.ManagementGroup, err)
+	}
+
+	description := fmt.Sprintf("Template Deployment %q (Management Group %q)", id.Name, id.ManagementGroup)
+	return waitForTemplateDeploymentToBeGone(ctx, description, d.Timeout(schema.TimeoutDelete), func() (resources.DeploymentExtended, error) {
+		return deployClient.Get(ctx, id.ManagementGroup, id.Name)
+	})
+}
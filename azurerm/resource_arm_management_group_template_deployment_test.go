@@ -0,0 +1,215 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMManagementGroupTemplateDeployment_basic(t *testing.T) {
+	resourceName := "azurerm_management_group_template_deployment.test"
+	ri := tf.AccRandTimeInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMManagementGroupTemplateDeploymentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMManagementGroupTemplateDeployment_basic(ri, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMManagementGroupTemplateDeploymentExists(resourceName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"parameters",
+					"template_body",
+				},
+			},
+		},
+	})
+}
+
+func TestAccAzureRMManagementGroupTemplateDeployment_requiresImport(t *testing.T) {
+	if !requireResourcesToBeImported {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_management_group_template_deployment.test"
+	ri := tf.AccRandTimeInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMManagementGroupTemplateDeploymentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMManagementGroupTemplateDeployment_basic(ri, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMManagementGroupTemplateDeploymentExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMManagementGroupTemplateDeployment_requiresImport(ri, testLocation()),
+				ExpectError: testRequiresImportError("azurerm_management_group_template_deployment"),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMManagementGroupTemplateDeployment_withOutputs(t *testing.T) {
+	resourceName := "azurerm_management_group_template_deployment.test"
+	ri := tf.AccRandTimeInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMManagementGroupTemplateDeploymentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMManagementGroupTemplateDeployment_basic(ri, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMManagementGroupTemplateDeploymentExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "outputs.testOutput", "first"),
+				),
+			},
+			{
+				Config: testAccAzureRMManagementGroupTemplateDeployment_updatedParameters(ri, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMManagementGroupTemplateDeploymentExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "outputs.testOutput", "second"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMManagementGroupTemplateDeploymentExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		id, err := azure.ParseManagementGroupTemplateDeploymentID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).Resource().ManagementGroupDeploymentsClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, id.ManagementGroup, id.Name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Template Deployment %q (Management Group %q) does not exist", id.Name, id.ManagementGroup)
+			}
+
+			return fmt.Errorf("Bad: Get on deploymentsClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMManagementGroupTemplateDeploymentDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).Resource().ManagementGroupDeploymentsClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_management_group_template_deployment" {
+			continue
+		}
+
+		id, err := azure.ParseManagementGroupTemplateDeploymentID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		resp, err := client.Get(ctx, id.ManagementGroup, id.Name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Management Group Template Deployment still exists:\n%#v", resp.Properties)
+	}
+
+	return nil
+}
+
+func testAccAzureRMManagementGroupTemplateDeployment_basic(rInt int, location string) string {
+	return testAccAzureRMManagementGroupTemplateDeployment_template(rInt, location, "first")
+}
+
+func testAccAzureRMManagementGroupTemplateDeployment_updatedParameters(rInt int, location string) string {
+	return testAccAzureRMManagementGroupTemplateDeployment_template(rInt, location, "second")
+}
+
+func testAccAzureRMManagementGroupTemplateDeployment_requiresImport(rInt int, location string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_management_group_template_deployment" "import" {
+  name                = "${azurerm_management_group_template_deployment.test.name}"
+  management_group_id = "${azurerm_management_group_template_deployment.test.management_group_id}"
+  location            = "${azurerm_management_group_template_deployment.test.location}"
+  template_body       = "${azurerm_management_group_template_deployment.test.template_body}"
+
+  parameters = {
+    "testValue" = "first"
+  }
+}
+`, testAccAzureRMManagementGroupTemplateDeployment_basic(rInt, location))
+}
+
+func testAccAzureRMManagementGroupTemplateDeployment_template(rInt int, location string, value string) string {
+	return fmt.Sprintf(`
+resource "azurerm_management_group" "test" {
+  group_id = "acctestmg-%d"
+}
+
+resource "azurerm_management_group_template_deployment" "test" {
+  name                = "acctestmgdeploy-%d"
+  management_group_id = "${azurerm_management_group.test.group_id}"
+  location            = "%s"
+
+  template_body = <<DEPLOY
+{
+  "$schema": "https://schema.management.azure.com/schemas/2019-08-01/managementGroupDeploymentTemplate.json#",
+  "contentVersion": "1.0.0.0",
+  "parameters": {
+    "testValue": {
+      "type": "string"
+    }
+  },
+  "resources": [],
+  "outputs": {
+    "testOutput": {
+      "type": "string",
+      "value": "[parameters('testValue')]"
+    }
+  }
+}
+DEPLOY
+
+  parameters = {
+    "testValue" = "%s"
+  }
+}
+`, rInt, rInt, location, value)
+}
@@ -4,23 +4,30 @@ import (
 	"fmt"
 	"log"
 	"regexp"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/mediaservices/mgmt/2018-07-01/media"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func resourceArmMediaServicesAccount() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmMediaServicesAccountCreateUpdate,
-		Read:   resourceArmMediaServicesAccountRead,
-		Update: resourceArmMediaServicesAccountCreateUpdate,
-		Delete: resourceArmMediaServicesAccountDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+		Create:   resourceArmMediaServicesAccountCreateUpdate,
+		Read:     resourceArmMediaServicesAccountRead,
+		Update:   resourceArmMediaServicesAccountCreateUpdate,
+		Delete:   resourceArmMediaServicesAccountDelete,
+		Importer: azure.ValidateResourceIDPriorToImport(azure.ValidateMediaServicesAccountID),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -66,8 +73,9 @@ func resourceArmMediaServicesAccount() *schema.Resource {
 }
 
 func resourceArmMediaServicesAccountCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).media.ServicesClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).Media().ServicesClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	accountName := d.Get("name").(string)
 	location := azure.NormalizeLocation(d.Get("location").(string))
@@ -100,15 +108,16 @@ func resourceArmMediaServicesAccountCreateUpdate(d *schema.ResourceData, meta in
 }
 
 func resourceArmMediaServicesAccountRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).media.ServicesClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).Media().ServicesClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := azure.ParseMediaServicesAccountID(d.Id())
 	if err != nil {
 		return err
 	}
 
-	name := id.Path["mediaservices"]
+	name := id.Name
 	resourceGroup := id.ResourceGroup
 
 	resp, err := client.Get(ctx, resourceGroup, name)
@@ -141,15 +150,16 @@ func resourceArmMediaServicesAccountRead(d *schema.ResourceData, meta interface{
 }
 
 func resourceArmMediaServicesAccountDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).media.ServicesClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).Media().ServicesClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := azure.ParseMediaServicesAccountID(d.Id())
 	if err != nil {
 		return err
 	}
 
-	name := id.Path["mediaservices"]
+	name := id.Name
 	resourceGroup := id.ResourceGroup
 
 	resp, err := client.Delete(ctx, resourceGroup, name)
@@ -3,12 +3,14 @@ package azurerm
 import (
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-12-01/network"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -16,12 +18,17 @@ const azureNetworkProfileResourceName = "azurerm_network_profile"
 
 func resourceArmNetworkProfile() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmNetworkProfileCreateUpdate,
-		Read:   resourceArmNetworkProfileRead,
-		Update: resourceArmNetworkProfileCreateUpdate,
-		Delete: resourceArmNetworkProfileDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+		Create:   resourceArmNetworkProfileCreateUpdate,
+		Read:     resourceArmNetworkProfileRead,
+		Update:   resourceArmNetworkProfileCreateUpdate,
+		Delete:   resourceArmNetworkProfileDelete,
+		Importer: azure.ValidateResourceIDPriorToImport(azure.ValidateNetworkProfileID),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -60,7 +67,7 @@ func resourceArmNetworkProfile() *schema.Resource {
 									"subnet_id": {
 										Type:         schema.TypeString,
 										Required:     true,
-										ValidateFunc: azure.ValidateResourceID,
+										ValidateFunc: azure.ValidateSubnetID,
 									},
 								},
 							},
@@ -83,15 +90,16 @@ func resourceArmNetworkProfile() *schema.Resource {
 }
 
 func resourceArmNetworkProfileCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).netProfileClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).Network().ProfileClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for Network Profile creation")
 
 	name := d.Get("name").(string)
 	resourceGroup := d.Get("resource_group_name").(string)
 
-	if requireResourcesToBeImported && d.IsNewResource() {
+	if meta.(*ArmClient).features.ExistingResources.RequireImport && d.IsNewResource() {
 		existing, err := client.Get(ctx, resourceGroup, name, "")
 		if err != nil {
 			if !utils.ResponseWasNotFound(existing.Response) {
@@ -153,15 +161,16 @@ func resourceArmNetworkProfileCreateUpdate(d *schema.ResourceData, meta interfac
 }
 
 func resourceArmNetworkProfileRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).netProfileClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).Network().ProfileClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := azure.ParseNetworkProfileID(d.Id())
 	if err != nil {
 		return err
 	}
 	resourceGroup := id.ResourceGroup
-	name := id.Path["networkProfiles"]
+	name := id.Name
 
 	profile, err := client.Get(ctx, resourceGroup, name, "")
 	if err != nil {
@@ -198,15 +207,16 @@ func resourceArmNetworkProfileRead(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceArmNetworkProfileDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).netProfileClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).Network().ProfileClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := azure.ParseNetworkProfileID(d.Id())
 	if err != nil {
 		return err
 	}
 	resourceGroup := id.ResourceGroup
-	name := id.Path["networkProfiles"]
+	name := id.Name
 
 	read, err := client.Get(ctx, resourceGroup, name, "")
 	if err != nil {
@@ -0,0 +1,298 @@
+package azurerm
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	resourceSvc "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/resource"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmResource() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmResourceCreateUpdate,
+		Read:   resourceArmResourceRead,
+		Update: resourceArmResourceCreateUpdate,
+		Delete: resourceArmResourceDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				if _, err := resourceSvc.ParseGenericResourceID(d.Id()); err != nil {
+					return nil, err
+				}
+
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"parent_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateGenericResourceType,
+			},
+
+			"api_version": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"body": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.ValidateJsonString,
+				StateFunc:    normalizeJson,
+			},
+
+			"output": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceArmResourceCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).Resource().ResourcesByIDClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id := resourceSvc.GenericResourceID{
+		ParentID: d.Get("parent_id").(string),
+		Type:     d.Get("type").(string),
+		Name:     d.Get("name").(string),
+	}
+	resourceId, err := id.ID()
+	if err != nil {
+		return err
+	}
+	apiVersion := d.Get("api_version").(string)
+
+	if meta.(*ArmClient).features.ExistingResources.RequireImport && d.IsNewResource() {
+		existing, err := client.GetByID(ctx, resourceId, apiVersion)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for presence of existing Resource %q: %+v", resourceId, err)
+			}
+		}
+
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return tf.ImportAsExistsError("azurerm_resource", resourceId)
+		}
+	}
+
+	body, err := expandGenericResourceBody(d.Get("body").(string))
+	if err != nil {
+		return err
+	}
+
+	future, err := client.CreateOrUpdateByID(ctx, resourceId, apiVersion, body)
+	if err != nil {
+		return fmt.Errorf("Error creating/updating Resource %q: %+v", resourceId, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for creation/update of Resource %q: %+v", resourceId, err)
+	}
+
+	d.SetId(resourceId)
+
+	return resourceArmResourceRead(d, meta)
+}
+
+func resourceArmResourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).Resource()
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := resourceSvc.ParseGenericResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	// the API Version isn't available when importing, so the latest supported by the Resource Type is used
+	apiVersion := d.Get("api_version").(string)
+	if apiVersion == "" {
+		apiVersion, err = resourceSvc.ResolveAPIVersion(ctx, client.ProvidersClient, d.Id())
+		if err != nil {
+			return err
+		}
+	}
+
+	resp, err := client.ResourcesByIDClient.GetByID(ctx, d.Id(), apiVersion)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Resource %q was not found - removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Resource %q: %+v", d.Id(), err)
+	}
+
+	d.Set("name", id.Name)
+	d.Set("parent_id", id.ParentID)
+	d.Set("type", id.Type)
+	d.Set("api_version", apiVersion)
+
+	body, err := flattenGenericResourceBody(d.Get("body").(string), resp.Body)
+	if err != nil {
+		return err
+	}
+	d.Set("body", body)
+
+	output, err := json.Marshal(resp.Body)
+	if err != nil {
+		return fmt.Errorf("Error serializing `output`: %+v", err)
+	}
+	d.Set("output", string(output))
+
+	return nil
+}
+
+func resourceArmResourceDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).Resource().ResourcesByIDClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	if _, err := resourceSvc.ParseGenericResourceID(d.Id()); err != nil {
+		return err
+	}
+
+	future, err := client.DeleteByID(ctx, d.Id(), d.Get("api_version").(string))
+	if err != nil {
+		return fmt.Errorf("Error deleting Resource %q: %+v", d.Id(), err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for deletion of Resource %q: %+v", d.Id(), err)
+	}
+
+	return nil
+}
+
+func validateGenericResourceType(i interface{}, k string) (warnings []string, errors []error) {
+	return validation.StringMatch(
+		regexp.MustCompile(`^[^/\s]+\.[^/\s]+(/[^/\s]+)+$`),
+		"must be in the format `{namespace}/{type}`, for example `Microsoft.Network/virtualNetworks`",
+	)(i, k)
+}
+
+func expandGenericResourceBody(input string) (map[string]interface{}, error) {
+	var body map[string]interface{}
+	if err := json.Unmarshal([]byte(input), &body); err != nil {
+		return nil, fmt.Errorf("Error expanding `body`: %+v", err)
+	}
+	return body, nil
+}
+
+// flattenGenericResourceBody returns the `body` for the Resource returned from the API. Since the API returns properties
+// which weren't specified (such as defaults and read-only properties) only those properties in the `existing` body are
+// retained - and those which the API doesn't return (such as secrets) keep their existing value
+func flattenGenericResourceBody(existing string, input map[string]interface{}) (string, error) {
+	var output interface{}
+
+	if existing == "" {
+		// when importing there's no existing body, so everything except the top-level read-only properties is used
+		body := make(map[string]interface{})
+		for k, v := range input {
+			switch strings.ToLower(k) {
+			case "id", "name", "type", "etag":
+				continue
+			}
+			body[k] = v
+		}
+		output = body
+	} else {
+		existingBody, err := expandGenericResourceBody(existing)
+		if err != nil {
+			return "", err
+		}
+
+		// the API returns the Location in its normalized form
+		if location, ok := existingBody["location"].(string); ok {
+			if actual, ok := input["location"].(string); ok && azure.NormalizeLocation(location) == azure.NormalizeLocation(actual) {
+				input["location"] = location
+			}
+		}
+
+		output = pruneGenericResourceBody(existingBody, input)
+	}
+
+	body, err := json.Marshal(output)
+	if err != nil {
+		return "", fmt.Errorf("Error serializing `body`: %+v", err)
+	}
+
+	return string(body), nil
+}
+
+func pruneGenericResourceBody(existing interface{}, actual interface{}) interface{} {
+	switch existingValue := existing.(type) {
+	case map[string]interface{}:
+		actualValue, ok := actual.(map[string]interface{})
+		if !ok {
+			return actual
+		}
+
+		output := make(map[string]interface{})
+		for key, value := range existingValue {
+			output[key] = value
+
+			for actualKey, v := range actualValue {
+				if strings.EqualFold(key, actualKey) {
+					output[key] = pruneGenericResourceBody(value, v)
+					break
+				}
+			}
+		}
+		return output
+
+	case []interface{}:
+		actualValue, ok := actual.([]interface{})
+		if !ok || len(actualValue) != len(existingValue) {
+			return actual
+		}
+
+		output := make([]interface{}, 0)
+		for i, value := range existingValue {
+			output = append(output, pruneGenericResourceBody(value, actualValue[i]))
+		}
+		return output
+	}
+
+	return actual
+}
@@ -0,0 +1,320 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestGenericResourceBody_flatten(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Existing string
+		Input    map[string]interface{}
+		Expected string
+	}{
+		{
+			Name:     "Import",
+			Existing: "",
+			Input: map[string]interface{}{
+				"id":       "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1",
+				"name":     "network1",
+				"type":     "Microsoft.Network/virtualNetworks",
+				"etag":     "W/\"abc\"",
+				"location": "westeurope",
+				"properties": map[string]interface{}{
+					"provisioningState": "Succeeded",
+				},
+			},
+			Expected: `{"location":"westeurope","properties":{"provisioningState":"Succeeded"}}`,
+		},
+		{
+			Name:     "Computed and Read-Only Properties are ignored",
+			Existing: `{"location":"West Europe","properties":{"addressSpace":{"addressPrefixes":["10.0.0.0/16"]}}}`,
+			Input: map[string]interface{}{
+				"id":       "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1",
+				"location": "westeurope",
+				"properties": map[string]interface{}{
+					"provisioningState": "Succeeded",
+					"addressSpace": map[string]interface{}{
+						"addressPrefixes": []interface{}{"10.0.0.0/16"},
+					},
+				},
+			},
+			Expected: `{"location":"West Europe","properties":{"addressSpace":{"addressPrefixes":["10.0.0.0/16"]}}}`,
+		},
+		{
+			Name:     "Changed Properties are detected",
+			Existing: `{"location":"westeurope","properties":{"addressSpace":{"addressPrefixes":["10.0.0.0/16"]}}}`,
+			Input: map[string]interface{}{
+				"location": "eastus",
+				"properties": map[string]interface{}{
+					"addressSpace": map[string]interface{}{
+						"addressPrefixes": []interface{}{"10.0.0.0/16", "10.1.0.0/16"},
+					},
+				},
+			},
+			Expected: `{"location":"eastus","properties":{"addressSpace":{"addressPrefixes":["10.0.0.0/16","10.1.0.0/16"]}}}`,
+		},
+		{
+			Name:     "Properties which aren't returned keep their existing value",
+			Existing: `{"properties":{"Password":"secret","subnets":[{"name":"first","properties":{"addressPrefix":"10.0.1.0/24"}}]}}`,
+			Input: map[string]interface{}{
+				"properties": map[string]interface{}{
+					"subnets": []interface{}{
+						map[string]interface{}{
+							"id":   "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1/subnets/first",
+							"name": "first",
+							"properties": map[string]interface{}{
+								"addressPrefix": "10.0.1.0/24",
+							},
+						},
+					},
+				},
+			},
+			Expected: `{"properties":{"Password":"secret","subnets":[{"name":"first","properties":{"addressPrefix":"10.0.1.0/24"}}]}}`,
+		},
+		{
+			Name:     "Keys are matched case-insensitively",
+			Existing: `{"Properties":{"EnableDdosProtection":false}}`,
+			Input: map[string]interface{}{
+				"properties": map[string]interface{}{
+					"enableDdosProtection": true,
+				},
+			},
+			Expected: `{"Properties":{"EnableDdosProtection":true}}`,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		actual, err := flattenGenericResourceBody(v.Existing, v.Input)
+		if err != nil {
+			t.Fatalf("Expected no error but got: %+v", err)
+		}
+
+		if actual != v.Expected {
+			t.Fatalf("Expected %q but got %q", v.Expected, actual)
+		}
+	}
+}
+
+func TestAccAzureRMResource_basic(t *testing.T) {
+	resourceName := "azurerm_resource.test"
+	ri := tf.AccRandTimeInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMResourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMResource_basic(ri, testLocation(), "10.0.0.0/16"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMResourceExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "output"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"api_version",
+					"body",
+				},
+			},
+		},
+	})
+}
+
+func TestAccAzureRMResource_update(t *testing.T) {
+	resourceName := "azurerm_resource.test"
+	ri := tf.AccRandTimeInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMResourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMResource_basic(ri, testLocation(), "10.0.0.0/16"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMResourceExists(resourceName),
+				),
+			},
+			{
+				Config: testAccAzureRMResource_basic(ri, testLocation(), "10.1.0.0/16"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMResourceExists(resourceName),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMResource_requiresImport(t *testing.T) {
+	if !requireResourcesToBeImported {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_resource.test"
+	ri := tf.AccRandTimeInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMResourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMResource_basic(ri, testLocation(), "10.0.0.0/16"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMResourceExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMResource_requiresImport(ri, testLocation()),
+				ExpectError: testRequiresImportError("azurerm_resource"),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMResource_childResource(t *testing.T) {
+	resourceName := "azurerm_resource.subnet"
+	ri := tf.AccRandTimeInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMResourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMResource_childResource(ri, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMResourceExists(resourceName),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMResourceExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).Resource().ResourcesByIDClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.GetByID(ctx, rs.Primary.ID, rs.Primary.Attributes["api_version"])
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Resource %q does not exist", rs.Primary.ID)
+			}
+
+			return fmt.Errorf("Bad: GetByID on resourcesByIDClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMResourceDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).Resource().ResourcesByIDClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_resource" {
+			continue
+		}
+
+		resp, err := client.GetByID(ctx, rs.Primary.ID, rs.Primary.Attributes["api_version"])
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Resource still exists:\n%#v", resp.Body)
+	}
+
+	return nil
+}
+
+func testAccAzureRMResource_basic(rInt int, location string, addressPrefix string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_resource" "test" {
+  name        = "acctestvirtnet%d"
+  parent_id   = "${azurerm_resource_group.test.id}"
+  type        = "Microsoft.Network/virtualNetworks"
+  api_version = "2019-09-01"
+
+  body = <<BODY
+{
+  "location": "${azurerm_resource_group.test.location}",
+  "properties": {
+    "addressSpace": {
+      "addressPrefixes": [
+        "%s"
+      ]
+    }
+  }
+}
+BODY
+}
+`, rInt, location, rInt, addressPrefix)
+}
+
+func testAccAzureRMResource_requiresImport(rInt int, location string) string {
+	template := testAccAzureRMResource_basic(rInt, location, "10.0.0.0/16")
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_resource" "import" {
+  name        = "${azurerm_resource.test.name}"
+  parent_id   = "${azurerm_resource.test.parent_id}"
+  type        = "${azurerm_resource.test.type}"
+  api_version = "${azurerm_resource.test.api_version}"
+  body        = "${azurerm_resource.test.body}"
+}
+`, template)
+}
+
+func testAccAzureRMResource_childResource(rInt int, location string) string {
+	template := testAccAzureRMResource_basic(rInt, location, "10.0.0.0/16")
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_resource" "subnet" {
+  name        = "acctestsubnet%d"
+  parent_id   = "${azurerm_resource.test.id}"
+  type        = "Microsoft.Network/virtualNetworks/subnets"
+  api_version = "2019-09-01"
+
+  body = <<BODY
+{
+  "properties": {
+    "addressPrefix": "10.0.2.0/24"
+  }
+}
+BODY
+}
+`, template, rInt)
+}
@@ -129,7 +129,7 @@ func testAccAzureRMSecurityCenterContact_update(t *testing.T) {
 
 func testCheckAzureRMSecurityCenterContactExists(resourceName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		client := testAccProvider.Meta().(*ArmClient).securityCenterContactsClient
+		client := testAccProvider.Meta().(*ArmClient).SecurityCenter().ContactsClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		rs, ok := s.RootModule().Resources[resourceName]
@@ -153,7 +153,7 @@ func testCheckAzureRMSecurityCenterContactExists(resourceName string) resource.T
 }
 
 func testCheckAzureRMSecurityCenterContactDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*ArmClient).securityCenterContactsClient
+	client := testAccProvider.Meta().(*ArmClient).SecurityCenter().ContactsClient
 	ctx := testAccProvider.Meta().(*ArmClient).StopContext
 	for _, res := range s.RootModule().Resources {
 		if res.Type != "azurerm_security_center_contact" {
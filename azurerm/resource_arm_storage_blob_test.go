@@ -10,12 +10,68 @@ import (
 	"strings"
 
 	"github.com/Azure/azure-sdk-for-go/storage"
+	azauto "github.com/Azure/go-autorest/autorest/azure"
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 )
 
+func TestParseStorageBlobID(t *testing.T) {
+	testData := []struct {
+		Name        string
+		Input       string
+		Environment azauto.Environment
+		Expected    *storageBlobId
+	}{
+		{
+			Name:        "Public Cloud",
+			Input:       "https://example.blob.core.windows.net/container/blob.vhd",
+			Environment: azauto.PublicCloud,
+			Expected: &storageBlobId{
+				storageAccountName: "example",
+				containerName:      "container",
+				blobName:           "blob.vhd",
+			},
+		},
+		{
+			Name:        "Nested Blob",
+			Input:       "https://example.blob.core.chinacloudapi.cn/container/nested/blob.vhd",
+			Environment: azauto.ChinaCloud,
+			Expected: &storageBlobId{
+				storageAccountName: "example",
+				containerName:      "container",
+				blobName:           "nested/blob.vhd",
+			},
+		},
+		{
+			Name:  "Azure Stack",
+			Input: "https://example.blob.local.azurestack.external/container/blob.vhd",
+			Environment: azauto.Environment{
+				StorageEndpointSuffix: "local.azurestack.external",
+			},
+			Expected: &storageBlobId{
+				storageAccountName: "example",
+				containerName:      "container",
+				blobName:           "blob.vhd",
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		actual, err := parseStorageBlobID(v.Input, v.Environment)
+		if err != nil {
+			t.Fatalf("Expected no error but got: %+v", err)
+		}
+
+		if *actual != *v.Expected {
+			t.Fatalf("Expected %+v but got %+v", *v.Expected, *actual)
+		}
+	}
+}
+
 func TestAccAzureRMStorageBlob_basic(t *testing.T) {
 	resourceName := "azurerm_storage_blob.test"
 	ri := tf.AccRandTimeInt()
@@ -0,0 +1,203 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2018-05-01/resources"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmSubscriptionTemplateDeployment() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmSubscriptionTemplateDeploymentCreateUpdate,
+		Read:   resourceArmSubscriptionTemplateDeploymentRead,
+		Update: resourceArmSubscriptionTemplateDeploymentCreateUpdate,
+		Delete: resourceArmSubscriptionTemplateDeploymentDelete,
+
+		Importer: azure.ValidateResourceIDPriorToImport(azure.ValidateSubscriptionTemplateDeploymentID),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(180 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(180 * time.Minute),
+			Delete: schema.DefaultTimeout(180 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			// the location the deployment data is stored in, rather than where resources are deployed
+			"location": azure.SchemaLocation(),
+
+			"template_body": {
+				Type:      schema.TypeString,
+				Optional:  true,
+				Computed:  true,
+				StateFunc: normalizeJson,
+			},
+
+			"parameters": {
+				Type:          schema.TypeMap,
+				Optional:      true,
+				ConflictsWith: []string{"parameters_body"},
+			},
+
+			"parameters_body": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				StateFunc:     normalizeJson,
+				ConflictsWith: []string{"parameters"},
+			},
+
+			"outputs": {
+				Type:     schema.TypeMap,
+				Computed: true,
+			},
+
+			"outputs_json": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"sensitive_outputs_json": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func resourceArmSubscriptionTemplateDeploymentCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient)
+	deployClient := client.Resource().DeploymentsClient
+	ctx, cancel := timeouts.ForCreateUpdate(client.StopContext, d)
+	defer cancel()
+
+	name := d.Get("name").(string)
+
+	if client.features.ExistingResources.RequireImport && d.IsNewResource() {
+		existing, err := deployClient.GetAtSubscriptionScope(ctx, name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for presence of existing Subscription Template Deployment %q: %+v", name, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_subscription_template_deployment", *existing.ID)
+		}
+	}
+
+	log.Printf("[INFO] preparing arguments for AzureRM Subscription Template Deployment creation.")
+	// Deployments scoped to a Subscription only support the Incremental mode
+	properties := resources.DeploymentProperties{
+		Mode: resources.Incremental,
+	}
+
+	parameters, err := expandTemplateDeploymentParameters(d)
+	if err != nil {
+		return err
+	}
+	properties.Parameters = parameters
+
+	if v, ok := d.GetOk("template_body"); ok {
+		template, err := expandTemplateBody(v.(string))
+		if err != nil {
+			return err
+		}
+
+		properties.Template = &template
+	}
+
+	deployment := resources.Deployment{
+		Location:   utils.String(azure.NormalizeLocation(d.Get("location"))),
+		Properties: &properties,
+	}
+
+	future, err := deployClient.CreateOrUpdateAtSubscriptionScope(ctx, name, deployment)
+	if err != nil {
+		return fmt.Errorf("Error creating Subscription Template Deployment %q: %+v", name, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, deployClient.Client); err != nil {
+		return fmt.Errorf("Error waiting for Subscription Template Deployment %q: %+v", name, err)
+	}
+
+	read, err := deployClient.GetAtSubscriptionScope(ctx, name)
+	if err != nil {
+		return err
+	}
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read Subscription Template Deployment %q ID", name)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmSubscriptionTemplateDeploymentRead(d, meta)
+}
+
+func resourceArmSubscriptionTemplateDeploymentRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient)
+	deployClient := client.Resource().DeploymentsClient
+	ctx, cancel := timeouts.ForRead(client.StopContext, d)
+	defer cancel()
+
+	id, err := azure.ParseSubscriptionTemplateDeploymentID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := deployClient.GetAtSubscriptionScope(ctx, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Subscription Template Deployment %q was not found - removing from state", id.Name)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error making Read request on Subscription Template Deployment %q: %+v", id.Name, err)
+	}
+
+	d.Set("name", resp.Name)
+	if location := resp.Location; location != nil {
+		d.Set("location", azure.NormalizeLocation(*location))
+	}
+
+	var outputs interface{}
+	if props := resp.Properties; props != nil {
+		outputs = props.Outputs
+	}
+
+	return setTemplateDeploymentOutputs(d, outputs)
+}
+
+func resourceArmSubscriptionTemplateDeploymentDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient)
+	deployClient := client.Resource().DeploymentsClient
+	ctx, cancel := timeouts.ForDelete(client.StopContext, d)
+	defer cancel()
+
+	id, err := azure.ParseSubscriptionTemplateDeploymentID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if _, err = deployClient.DeleteAtSubscriptionScope(ctx, id.Name); err != nil {
+		return fmt.Errorf("Error deleting Subscription Template Deployment %q: %+v", id.Name, err)
+	}
+
+	description := fmt.Sprintf("Subscription Template Deployment %q", id.Name)
+	return waitForTemplateDeploymentToBeGone(ctx, description, d.Timeout(schema.TimeoutDelete), func() (resources.DeploymentExtended, error) {
+		return deployClient.GetAtSubscriptionScope(ctx, id.Name)
+	})
+}
@@ -0,0 +1,209 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMSubscriptionTemplateDeployment_basic(t *testing.T) {
+	resourceName := "azurerm_subscription_template_deployment.test"
+	ri := tf.AccRandTimeInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMSubscriptionTemplateDeploymentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMSubscriptionTemplateDeployment_basic(ri, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSubscriptionTemplateDeploymentExists(resourceName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"parameters",
+					"template_body",
+				},
+			},
+		},
+	})
+}
+
+func TestAccAzureRMSubscriptionTemplateDeployment_requiresImport(t *testing.T) {
+	if !requireResourcesToBeImported {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_subscription_template_deployment.test"
+	ri := tf.AccRandTimeInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMSubscriptionTemplateDeploymentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMSubscriptionTemplateDeployment_basic(ri, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSubscriptionTemplateDeploymentExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMSubscriptionTemplateDeployment_requiresImport(ri, testLocation()),
+				ExpectError: testRequiresImportError("azurerm_subscription_template_deployment"),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMSubscriptionTemplateDeployment_withOutputs(t *testing.T) {
+	resourceName := "azurerm_subscription_template_deployment.test"
+	ri := tf.AccRandTimeInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMSubscriptionTemplateDeploymentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMSubscriptionTemplateDeployment_basic(ri, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSubscriptionTemplateDeploymentExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "outputs.testOutput", "first"),
+				),
+			},
+			{
+				Config: testAccAzureRMSubscriptionTemplateDeployment_updatedParameters(ri, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSubscriptionTemplateDeploymentExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "outputs.testOutput", "second"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMSubscriptionTemplateDeploymentExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		id, err := azure.ParseSubscriptionTemplateDeploymentID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).Resource().DeploymentsClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.GetAtSubscriptionScope(ctx, id.Name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Subscription Template Deployment %q does not exist", id.Name)
+			}
+
+			return fmt.Errorf("Bad: Get on deploymentsClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMSubscriptionTemplateDeploymentDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).Resource().DeploymentsClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_subscription_template_deployment" {
+			continue
+		}
+
+		id, err := azure.ParseSubscriptionTemplateDeploymentID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		resp, err := client.GetAtSubscriptionScope(ctx, id.Name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Subscription Template Deployment still exists:\n%#v", resp.Properties)
+	}
+
+	return nil
+}
+
+func testAccAzureRMSubscriptionTemplateDeployment_basic(rInt int, location string) string {
+	return testAccAzureRMSubscriptionTemplateDeployment_template(rInt, location, "first")
+}
+
+func testAccAzureRMSubscriptionTemplateDeployment_updatedParameters(rInt int, location string) string {
+	return testAccAzureRMSubscriptionTemplateDeployment_template(rInt, location, "second")
+}
+
+func testAccAzureRMSubscriptionTemplateDeployment_requiresImport(rInt int, location string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_subscription_template_deployment" "import" {
+  name          = "${azurerm_subscription_template_deployment.test.name}"
+  location      = "${azurerm_subscription_template_deployment.test.location}"
+  template_body = "${azurerm_subscription_template_deployment.test.template_body}"
+
+  parameters = {
+    "testValue" = "first"
+  }
+}
+`, testAccAzureRMSubscriptionTemplateDeployment_basic(rInt, location))
+}
+
+func testAccAzureRMSubscriptionTemplateDeployment_template(rInt int, location string, value string) string {
+	return fmt.Sprintf(`
+resource "azurerm_subscription_template_deployment" "test" {
+  name     = "acctestsubdeploy-%d"
+  location = "%s"
+
+  template_body = <<DEPLOY
+{
+  "$schema": "https://schema.management.azure.com/schemas/2018-05-01/subscriptionDeploymentTemplate.json#",
+  "contentVersion": "1.0.0.0",
+  "parameters": {
+    "testValue": {
+      "type": "string"
+    }
+  },
+  "resources": [],
+  "outputs": {
+    "testOutput": {
+      "type": "string",
+      "value": "[parameters('testValue')]"
+    }
+  }
+}
+DEPLOY
+
+  parameters = {
+    "testValue" = "%s"
+  }
+}
+`, rInt, location, value)
+}
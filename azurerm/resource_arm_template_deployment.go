@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"log"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -15,6 +16,9 @@ import (
 	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	resourceSvc "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/resource"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -25,6 +29,12 @@ func resourceArmTemplateDeployment() *schema.Resource {
 		Update: resourceArmTemplateDeploymentCreateUpdate,
 		Delete: resourceArmTemplateDeploymentDelete,
 
+		Importer: azure.ValidateResourceIDPriorToImport(azure.ValidateTemplateDeploymentID),
+
+		CustomizeDiff: resourceArmTemplateDeploymentCustomizeDiff,
+
+		Timeouts: resourceArmTemplateDeploymentTimeouts(),
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -35,23 +45,68 @@ func resourceArmTemplateDeployment() *schema.Resource {
 			"resource_group_name": azure.SchemaResourceGroupName(),
 
 			"template_body": {
-				Type:      schema.TypeString,
-				Optional:  true,
-				Computed:  true,
-				StateFunc: normalizeJson,
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				StateFunc:     normalizeJson,
+				ConflictsWith: []string{"template_link"},
+			},
+
+			"template_link": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"template_body"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"uri": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.URLIsHTTPOrHTTPS,
+						},
+
+						"content_version": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+					},
+				},
 			},
 
 			"parameters": {
 				Type:          schema.TypeMap,
 				Optional:      true,
-				ConflictsWith: []string{"parameters_body"},
+				ConflictsWith: []string{"parameters_body", "parameters_link"},
 			},
 
 			"parameters_body": {
 				Type:          schema.TypeString,
 				Optional:      true,
 				StateFunc:     normalizeJson,
-				ConflictsWith: []string{"parameters"},
+				ConflictsWith: []string{"parameters", "parameters_link"},
+			},
+
+			"parameters_link": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"parameters", "parameters_body"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"uri": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.URLIsHTTPOrHTTPS,
+						},
+
+						"content_version": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+					},
+				},
 			},
 
 			"deployment_mode": {
@@ -64,24 +119,96 @@ func resourceArmTemplateDeployment() *schema.Resource {
 				DiffSuppressFunc: ignoreCaseDiffSuppressFunc,
 			},
 
+			"what_if_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"what_if_changes": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"resource_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"change_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"pre_existing_resource_ids": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+
 			"outputs": {
 				Type:     schema.TypeMap,
 				Computed: true,
 			},
+
+			"outputs_json": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"sensitive_outputs_json": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
 		},
 	}
 }
 
+func resourceArmTemplateDeploymentTimeouts() *schema.ResourceTimeout {
+	return &schema.ResourceTimeout{
+		Create: schema.DefaultTimeout(180 * time.Minute),
+		Read:   schema.DefaultTimeout(5 * time.Minute),
+		Update: schema.DefaultTimeout(180 * time.Minute),
+		Delete: schema.DefaultTimeout(180 * time.Minute),
+	}
+}
+
+// templateDeploymentFields are the fields which define the Template Deployment - a change to any of which requires
+// the Template to be (re)deployed
+var templateDeploymentFields = []string{"name", "resource_group_name", "deployment_mode", "template_body", "template_link", "parameters", "parameters_body", "parameters_link"}
+
+// templateDeploymentHasChanges returns whether any of the fields which define the Template Deployment have changed
+func templateDeploymentHasChanges(d interface{ HasChange(key string) bool }) bool {
+	for _, field := range templateDeploymentFields {
+		if d.HasChange(field) {
+			return true
+		}
+	}
+
+	return false
+}
+
 func resourceArmTemplateDeploymentCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient)
-	deployClient := client.deploymentsClient
-	ctx := client.StopContext
+	deployClient := client.Resource().DeploymentsClient
+	ctx, cancel := timeouts.ForCreateUpdate(client.StopContext, d)
+	defer cancel()
+
+	// toggling `what_if_enabled` only affects the plan, so there's nothing to deploy
+	if !d.IsNewResource() && !templateDeploymentHasChanges(d) {
+		log.Printf("[DEBUG] Only the plan-time settings of Template Deployment %q have changed - skipping deployment", d.Id())
+		return resourceArmTemplateDeploymentRead(d, meta)
+	}
 
 	name := d.Get("name").(string)
 	resourceGroup := d.Get("resource_group_name").(string)
-	deploymentMode := d.Get("deployment_mode").(string)
 
-	if requireResourcesToBeImported && d.IsNewResource() {
+	if meta.(*ArmClient).features.ExistingResources.RequireImport && d.IsNewResource() {
 		existing, err := deployClient.Get(ctx, resourceGroup, name)
 		if err != nil {
 			if !utils.ResponseWasNotFound(existing.Response) {
@@ -95,45 +222,27 @@ func resourceArmTemplateDeploymentCreateUpdate(d *schema.ResourceData, meta inte
 	}
 
 	log.Printf("[INFO] preparing arguments for AzureRM Template Deployment creation.")
-	properties := resources.DeploymentProperties{
-		Mode: resources.DeploymentMode(deploymentMode),
-	}
-
-	if v, ok := d.GetOk("parameters"); ok {
-		params := v.(map[string]interface{})
-
-		newParams := make(map[string]interface{}, len(params))
-		for key, val := range params {
-			newParams[key] = struct {
-				Value interface{}
-			}{
-				Value: val,
-			}
-		}
-
-		properties.Parameters = &newParams
+	properties, err := expandTemplateDeploymentProperties(d)
+	if err != nil {
+		return err
 	}
 
-	if v, ok := d.GetOk("parameters_body"); ok {
-		params, err := expandParametersBody(v.(string))
-		if err != nil {
-			return err
-		}
-
-		properties.Parameters = &params
+	deployment := resources.Deployment{
+		Properties: properties,
 	}
 
-	if v, ok := d.GetOk("template_body"); ok {
-		template, err := expandTemplateBody(v.(string))
-		if err != nil {
-			return err
+	// Azure reports every resource targeted by the Template as being created by it, so the resources which already
+	// exist are recorded prior to deploying, to ensure these aren't deleted alongside the Template Deployment
+	existingPreExistingIds := *utils.ExpandStringSlice(d.Get("pre_existing_resource_ids").(*schema.Set).List())
+	preExistingIds, err := listTemplateDeploymentPreExistingItems(ctx, client.Resource(), resourceGroup, name, deployment, d.IsNewResource(), existingPreExistingIds)
+	if err != nil {
+		if client.features.TemplateDeployment.DeleteNestedItemsDuringDeletion {
+			return fmt.Errorf("Error determining the pre-existing resources for Template Deployment %q (Resource Group %q): %+v", name, resourceGroup, err)
 		}
 
-		properties.Template = &template
-	}
-
-	deployment := resources.Deployment{
-		Properties: &properties,
+		// these are only used when deleting the resources provisioned by the Template Deployment, which isn't enabled
+		log.Printf("[WARN] Unable to determine the pre-existing resources for Template Deployment %q (Resource Group %q): %+v", name, resourceGroup, err)
+		preExistingIds = existingPreExistingIds
 	}
 
 	future, err := deployClient.CreateOrUpdate(ctx, resourceGroup, name, deployment)
@@ -155,23 +264,25 @@ func resourceArmTemplateDeploymentCreateUpdate(d *schema.ResourceData, meta inte
 
 	d.SetId(*read.ID)
 
+	if err := d.Set("pre_existing_resource_ids", preExistingIds); err != nil {
+		return fmt.Errorf("Error setting `pre_existing_resource_ids`: %+v", err)
+	}
+
 	return resourceArmTemplateDeploymentRead(d, meta)
 }
 
 func resourceArmTemplateDeploymentRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient)
-	deployClient := client.deploymentsClient
-	ctx := client.StopContext
+	deployClient := client.Resource().DeploymentsClient
+	ctx, cancel := timeouts.ForRead(client.StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := azure.ParseTemplateDeploymentID(d.Id())
 	if err != nil {
 		return err
 	}
 	resourceGroup := id.ResourceGroup
-	name := id.Path["deployments"]
-	if name == "" {
-		name = id.Path["Deployments"]
-	}
+	name := id.Name
 
 	resp, err := deployClient.Get(ctx, resourceGroup, name)
 	if err != nil {
@@ -182,61 +293,164 @@ func resourceArmTemplateDeploymentRead(d *schema.ResourceData, meta interface{})
 		return fmt.Errorf("Error making Read request on Azure RM Template Deployment %q (Resource Group %q): %+v", name, resourceGroup, err)
 	}
 
-	outputs := make(map[string]string)
-	if outs := resp.Properties.Outputs; outs != nil {
-		outsVal := outs.(map[string]interface{})
-		if len(outsVal) > 0 {
-			for key, output := range outsVal {
-				log.Printf("[DEBUG] Processing deployment output %s", key)
-				outputMap := output.(map[string]interface{})
-				outputValue, ok := outputMap["value"]
-				if !ok {
-					log.Printf("[DEBUG] No value - skipping")
-					continue
-				}
-				outputType, ok := outputMap["type"]
-				if !ok {
-					log.Printf("[DEBUG] No type - skipping")
-					continue
-				}
-
-				var outputValueString string
-				switch strings.ToLower(outputType.(string)) {
-				case "bool":
-					outputValueString = strconv.FormatBool(outputValue.(bool))
-
-				case "string":
-					outputValueString = outputValue.(string)
-
-				case "int":
-					outputValueString = fmt.Sprint(outputValue)
-
-				default:
-					log.Printf("[WARN] Ignoring output %s: Outputs of type %s are not currently supported in azurerm_template_deployment.",
-						key, outputType)
-					continue
-				}
-				outputs[key] = outputValueString
+	// a Template Deployment which has just been imported has nothing but an ID in the state
+	importing := d.Get("deployment_mode").(string) == ""
+
+	d.Set("name", name)
+	d.Set("resource_group_name", resourceGroup)
+
+	var outputs interface{}
+	if props := resp.Properties; props != nil {
+		d.Set("deployment_mode", string(props.Mode))
+		outputs = props.Outputs
+
+		if err := d.Set("template_link", flattenTemplateDeploymentTemplateLink(props.TemplateLink)); err != nil {
+			return fmt.Errorf("Error setting `template_link`: %+v", err)
+		}
+
+		if err := d.Set("parameters_link", flattenTemplateDeploymentParametersLink(props.ParametersLink)); err != nil {
+			return fmt.Errorf("Error setting `parameters_link`: %+v", err)
+		}
+
+		// only the parameters defined within `parameters` or `parameters_body` are read back, since the API also
+		// returns those parameters which use a default value
+		if existing := d.Get("parameters").(map[string]interface{}); len(existing) > 0 && props.ParametersLink == nil {
+			if err := d.Set("parameters", flattenTemplateDeploymentParameters(existing, props.Parameters)); err != nil {
+				return fmt.Errorf("Error setting `parameters`: %+v", err)
+			}
+		}
+
+		if existing := d.Get("parameters_body").(string); existing != "" && props.ParametersLink == nil {
+			parametersBody, err := flattenTemplateDeploymentParametersBody(existing, props.Parameters)
+			if err != nil {
+				return err
 			}
+			d.Set("parameters_body", parametersBody)
+		}
+
+		if importing && props.ParametersLink == nil {
+			if err := d.Set("parameters", flattenTemplateDeploymentDeployedParameters(props.Parameters)); err != nil {
+				return fmt.Errorf("Error setting `parameters`: %+v", err)
+			}
+		}
+	}
+
+	template, err := deployClient.ExportTemplate(ctx, resourceGroup, name)
+	if err != nil {
+		return fmt.Errorf("Error exporting the Template for Template Deployment %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	templateBody, err := json.Marshal(template.Template)
+	if err != nil {
+		return fmt.Errorf("Error serializing the Template for Template Deployment %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+	d.Set("template_body", normalizeJson(string(templateBody)))
+
+	return setTemplateDeploymentOutputs(d, outputs)
+}
+
+func resourceArmTemplateDeploymentCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	// validating the Template (and previewing the changes) requires a request to Azure, so this is only done when
+	// the Template will be deployed - otherwise `what_if_changes` is left as-is, so that it's stable between plans
+	if d.Id() != "" && !templateDeploymentHasChanges(d) {
+		return nil
+	}
+
+	// the pre-existing resources are determined prior to each deployment
+	if d.Id() != "" {
+		if err := d.SetNewComputed("pre_existing_resource_ids"); err != nil {
+			return err
+		}
+	}
+
+	// these values may be interpolated from other resources, in which case they're not known until apply
+	for _, field := range templateDeploymentFields {
+		if !d.NewValueKnown(field) {
+			log.Printf("[DEBUG] Unable to validate Template Deployment since %q isn't known until apply", field)
+			return setTemplateDeploymentWhatIfChangesComputed(d)
+		}
+	}
+
+	// the `timeouts` block isn't available during the plan, so the default Create/Update timeout is used
+	timeout := resourceArmTemplateDeploymentTimeouts().Create
+	if d.Id() != "" {
+		timeout = resourceArmTemplateDeploymentTimeouts().Update
+	}
+
+	client := meta.(*ArmClient)
+	ctx, cancel := context.WithTimeout(client.StopContext, *timeout)
+	defer cancel()
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+
+	properties, err := expandTemplateDeploymentProperties(d)
+	if err != nil {
+		return err
+	}
+	deployment := resources.Deployment{
+		Properties: properties,
+	}
+
+	validation, err := client.Resource().DeploymentsClient.Validate(ctx, resourceGroup, name, deployment)
+	if err != nil {
+		// the Resource Group may not exist until apply
+		if utils.ResponseWasNotFound(validation.Response) {
+			log.Printf("[DEBUG] Unable to validate Template Deployment %q since Resource Group %q wasn't found", name, resourceGroup)
+			return setTemplateDeploymentWhatIfChangesComputed(d)
 		}
+
+		return fmt.Errorf("Error validating Template Deployment %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+	if validation.Error != nil {
+		return fmt.Errorf("Error validating Template Deployment %q (Resource Group %q): %s", name, resourceGroup, flattenTemplateDeploymentError(validation.Error))
+	}
+
+	if !d.Get("what_if_enabled").(bool) {
+		return d.SetNew("what_if_changes", []interface{}{})
+	}
+
+	// What-If isn't available in every Azure Environment, so the changes are left unknown if it's unavailable
+	whatIfClient := client.Resource().DeploymentsWhatIfClient
+	future, err := whatIfClient.WhatIf(ctx, resourceGroup, name, deployment)
+	if err != nil {
+		log.Printf("[WARN] Unable to preview the changes for Template Deployment %q (Resource Group %q): %+v", name, resourceGroup, err)
+		return setTemplateDeploymentWhatIfChangesComputed(d)
+	}
+	if err := future.WaitForCompletionRef(ctx, whatIfClient.Client); err != nil {
+		log.Printf("[WARN] Unable to preview the changes for Template Deployment %q (Resource Group %q): %+v", name, resourceGroup, err)
+		return setTemplateDeploymentWhatIfChangesComputed(d)
+	}
+	result, err := future.Result(whatIfClient)
+	if err != nil {
+		log.Printf("[WARN] Unable to preview the changes for Template Deployment %q (Resource Group %q): %+v", name, resourceGroup, err)
+		return setTemplateDeploymentWhatIfChangesComputed(d)
+	}
+	if result.Error != nil {
+		return fmt.Errorf("Error previewing the changes for Template Deployment %q (Resource Group %q): %s", name, resourceGroup, flattenTemplateDeploymentError(result.Error))
 	}
 
-	return d.Set("outputs", outputs)
+	return d.SetNew("what_if_changes", flattenTemplateDeploymentWhatIfChanges(result.Properties))
 }
 
 func resourceArmTemplateDeploymentDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient)
-	deployClient := client.deploymentsClient
-	ctx := client.StopContext
+	deployClient := client.Resource().DeploymentsClient
+	ctx, cancel := timeouts.ForDelete(client.StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := azure.ParseTemplateDeploymentID(d.Id())
 	if err != nil {
 		return err
 	}
 	resourceGroup := id.ResourceGroup
-	name := id.Path["deployments"]
-	if name == "" {
-		name = id.Path["Deployments"]
+	name := id.Name
+
+	if client.features.TemplateDeployment.DeleteNestedItemsDuringDeletion {
+		preExistingIds := *utils.ExpandStringSlice(d.Get("pre_existing_resource_ids").(*schema.Set).List())
+		if err := deleteTemplateDeploymentNestedItems(ctx, client.Resource(), resourceGroup, name, preExistingIds); err != nil {
+			return err
+		}
 	}
 
 	_, err = deployClient.Delete(ctx, resourceGroup, name)
@@ -244,7 +458,428 @@ func resourceArmTemplateDeploymentDelete(d *schema.ResourceData, meta interface{
 		return err
 	}
 
-	return waitForTemplateDeploymentToBeDeleted(ctx, deployClient, resourceGroup, name)
+	return waitForTemplateDeploymentToBeDeleted(ctx, deployClient, resourceGroup, name, d.Timeout(schema.TimeoutDelete))
+}
+
+func setTemplateDeploymentWhatIfChangesComputed(d *schema.ResourceDiff) error {
+	if !d.Get("what_if_enabled").(bool) {
+		return nil
+	}
+
+	return d.SetNewComputed("what_if_changes")
+}
+
+func flattenTemplateDeploymentWhatIfChanges(input *resourceSvc.WhatIfOperationProperties) []interface{} {
+	output := make([]interface{}, 0)
+	if input == nil || input.Changes == nil {
+		return output
+	}
+
+	for _, v := range *input.Changes {
+		if v.ResourceID == nil || v.ChangeType == nil {
+			continue
+		}
+
+		// only the resources which would be changed are interesting
+		if strings.EqualFold(*v.ChangeType, "NoChange") || strings.EqualFold(*v.ChangeType, "Ignore") {
+			continue
+		}
+
+		output = append(output, map[string]interface{}{
+			"resource_id": *v.ResourceID,
+			"change_type": *v.ChangeType,
+		})
+	}
+
+	// the changes are returned in no particular order, so these are sorted to keep the plan stable
+	sort.SliceStable(output, func(i, j int) bool {
+		first := output[i].(map[string]interface{})
+		second := output[j].(map[string]interface{})
+		return strings.ToLower(first["resource_id"].(string)) < strings.ToLower(second["resource_id"].(string))
+	})
+
+	return output
+}
+
+// flattenTemplateDeploymentError flattens an error returned when validating a Template Deployment, including the
+// details of any nested errors (such as those for an individual resource or parameter)
+func flattenTemplateDeploymentError(input *resources.ManagementErrorWithDetails) string {
+	if input == nil {
+		return ""
+	}
+
+	message := ""
+	if input.Message != nil {
+		message = *input.Message
+	}
+	if input.Code != nil {
+		message = fmt.Sprintf("%s: %s", *input.Code, message)
+	}
+	if input.Target != nil && *input.Target != "" {
+		message = fmt.Sprintf("%s (Target %q)", message, *input.Target)
+	}
+
+	if input.Details != nil {
+		for _, detail := range *input.Details {
+			v := detail
+			message = fmt.Sprintf("%s\n  - %s", message, strings.Replace(flattenTemplateDeploymentError(&v), "\n", "\n  ", -1))
+		}
+	}
+
+	return message
+}
+
+// templateDeploymentResourceData is implemented by both schema.ResourceData and schema.ResourceDiff, allowing a
+// Template Deployment to be expanded both when it's validated during the plan and when it's applied
+type templateDeploymentResourceData interface {
+	Get(key string) interface{}
+	GetOk(key string) (interface{}, bool)
+}
+
+func expandTemplateDeploymentProperties(d templateDeploymentResourceData) (*resources.DeploymentProperties, error) {
+	properties := resources.DeploymentProperties{
+		Mode: resources.DeploymentMode(d.Get("deployment_mode").(string)),
+	}
+
+	if v, ok := d.GetOk("parameters_link"); ok {
+		properties.ParametersLink = expandTemplateDeploymentParametersLink(v.([]interface{}))
+	} else {
+		parameters, err := expandTemplateDeploymentParameters(d)
+		if err != nil {
+			return nil, err
+		}
+		properties.Parameters = parameters
+	}
+
+	// `template_body` is read back from the API, so the link takes precedence when both are present in the state
+	if v, ok := d.GetOk("template_link"); ok {
+		properties.TemplateLink = expandTemplateDeploymentTemplateLink(v.([]interface{}))
+	} else if v, ok := d.GetOk("template_body"); ok {
+		template, err := expandTemplateBody(v.(string))
+		if err != nil {
+			return nil, err
+		}
+
+		properties.Template = &template
+	}
+
+	return &properties, nil
+}
+
+// expandTemplateDeploymentParameters expands the `parameters` or `parameters_body` fields shared by the Template Deployment resources
+func expandTemplateDeploymentParameters(d templateDeploymentResourceData) (*map[string]interface{}, error) {
+	if v, ok := d.GetOk("parameters"); ok {
+		params := v.(map[string]interface{})
+
+		newParams := make(map[string]interface{}, len(params))
+		for key, val := range params {
+			newParams[key] = struct {
+				Value interface{}
+			}{
+				Value: val,
+			}
+		}
+
+		return &newParams, nil
+	}
+
+	if v, ok := d.GetOk("parameters_body"); ok {
+		params, err := expandParametersBody(v.(string))
+		if err != nil {
+			return nil, err
+		}
+
+		return &params, nil
+	}
+
+	return nil, nil
+}
+
+func expandTemplateDeploymentTemplateLink(input []interface{}) *resources.TemplateLink {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+	link := resources.TemplateLink{
+		URI: utils.String(v["uri"].(string)),
+	}
+
+	if contentVersion := v["content_version"].(string); contentVersion != "" {
+		link.ContentVersion = utils.String(contentVersion)
+	}
+
+	return &link
+}
+
+func flattenTemplateDeploymentTemplateLink(input *resources.TemplateLink) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	uri := ""
+	if input.URI != nil {
+		uri = *input.URI
+	}
+
+	contentVersion := ""
+	if input.ContentVersion != nil {
+		contentVersion = *input.ContentVersion
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"uri":             uri,
+			"content_version": contentVersion,
+		},
+	}
+}
+
+func expandTemplateDeploymentParametersLink(input []interface{}) *resources.ParametersLink {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+	link := resources.ParametersLink{
+		URI: utils.String(v["uri"].(string)),
+	}
+
+	if contentVersion := v["content_version"].(string); contentVersion != "" {
+		link.ContentVersion = utils.String(contentVersion)
+	}
+
+	return &link
+}
+
+func flattenTemplateDeploymentParametersLink(input *resources.ParametersLink) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	uri := ""
+	if input.URI != nil {
+		uri = *input.URI
+	}
+
+	contentVersion := ""
+	if input.ContentVersion != nil {
+		contentVersion = *input.ContentVersion
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"uri":             uri,
+			"content_version": contentVersion,
+		},
+	}
+}
+
+// flattenTemplateDeploymentParameters returns the `existing` parameters with the value of each parameter replaced by
+// the value deployed to Azure - secure values (which aren't returned) and values which can't be represented as a
+// string are left as-is
+func flattenTemplateDeploymentParameters(existing map[string]interface{}, input interface{}) map[string]interface{} {
+	output := make(map[string]interface{}, len(existing))
+	for key, value := range existing {
+		output[key] = value
+	}
+
+	deployed, ok := input.(map[string]interface{})
+	if !ok {
+		return output
+	}
+
+	for key := range existing {
+		deployedMap, ok := deployed[key].(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		switch v := deployedMap["value"].(type) {
+		case string:
+			output[key] = v
+		case bool:
+			output[key] = strconv.FormatBool(v)
+		case float64:
+			output[key] = strconv.FormatFloat(v, 'f', -1, 64)
+		}
+	}
+
+	return output
+}
+
+// flattenTemplateDeploymentDeployedParameters returns the parameters deployed to Azure which can be represented within
+// `parameters` - secure parameters (whose values aren't returned) and object/array parameters are omitted
+func flattenTemplateDeploymentDeployedParameters(input interface{}) map[string]interface{} {
+	output := make(map[string]interface{})
+
+	deployed, ok := input.(map[string]interface{})
+	if !ok {
+		return output
+	}
+
+	for key, parameter := range deployed {
+		parameterMap, ok := parameter.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		if parameterType, ok := parameterMap["type"].(string); ok && strings.HasPrefix(strings.ToLower(parameterType), "secure") {
+			continue
+		}
+
+		switch v := parameterMap["value"].(type) {
+		case string:
+			output[key] = v
+		case bool:
+			output[key] = strconv.FormatBool(v)
+		case float64:
+			output[key] = strconv.FormatFloat(v, 'f', -1, 64)
+		}
+	}
+
+	return output
+}
+
+// flattenTemplateDeploymentParametersBody returns the `existing` parameters body with the value of each parameter
+// replaced by the value returned from the API - parameters whose values aren't returned (such as secure parameters and
+// Key Vault references) retain their existing value
+func flattenTemplateDeploymentParametersBody(existing string, input interface{}) (string, error) {
+	parametersBody, err := expandParametersBody(existing)
+	if err != nil {
+		return "", err
+	}
+
+	deployed, ok := input.(map[string]interface{})
+	if !ok {
+		return normalizeJson(existing), nil
+	}
+
+	for key, parameter := range parametersBody {
+		parameterMap, ok := parameter.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if _, ok := parameterMap["value"]; !ok {
+			continue
+		}
+
+		deployedMap, ok := deployed[key].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if value, ok := deployedMap["value"]; ok {
+			parameterMap["value"] = value
+		}
+	}
+
+	b, err := json.Marshal(parametersBody)
+	if err != nil {
+		return "", fmt.Errorf("Error serializing the parameters_body for Azure RM Template Deployment: %+v", err)
+	}
+
+	return string(b), nil
+}
+
+// setTemplateDeploymentOutputs sets the `outputs`, `outputs_json` and `sensitive_outputs_json` fields shared by the
+// Template Deployment resources
+func setTemplateDeploymentOutputs(d *schema.ResourceData, input interface{}) error {
+	outputs, values, sensitiveValues, err := flattenTemplateDeploymentOutputs(input)
+	if err != nil {
+		return err
+	}
+
+	outputsJson, err := json.Marshal(values)
+	if err != nil {
+		return fmt.Errorf("Error serializing `outputs_json`: %+v", err)
+	}
+
+	sensitiveOutputsJson, err := json.Marshal(sensitiveValues)
+	if err != nil {
+		return fmt.Errorf("Error serializing `sensitive_outputs_json`: %+v", err)
+	}
+
+	if err := d.Set("outputs", outputs); err != nil {
+		return fmt.Errorf("Error setting `outputs`: %+v", err)
+	}
+	d.Set("outputs_json", string(outputsJson))
+	d.Set("sensitive_outputs_json", string(sensitiveOutputsJson))
+
+	return nil
+}
+
+// flattenTemplateDeploymentOutputs flattens the outputs of a Template Deployment, returning the scalar outputs converted
+// to strings, the values of all non-secure outputs and the values of the `securestring` and `secureobject` outputs -
+// which are `null` when Azure doesn't return the value
+func flattenTemplateDeploymentOutputs(input interface{}) (map[string]string, map[string]interface{}, map[string]interface{}, error) {
+	outputs := make(map[string]string)
+	values := make(map[string]interface{})
+	sensitiveValues := make(map[string]interface{})
+	if input == nil {
+		return outputs, values, sensitiveValues, nil
+	}
+
+	outputsMap, ok := input.(map[string]interface{})
+	if !ok {
+		return nil, nil, nil, fmt.Errorf("Error flattening the outputs: expected an object but got %T", input)
+	}
+
+	for key, output := range outputsMap {
+		log.Printf("[DEBUG] Processing deployment output %s", key)
+		outputMap, ok := output.(map[string]interface{})
+		if !ok {
+			return nil, nil, nil, fmt.Errorf("Error flattening output %q: expected an object but got %T", key, output)
+		}
+
+		outputType, ok := outputMap["type"].(string)
+		if !ok {
+			log.Printf("[DEBUG] No type - skipping")
+			continue
+		}
+
+		outputValue, hasValue := outputMap["value"]
+
+		outputTypeLower := strings.ToLower(outputType)
+		if outputTypeLower == "securestring" || outputTypeLower == "secureobject" {
+			// the values of secure outputs are generally not returned by the API - in which case this is `null`
+			sensitiveValues[key] = outputValue
+			continue
+		}
+
+		if !hasValue {
+			log.Printf("[DEBUG] No value - skipping")
+			continue
+		}
+
+		var outputValueString string
+		switch outputTypeLower {
+		case "bool":
+			v, ok := outputValue.(bool)
+			if !ok {
+				return nil, nil, nil, fmt.Errorf("Error flattening output %q: expected a bool but got %T", key, outputValue)
+			}
+			outputValueString = strconv.FormatBool(v)
+
+		case "string":
+			v, ok := outputValue.(string)
+			if !ok {
+				return nil, nil, nil, fmt.Errorf("Error flattening output %q: expected a string but got %T", key, outputValue)
+			}
+			outputValueString = v
+
+		case "int":
+			outputValueString = fmt.Sprint(outputValue)
+
+		default:
+			log.Printf("[DEBUG] Output %s of type %s is only available within `outputs_json`", key, outputType)
+			values[key] = outputValue
+			continue
+		}
+		outputs[key] = outputValueString
+		values[key] = outputValue
+	}
+
+	return outputs, values, sensitiveValues, nil
 }
 
 // TODO: move this out into the new `helpers` structure
@@ -277,33 +912,42 @@ func normalizeJson(jsonString interface{}) string {
 	return string(b[:])
 }
 
-func waitForTemplateDeploymentToBeDeleted(ctx context.Context, client resources.DeploymentsGroupClient, resourceGroup, name string) error {
+func waitForTemplateDeploymentToBeDeleted(ctx context.Context, client resources.DeploymentsGroupClient, resourceGroup, name string, timeout time.Duration) error {
+	description := fmt.Sprintf("Template Deployment %q (Resource Group %q)", name, resourceGroup)
+	return waitForTemplateDeploymentToBeGone(ctx, description, timeout, func() (resources.DeploymentExtended, error) {
+		return client.Get(ctx, resourceGroup, name)
+	})
+}
+
+// waitForTemplateDeploymentToBeGone waits for the Template Deployment retrieved using `get` (which is scoped to either a
+// Resource Group, a Subscription or a Management Group) to be deleted
+func waitForTemplateDeploymentToBeGone(ctx context.Context, description string, timeout time.Duration, get func() (resources.DeploymentExtended, error)) error {
 	// we can't use the Waiter here since the API returns a 200 once it's deleted which is considered a polling status code..
-	log.Printf("[DEBUG] Waiting for Template Deployment (%q in Resource Group %q) to be deleted", name, resourceGroup)
+	log.Printf("[DEBUG] Waiting for %s to be deleted", description)
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{"200"},
 		Target:  []string{"404"},
-		Refresh: templateDeploymentStateStatusCodeRefreshFunc(ctx, client, resourceGroup, name),
-		Timeout: 40 * time.Minute,
+		Refresh: templateDeploymentStateStatusCodeRefreshFunc(description, get),
+		Timeout: timeout,
 	}
 	if _, err := stateConf.WaitForState(); err != nil {
-		return fmt.Errorf("Error waiting for Template Deployment (%q in Resource Group %q) to be deleted: %+v", name, resourceGroup, err)
+		return fmt.Errorf("Error waiting for %s to be deleted: %+v", description, err)
 	}
 
 	return nil
 }
 
-func templateDeploymentStateStatusCodeRefreshFunc(ctx context.Context, client resources.DeploymentsGroupClient, resourceGroup, name string) resource.StateRefreshFunc {
+func templateDeploymentStateStatusCodeRefreshFunc(description string, get func() (resources.DeploymentExtended, error)) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
-		res, err := client.Get(ctx, resourceGroup, name)
+		res, err := get()
 
-		log.Printf("Retrieving Template Deployment %q (Resource Group %q) returned Status %d", resourceGroup, name, res.StatusCode)
+		log.Printf("Retrieving %s returned Status %d", description, res.StatusCode)
 
 		if err != nil {
 			if utils.ResponseWasNotFound(res.Response) {
 				return res, strconv.Itoa(res.StatusCode), nil
 			}
-			return nil, "", fmt.Errorf("Error polling for the status of the Template Deployment %q (RG: %q): %+v", name, resourceGroup, err)
+			return nil, "", fmt.Errorf("Error polling for the status of %s: %+v", description, err)
 		}
 
 		return res, strconv.Itoa(res.StatusCode), nil
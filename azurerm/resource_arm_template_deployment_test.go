@@ -2,16 +2,26 @@ package azurerm
 
 import (
 	"fmt"
+	"io/ioutil"
 	"net/http"
+	"os"
+	"reflect"
 	"regexp"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2018-05-01/resources"
+	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	resourceSvc "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/resource"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func TestAccAzureRMTemplateDeployment_basic(t *testing.T) {
+	resourceName := "azurerm_template_deployment.test"
 	ri := tf.AccRandTimeInt()
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -22,9 +32,14 @@ func TestAccAzureRMTemplateDeployment_basic(t *testing.T) {
 			{
 				Config: testAccAzureRMTemplateDeployment_basicMultiple(ri, testLocation()),
 				Check: resource.ComposeTestCheckFunc(
-					testCheckAzureRMTemplateDeploymentExists("azurerm_template_deployment.test"),
+					testCheckAzureRMTemplateDeploymentExists(resourceName),
 				),
 			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -132,6 +147,58 @@ func TestAccAzureRMTemplateDeployment_withParamsBody(t *testing.T) {
 
 }
 
+func TestAccAzureRMTemplateDeployment_templateLink(t *testing.T) {
+	resourceName := "azurerm_template_deployment.test"
+	ri := tf.AccRandTimeInt()
+	rs := strings.ToLower(acctest.RandString(11))
+
+	templateFile, err := ioutil.TempFile("", "")
+	if err != nil {
+		t.Fatalf("Failed to create local template file: %+v", err)
+	}
+	defer os.Remove(templateFile.Name())
+	if _, err := templateFile.WriteString(testAccAzureRMTemplateDeployment_linkedTemplate()); err != nil {
+		t.Fatalf("Failed to write local template file: %+v", err)
+	}
+	if err := templateFile.Close(); err != nil {
+		t.Fatalf("Failed to close local template file: %+v", err)
+	}
+
+	parametersFile, err := ioutil.TempFile("", "")
+	if err != nil {
+		t.Fatalf("Failed to create local parameters file: %+v", err)
+	}
+	defer os.Remove(parametersFile.Name())
+	if _, err := parametersFile.WriteString(testAccAzureRMTemplateDeployment_linkedParameters()); err != nil {
+		t.Fatalf("Failed to write local parameters file: %+v", err)
+	}
+	if err := parametersFile.Close(); err != nil {
+		t.Fatalf("Failed to close local parameters file: %+v", err)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMTemplateDeploymentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMTemplateDeployment_templateLink(ri, rs, testLocation(), templateFile.Name(), parametersFile.Name()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMTemplateDeploymentExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "template_link.0.content_version", "1.0.0.0"),
+					resource.TestCheckResourceAttr(resourceName, "parameters_link.0.content_version", "1.0.0.0"),
+					resource.TestCheckResourceAttr(resourceName, "outputs.testOutput", "linked"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccAzureRMTemplateDeployment_withOutputs(t *testing.T) {
 	ri := tf.AccRandTimeInt()
 
@@ -160,6 +227,78 @@ func TestAccAzureRMTemplateDeployment_withOutputs(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMTemplateDeployment_withComplexOutputs(t *testing.T) {
+	resourceName := "azurerm_template_deployment.test"
+	ri := tf.AccRandTimeInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMTemplateDeploymentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMTemplateDeployment_withComplexOutputs(ri, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMTemplateDeploymentExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "outputs.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "outputs.stringOutput", "hello"),
+					resource.TestCheckResourceAttr(resourceName, "outputs_json", `{"arrayOutput":["first","second"],"objectOutput":{"enabled":true,"name":"example"},"stringOutput":"hello"}`),
+					// Azure never returns the values of secure outputs, so only the key is present
+					resource.TestCheckResourceAttr(resourceName, "sensitive_outputs_json", `{"secureOutput":null}`),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMTemplateDeployment_validationError(t *testing.T) {
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMTemplateDeploymentDestroy,
+		Steps: []resource.TestStep{
+			{
+				// the Template can only be validated during the plan once the Resource Group exists
+				Config: testAccAzureRMTemplateDeployment_resourceGroup(ri, location),
+			},
+			{
+				Config:      testAccAzureRMTemplateDeployment_validationError(ri, location),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Error validating Template Deployment"),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMTemplateDeployment_whatIf(t *testing.T) {
+	resourceName := "azurerm_template_deployment.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMTemplateDeploymentDestroy,
+		Steps: []resource.TestStep{
+			{
+				// the changes can only be previewed during the plan once the Resource Group exists
+				Config: testAccAzureRMTemplateDeployment_resourceGroup(ri, location),
+			},
+			{
+				Config: testAccAzureRMTemplateDeployment_whatIf(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMTemplateDeploymentExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "what_if_changes.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "what_if_changes.0.change_type", "Create"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAzureRMTemplateDeployment_withError(t *testing.T) {
 	ri := tf.AccRandTimeInt()
 
@@ -190,7 +329,7 @@ func testCheckAzureRMTemplateDeploymentExists(resourceName string) resource.Test
 			return fmt.Errorf("Bad: no resource group found in state for template deployment: %s", name)
 		}
 
-		client := testAccProvider.Meta().(*ArmClient).deploymentsClient
+		client := testAccProvider.Meta().(*ArmClient).Resource().DeploymentsClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		resp, err := client.Get(ctx, resourceGroup, name)
@@ -220,19 +359,19 @@ func testCheckAzureRMTemplateDeploymentDisappears(resourceName string) resource.
 			return fmt.Errorf("Bad: no resource group found in state for template deployment: %s", deploymentName)
 		}
 
-		client := testAccProvider.Meta().(*ArmClient).deploymentsClient
+		client := testAccProvider.Meta().(*ArmClient).Resource().DeploymentsClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		if _, err := client.Delete(ctx, resourceGroup, deploymentName); err != nil {
 			return fmt.Errorf("Failed deleting Deployment %q (Resource Group %q): %+v", deploymentName, resourceGroup, err)
 		}
 
-		return waitForTemplateDeploymentToBeDeleted(ctx, client, resourceGroup, deploymentName)
+		return waitForTemplateDeploymentToBeDeleted(ctx, client, resourceGroup, deploymentName, 40*time.Minute)
 	}
 }
 
 func testCheckAzureRMTemplateDeploymentDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*ArmClient).deploymentsClient
+	client := testAccProvider.Meta().(*ArmClient).Resource().DeploymentsClient
 	ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 	for _, rs := range s.RootModule().Resources {
@@ -814,6 +953,216 @@ DEPLOY
 `, rInt, location, rInt, rInt)
 }
 
+func testAccAzureRMTemplateDeployment_withComplexOutputs(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_template_deployment" "test" {
+  name                = "acctesttemplate-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  template_body = <<DEPLOY
+{
+  "$schema": "https://schema.management.azure.com/schemas/2015-01-01/deploymentTemplate.json#",
+  "contentVersion": "1.0.0.0",
+  "parameters": {
+    "secureParameter": {
+      "type": "securestring",
+      "defaultValue": "secret"
+    }
+  },
+  "resources": [],
+  "outputs": {
+    "stringOutput": {
+      "type": "string",
+      "value": "hello"
+    },
+    "arrayOutput": {
+      "type": "array",
+      "value": [
+        "first",
+        "second"
+      ]
+    },
+    "objectOutput": {
+      "type": "object",
+      "value": {
+        "name": "example",
+        "enabled": true
+      }
+    },
+    "secureOutput": {
+      "type": "securestring",
+      "value": "[parameters('secureParameter')]"
+    }
+  }
+}
+DEPLOY
+
+  deployment_mode = "Incremental"
+}
+`, rInt, location, rInt)
+}
+
+func testAccAzureRMTemplateDeployment_templateLink(rInt int, rString string, location string, templateFileName string, parametersFileName string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestsa%s"
+  resource_group_name      = "${azurerm_resource_group.test.name}"
+  location                 = "${azurerm_resource_group.test.location}"
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_storage_container" "test" {
+  name                  = "templates"
+  resource_group_name   = "${azurerm_resource_group.test.name}"
+  storage_account_name  = "${azurerm_storage_account.test.name}"
+  container_access_type = "blob"
+}
+
+resource "azurerm_storage_blob" "template" {
+  name                   = "template.json"
+  resource_group_name    = "${azurerm_resource_group.test.name}"
+  storage_account_name   = "${azurerm_storage_account.test.name}"
+  storage_container_name = "${azurerm_storage_container.test.name}"
+  type                   = "block"
+  source                 = "%s"
+}
+
+resource "azurerm_storage_blob" "parameters" {
+  name                   = "parameters.json"
+  resource_group_name    = "${azurerm_resource_group.test.name}"
+  storage_account_name   = "${azurerm_storage_account.test.name}"
+  storage_container_name = "${azurerm_storage_container.test.name}"
+  type                   = "block"
+  source                 = "%s"
+}
+
+resource "azurerm_template_deployment" "test" {
+  name                = "acctesttemplate-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  deployment_mode     = "Incremental"
+
+  template_link {
+    uri             = "${azurerm_storage_blob.template.url}"
+    content_version = "1.0.0.0"
+  }
+
+  parameters_link {
+    uri             = "${azurerm_storage_blob.parameters.url}"
+    content_version = "1.0.0.0"
+  }
+}
+`, rInt, location, rString, templateFileName, parametersFileName, rInt)
+}
+
+func testAccAzureRMTemplateDeployment_linkedTemplate() string {
+	return `{
+  "$schema": "https://schema.management.azure.com/schemas/2015-01-01/deploymentTemplate.json#",
+  "contentVersion": "1.0.0.0",
+  "parameters": {
+    "testValue": {
+      "type": "string"
+    }
+  },
+  "resources": [],
+  "outputs": {
+    "testOutput": {
+      "type": "string",
+      "value": "[parameters('testValue')]"
+    }
+  }
+}`
+}
+
+func testAccAzureRMTemplateDeployment_linkedParameters() string {
+	return `{
+  "$schema": "https://schema.management.azure.com/schemas/2015-01-01/deploymentParameters.json#",
+  "contentVersion": "1.0.0.0",
+  "parameters": {
+    "testValue": {
+      "value": "linked"
+    }
+  }
+}`
+}
+
+func testAccAzureRMTemplateDeployment_resourceGroup(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+`, rInt, location)
+}
+
+// the `missingParameter` parameter isn't defined, which fails validation
+func testAccAzureRMTemplateDeployment_validationError(rInt int, location string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_template_deployment" "test" {
+  name                = "acctesttemplate-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  deployment_mode     = "Incremental"
+
+  template_body = <<DEPLOY
+{
+  "$schema": "https://schema.management.azure.com/schemas/2015-01-01/deploymentTemplate.json#",
+  "contentVersion": "1.0.0.0",
+  "resources": [],
+  "outputs": {
+    "testOutput": {
+      "type": "string",
+      "value": "[parameters('missingParameter')]"
+    }
+  }
+}
+DEPLOY
+}
+`, testAccAzureRMTemplateDeployment_resourceGroup(rInt, location), rInt)
+}
+
+func testAccAzureRMTemplateDeployment_whatIf(rInt int, location string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_template_deployment" "test" {
+  name                = "acctesttemplate-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  deployment_mode     = "Incremental"
+  what_if_enabled     = true
+
+  template_body = <<DEPLOY
+{
+  "$schema": "https://schema.management.azure.com/schemas/2015-01-01/deploymentTemplate.json#",
+  "contentVersion": "1.0.0.0",
+  "resources": [
+    {
+      "type": "Microsoft.Network/publicIPAddresses",
+      "apiVersion": "2019-09-01",
+      "name": "acctestpip-%d",
+      "location": "[resourceGroup().location]",
+      "properties": {
+        "publicIPAllocationMethod": "Dynamic"
+      }
+    }
+  ]
+}
+DEPLOY
+}
+`, testAccAzureRMTemplateDeployment_resourceGroup(rInt, location), rInt, rInt)
+}
+
 // StorageAccount name is too long, forces error
 func testAccAzureRMTemplateDeployment_withError(rInt int, location string) string {
 	return fmt.Sprintf(`
@@ -881,3 +1230,425 @@ DEPLOY
 }
 `, rInt, location, rInt)
 }
+
+func TestTemplateDeploymentOutputs_flatten(t *testing.T) {
+	input := map[string]interface{}{
+		"boolOutput": map[string]interface{}{
+			"type":  "Bool",
+			"value": true,
+		},
+		"intOutput": map[string]interface{}{
+			"type":  "Int",
+			"value": float64(-123),
+		},
+		"stringOutput": map[string]interface{}{
+			"type":  "String",
+			"value": "hello",
+		},
+		"arrayOutput": map[string]interface{}{
+			"type":  "Array",
+			"value": []interface{}{"first", "second"},
+		},
+		"objectOutput": map[string]interface{}{
+			"type": "Object",
+			"value": map[string]interface{}{
+				"name": "example",
+			},
+		},
+		"secureStringOutput": map[string]interface{}{
+			"type":  "SecureString",
+			"value": "secret",
+		},
+		"secureObjectOutput": map[string]interface{}{
+			"type": "SecureObject",
+			"value": map[string]interface{}{
+				"password": "secret",
+			},
+		},
+		"secureOutputWithoutValue": map[string]interface{}{
+			"type": "SecureString",
+		},
+	}
+
+	outputs, values, sensitiveValues, err := flattenTemplateDeploymentOutputs(input)
+	if err != nil {
+		t.Fatalf("Expected no error but got: %+v", err)
+	}
+
+	expectedOutputs := map[string]string{
+		"boolOutput":   "true",
+		"intOutput":    "-123",
+		"stringOutput": "hello",
+	}
+	if !reflect.DeepEqual(outputs, expectedOutputs) {
+		t.Fatalf("Expected the outputs to be %+v but got %+v", expectedOutputs, outputs)
+	}
+
+	expectedValues := map[string]interface{}{
+		"boolOutput":   true,
+		"intOutput":    float64(-123),
+		"stringOutput": "hello",
+		"arrayOutput":  []interface{}{"first", "second"},
+		"objectOutput": map[string]interface{}{
+			"name": "example",
+		},
+	}
+	if !reflect.DeepEqual(values, expectedValues) {
+		t.Fatalf("Expected the values to be %+v but got %+v", expectedValues, values)
+	}
+
+	expectedSensitiveValues := map[string]interface{}{
+		"secureStringOutput": "secret",
+		"secureObjectOutput": map[string]interface{}{
+			"password": "secret",
+		},
+		"secureOutputWithoutValue": nil,
+	}
+	if !reflect.DeepEqual(sensitiveValues, expectedSensitiveValues) {
+		t.Fatalf("Expected the sensitive values to be %+v but got %+v", expectedSensitiveValues, sensitiveValues)
+	}
+}
+
+func TestTemplateDeploymentOutputs_flattenInvalid(t *testing.T) {
+	cases := map[string]interface{}{
+		"not an object": []interface{}{"first"},
+		"output isn't an object": map[string]interface{}{
+			"stringOutput": "hello",
+		},
+		"bool output isn't a bool": map[string]interface{}{
+			"boolOutput": map[string]interface{}{
+				"type":  "Bool",
+				"value": "true",
+			},
+		},
+		"string output isn't a string": map[string]interface{}{
+			"stringOutput": map[string]interface{}{
+				"type":  "String",
+				"value": float64(1),
+			},
+		},
+	}
+
+	for name, input := range cases {
+		t.Logf("[DEBUG] Testing %q", name)
+
+		if _, _, _, err := flattenTemplateDeploymentOutputs(input); err == nil {
+			t.Fatalf("Expected an error for %q but didn't get one", name)
+		}
+	}
+}
+
+func TestTemplateDeploymentParameters_flatten(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Existing map[string]interface{}
+		Deployed interface{}
+		Expected map[string]interface{}
+	}{
+		{
+			Name: "No Deployed Parameters",
+			Existing: map[string]interface{}{
+				"first": "hello",
+			},
+			Deployed: nil,
+			Expected: map[string]interface{}{
+				"first": "hello",
+			},
+		},
+		{
+			Name: "Values Are Updated",
+			Existing: map[string]interface{}{
+				"first":  "hello",
+				"second": "1",
+				"third":  "false",
+			},
+			Deployed: map[string]interface{}{
+				"first": map[string]interface{}{
+					"type":  "String",
+					"value": "world",
+				},
+				"second": map[string]interface{}{
+					"type":  "Int",
+					"value": float64(2),
+				},
+				"third": map[string]interface{}{
+					"type":  "Bool",
+					"value": true,
+				},
+			},
+			Expected: map[string]interface{}{
+				"first":  "world",
+				"second": "2",
+				"third":  "true",
+			},
+		},
+		{
+			Name: "Default Values Are Ignored",
+			Existing: map[string]interface{}{
+				"first": "hello",
+			},
+			Deployed: map[string]interface{}{
+				"first": map[string]interface{}{
+					"type":  "String",
+					"value": "hello",
+				},
+				"second": map[string]interface{}{
+					"type":  "String",
+					"value": "default",
+				},
+			},
+			Expected: map[string]interface{}{
+				"first": "hello",
+			},
+		},
+		{
+			Name: "Secure Values Are Retained",
+			Existing: map[string]interface{}{
+				"secret": "hello",
+			},
+			Deployed: map[string]interface{}{
+				"secret": map[string]interface{}{
+					"type": "SecureString",
+				},
+			},
+			Expected: map[string]interface{}{
+				"secret": "hello",
+			},
+		},
+	}
+
+	for _, v := range cases {
+		t.Run(v.Name, func(t *testing.T) {
+			actual := flattenTemplateDeploymentParameters(v.Existing, v.Deployed)
+			if !reflect.DeepEqual(actual, v.Expected) {
+				t.Fatalf("Expected %+v but got %+v", v.Expected, actual)
+			}
+		})
+	}
+}
+
+func TestTemplateDeploymentDeployedParameters_flatten(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Deployed interface{}
+		Expected map[string]interface{}
+	}{
+		{
+			Name:     "No Deployed Parameters",
+			Deployed: nil,
+			Expected: map[string]interface{}{},
+		},
+		{
+			Name: "Scalar Values",
+			Deployed: map[string]interface{}{
+				"first": map[string]interface{}{
+					"type":  "String",
+					"value": "hello",
+				},
+				"second": map[string]interface{}{
+					"type":  "Int",
+					"value": float64(2),
+				},
+				"third": map[string]interface{}{
+					"type":  "Bool",
+					"value": true,
+				},
+			},
+			Expected: map[string]interface{}{
+				"first":  "hello",
+				"second": "2",
+				"third":  "true",
+			},
+		},
+		{
+			Name: "Secure and Complex Values Are Omitted",
+			Deployed: map[string]interface{}{
+				"first": map[string]interface{}{
+					"type":  "String",
+					"value": "hello",
+				},
+				"secret": map[string]interface{}{
+					"type": "SecureString",
+				},
+				"secretObject": map[string]interface{}{
+					"type":  "SecureObject",
+					"value": "should-not-be-returned",
+				},
+				"list": map[string]interface{}{
+					"type":  "Array",
+					"value": []interface{}{"a", "b"},
+				},
+				"object": map[string]interface{}{
+					"type":  "Object",
+					"value": map[string]interface{}{"a": "b"},
+				},
+			},
+			Expected: map[string]interface{}{
+				"first": "hello",
+			},
+		},
+	}
+
+	for _, v := range cases {
+		t.Run(v.Name, func(t *testing.T) {
+			actual := flattenTemplateDeploymentDeployedParameters(v.Deployed)
+			if !reflect.DeepEqual(actual, v.Expected) {
+				t.Fatalf("Expected %+v but got %+v", v.Expected, actual)
+			}
+		})
+	}
+}
+
+func TestTemplateDeploymentParametersBody_flatten(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Existing string
+		Deployed interface{}
+		Expected string
+	}{
+		{
+			Name:     "No Deployed Parameters",
+			Existing: `{"first": {"value": "hello"}}`,
+			Deployed: nil,
+			Expected: `{"first":{"value":"hello"}}`,
+		},
+		{
+			Name:     "Unchanged",
+			Existing: `{"first": {"value": "hello"}}`,
+			Deployed: map[string]interface{}{
+				"first": map[string]interface{}{
+					"type":  "String",
+					"value": "hello",
+				},
+			},
+			Expected: `{"first":{"value":"hello"}}`,
+		},
+		{
+			Name:     "Changed Outside of Terraform",
+			Existing: `{"first": {"value": "hello"}, "second": {"value": 1}}`,
+			Deployed: map[string]interface{}{
+				"first": map[string]interface{}{
+					"type":  "String",
+					"value": "world",
+				},
+				"second": map[string]interface{}{
+					"type":  "Int",
+					"value": float64(2),
+				},
+			},
+			Expected: `{"first":{"value":"world"},"second":{"value":2}}`,
+		},
+		{
+			Name:     "Default Values Are Ignored",
+			Existing: `{"first": {"value": "hello"}}`,
+			Deployed: map[string]interface{}{
+				"first": map[string]interface{}{
+					"type":  "String",
+					"value": "hello",
+				},
+				"second": map[string]interface{}{
+					"type":  "String",
+					"value": "default",
+				},
+			},
+			Expected: `{"first":{"value":"hello"}}`,
+		},
+		{
+			Name:     "Secure Values And References Are Retained",
+			Existing: `{"secret": {"value": "hello"}, "reference": {"reference": {"keyvault": {"id": "example"}, "secretName": "secret"}}}`,
+			Deployed: map[string]interface{}{
+				"secret": map[string]interface{}{
+					"type": "SecureString",
+				},
+				"reference": map[string]interface{}{
+					"type": "SecureString",
+				},
+			},
+			Expected: `{"reference":{"reference":{"keyvault":{"id":"example"},"secretName":"secret"}},"secret":{"value":"hello"}}`,
+		},
+	}
+
+	for _, v := range cases {
+		t.Run(v.Name, func(t *testing.T) {
+			actual, err := flattenTemplateDeploymentParametersBody(v.Existing, v.Deployed)
+			if err != nil {
+				t.Fatalf("Expected no error but got: %+v", err)
+			}
+
+			if actual != v.Expected {
+				t.Fatalf("Expected %q but got %q", v.Expected, actual)
+			}
+		})
+	}
+}
+
+func TestTemplateDeploymentError_flatten(t *testing.T) {
+	input := resources.ManagementErrorWithDetails{
+		Code:    utils.String("InvalidTemplateDeployment"),
+		Message: utils.String("The template deployment is not valid."),
+		Details: &[]resources.ManagementErrorWithDetails{
+			{
+				Code:    utils.String("StorageAccountAlreadyTaken"),
+				Message: utils.String("The storage account named example is already taken."),
+				Target:  utils.String("example"),
+				Details: &[]resources.ManagementErrorWithDetails{
+					{
+						Code:    utils.String("Conflict"),
+						Message: utils.String("Nested."),
+					},
+				},
+			},
+			{
+				Message: utils.String("Without a Code."),
+			},
+		},
+	}
+
+	expected := `InvalidTemplateDeployment: The template deployment is not valid.
+  - StorageAccountAlreadyTaken: The storage account named example is already taken. (Target "example")
+    - Conflict: Nested.
+  - Without a Code.`
+
+	if actual := flattenTemplateDeploymentError(&input); actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
+
+func TestTemplateDeploymentWhatIfChanges_flatten(t *testing.T) {
+	input := resourceSvc.WhatIfOperationProperties{
+		Changes: &[]resourceSvc.WhatIfChange{
+			{
+				ResourceID: utils.String("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Storage/storageAccounts/account1"),
+				ChangeType: utils.String("Create"),
+			},
+			{
+				ResourceID: utils.String("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/publicIPAddresses/ip1"),
+				ChangeType: utils.String("NoChange"),
+			},
+			{
+				ResourceID: utils.String("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1"),
+				ChangeType: utils.String("Modify"),
+			},
+			{
+				ResourceID: utils.String("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Web/sites/site1"),
+				ChangeType: utils.String("Ignore"),
+			},
+		},
+	}
+
+	// the changes are sorted by Resource ID
+	expected := []interface{}{
+		map[string]interface{}{
+			"resource_id": "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1",
+			"change_type": "Modify",
+		},
+		map[string]interface{}{
+			"resource_id": "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Storage/storageAccounts/account1",
+			"change_type": "Create",
+		},
+	}
+
+	if actual := flattenTemplateDeploymentWhatIfChanges(&input); !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
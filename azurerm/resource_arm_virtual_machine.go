@@ -6,6 +6,7 @@ import (
 	"log"
 	"net/url"
 	"strings"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2018-06-01/compute"
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-12-01/network"
@@ -15,6 +16,7 @@ import (
 	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 	"golang.org/x/net/context"
 )
@@ -23,12 +25,19 @@ var virtualMachineResourceName = "azurerm_virtual_machine"
 
 func resourceArmVirtualMachine() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmVirtualMachineCreateUpdate,
-		Read:   resourceArmVirtualMachineRead,
-		Update: resourceArmVirtualMachineCreateUpdate,
-		Delete: resourceArmVirtualMachineDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+		Create:   resourceArmVirtualMachineCreateUpdate,
+		Read:     resourceArmVirtualMachineRead,
+		Update:   resourceArmVirtualMachineCreateUpdate,
+		Delete:   resourceArmVirtualMachineDelete,
+		Importer: azure.ValidateResourceIDPriorToImport(azure.ValidateVirtualMachineID),
+
+		CustomizeDiff: resourceArmVirtualMachineCustomizeDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -69,10 +78,11 @@ func resourceArmVirtualMachine() *schema.Resource {
 			},
 
 			"availability_set_id": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Computed: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateOrEmpty(azure.ValidateAvailabilitySetID),
 				StateFunc: func(id interface{}) string {
 					return strings.ToLower(id.(string))
 				},
@@ -113,6 +123,8 @@ func resourceArmVirtualMachine() *schema.Resource {
 				},
 			},
 
+			"power_state": virtualMachinePowerStateSchema(),
+
 			"license_type": {
 				Type:             schema.TypeString,
 				Optional:         true,
@@ -210,6 +222,7 @@ func resourceArmVirtualMachine() *schema.Resource {
 							Optional:      true,
 							ForceNew:      true,
 							Computed:      true,
+							ValidateFunc:  azure.ValidateOrEmpty(azure.ValidateManagedDiskID),
 							ConflictsWith: []string{"storage_os_disk.0.vhd_uri"},
 						},
 
@@ -285,6 +298,7 @@ func resourceArmVirtualMachine() *schema.Resource {
 							Optional:         true,
 							Computed:         true,
 							DiffSuppressFunc: ignoreCaseDiffSuppressFunc,
+							ValidateFunc:     azure.ValidateOrEmpty(azure.ValidateManagedDiskID),
 						},
 
 						"managed_disk_type": {
@@ -515,8 +529,9 @@ func resourceArmVirtualMachine() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"source_vault_id": {
-							Type:     schema.TypeString,
-							Required: true,
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: azure.ValidateKeyVaultID,
 						},
 
 						"vault_certificates": {
@@ -548,8 +563,9 @@ func resourceArmVirtualMachine() *schema.Resource {
 			},
 
 			"primary_network_interface_id": {
-				Type:     schema.TypeString,
-				Optional: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: azure.ValidateOrEmpty(azure.ValidateNetworkInterfaceID),
 			},
 
 			"tags": tagsSchema(),
@@ -558,15 +574,16 @@ func resourceArmVirtualMachine() *schema.Resource {
 }
 
 func resourceArmVirtualMachineCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).vmClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).Compute().VMClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for Azure ARM Virtual Machine creation.")
 
 	name := d.Get("name").(string)
 	resGroup := d.Get("resource_group_name").(string)
 
-	if requireResourcesToBeImported && d.IsNewResource() {
+	if meta.(*ArmClient).features.ExistingResources.RequireImport && d.IsNewResource() {
 		existing, err := client.Get(ctx, resGroup, name, "")
 		if err != nil {
 			if !utils.ResponseWasNotFound(existing.Response) {
@@ -672,6 +689,41 @@ func resourceArmVirtualMachineCreateUpdate(d *schema.ResourceData, meta interfac
 	azureRMLockByName(name, virtualMachineResourceName)
 	defer azureRMUnlockByName(name, virtualMachineResourceName)
 
+	// when the new size isn't available on the hardware cluster currently hosting the Virtual Machine
+	// it needs to be deallocated to be resized - after which it's returned to the power state it was previously in
+	deallocated := false
+	previousPowerState := ""
+	restorePowerState := false
+	defer func() {
+		if restorePowerState {
+			restoreVirtualMachinePowerState(ctx, client, resGroup, name, previousPowerState)
+		}
+	}()
+	if !d.IsNewResource() && d.HasChange("vm_size") {
+		requiresDeallocation, err2 := virtualMachineRequiresDeallocationToResize(ctx, client, resGroup, name, vmSize)
+		if err2 != nil {
+			return err2
+		}
+
+		if requiresDeallocation {
+			previousPowerState, err = virtualMachinePowerState(ctx, client, resGroup, name)
+			if err != nil {
+				return err
+			}
+			if previousPowerState == "" {
+				log.Printf("[WARN] Unable to determine the power state of Virtual Machine %q (Resource Group %q) - it'll remain deallocated once resized", name, resGroup)
+			}
+
+			if err := virtualMachineDeallocate(ctx, client, resGroup, name); err != nil {
+				return err
+			}
+			deallocated = true
+
+			// should the update fail, the Virtual Machine is returned to its previous power state on a best-effort basis
+			restorePowerState = true
+		}
+	}
+
 	future, err := client.CreateOrUpdate(ctx, resGroup, name, vm)
 	if err != nil {
 		return err
@@ -680,6 +732,25 @@ func resourceArmVirtualMachineCreateUpdate(d *schema.ResourceData, meta interfac
 	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
 		return err
 	}
+	restorePowerState = false
+
+	// the power state is only changed when it's specified, otherwise any changes made outside of Terraform are retained
+	powerState := ""
+	if d.HasChange("power_state") {
+		powerState = d.Get("power_state").(string)
+	}
+
+	// a Virtual Machine which was deallocated to be resized is returned to the power state it was previously in,
+	// such that a Virtual Machine which was stopped (rather than deallocated) is started and powered off again
+	if deallocated && powerState == "" {
+		powerState = previousPowerState
+	}
+
+	if powerState != "" {
+		if err := setVirtualMachinePowerState(ctx, client, resGroup, name, powerState); err != nil {
+			return err
+		}
+	}
 
 	read, err := client.Get(ctx, resGroup, name, "")
 	if err != nil {
@@ -713,15 +784,16 @@ func resourceArmVirtualMachineCreateUpdate(d *schema.ResourceData, meta interfac
 }
 
 func resourceArmVirtualMachineRead(d *schema.ResourceData, meta interface{}) error {
-	vmClient := meta.(*ArmClient).vmClient
-	ctx := meta.(*ArmClient).StopContext
+	vmClient := meta.(*ArmClient).Compute().VMClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := azure.ParseVirtualMachineID(d.Id())
 	if err != nil {
 		return err
 	}
 	resGroup := id.ResourceGroup
-	name := id.Path["virtualMachines"]
+	name := id.Name
 
 	resp, err := vmClient.Get(ctx, resGroup, name, "")
 	if err != nil {
@@ -763,7 +835,7 @@ func resourceArmVirtualMachineRead(d *schema.ResourceData, meta interface{}) err
 			}
 
 			if osDisk := profile.OsDisk; osDisk != nil {
-				diskInfo, err := resourceArmVirtualMachineGetManagedDiskInfo(osDisk.ManagedDisk, meta)
+				diskInfo, err := resourceArmVirtualMachineGetManagedDiskInfo(ctx, osDisk.ManagedDisk, meta)
 				if err != nil {
 					return fmt.Errorf("Error flattening `storage_os_disk`: %#v", err)
 				}
@@ -775,7 +847,7 @@ func resourceArmVirtualMachineRead(d *schema.ResourceData, meta interface{}) err
 			if dataDisks := profile.DataDisks; dataDisks != nil {
 				disksInfo := make([]*compute.Disk, len(*dataDisks))
 				for i, dataDisk := range *dataDisks {
-					diskInfo, err := resourceArmVirtualMachineGetManagedDiskInfo(dataDisk.ManagedDisk, meta)
+					diskInfo, err := resourceArmVirtualMachineGetManagedDiskInfo(ctx, dataDisk.ManagedDisk, meta)
 					if err != nil {
 						return fmt.Errorf("[DEBUG] Error getting managed data disk detailed information: %#v", err)
 					}
@@ -829,21 +901,28 @@ func resourceArmVirtualMachineRead(d *schema.ResourceData, meta interface{}) err
 		}
 	}
 
+	powerState, err := virtualMachinePowerState(ctx, vmClient, resGroup, name)
+	if err != nil {
+		return err
+	}
+	d.Set("power_state", powerState)
+
 	flattenAndSetTags(d, resp.Tags)
 
 	return nil
 }
 
 func resourceArmVirtualMachineDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).vmClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).Compute().VMClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := azure.ParseVirtualMachineID(d.Id())
 	if err != nil {
 		return err
 	}
 	resGroup := id.ResourceGroup
-	name := id.Path["virtualMachines"]
+	name := id.Name
 
 	azureRMLockByName(name, virtualMachineResourceName)
 	defer azureRMUnlockByName(name, virtualMachineResourceName)
@@ -857,9 +936,11 @@ func resourceArmVirtualMachineDelete(d *schema.ResourceData, meta interface{}) e
 		return err
 	}
 
-	// delete OS Disk if opted in
-	if deleteOsDisk := d.Get("delete_os_disk_on_termination").(bool); deleteOsDisk {
-		log.Printf("[INFO] delete_os_disk_on_termination is enabled, deleting disk from %s", name)
+	features := meta.(*ArmClient).features.VirtualMachine
+
+	// delete OS Disk if opted in, either on the resource or via the `virtual_machine` feature
+	if deleteOsDisk := d.Get("delete_os_disk_on_termination").(bool) || features.DeleteOSDiskOnDeletion; deleteOsDisk {
+		log.Printf("[INFO] Deletion of the OS Disk is enabled, deleting disk from %s", name)
 
 		osDisk, err := expandAzureRmVirtualMachineOsDisk(d)
 		if err != nil {
@@ -868,13 +949,13 @@ func resourceArmVirtualMachineDelete(d *schema.ResourceData, meta interface{}) e
 
 		if osDisk.Vhd != nil {
 			if osDisk.Vhd.URI != nil {
-				if err = resourceArmVirtualMachineDeleteVhd(*osDisk.Vhd.URI, meta); err != nil {
+				if err = resourceArmVirtualMachineDeleteVhd(ctx, *osDisk.Vhd.URI, meta); err != nil {
 					return fmt.Errorf("Error deleting OS Disk VHD: %+v", err)
 				}
 			}
 		} else if osDisk.ManagedDisk != nil {
 			if osDisk.ManagedDisk.ID != nil {
-				if err = resourceArmVirtualMachineDeleteManagedDisk(*osDisk.ManagedDisk.ID, meta); err != nil {
+				if err = resourceArmVirtualMachineDeleteManagedDisk(ctx, *osDisk.ManagedDisk.ID, meta); err != nil {
 					return fmt.Errorf("Error deleting OS Managed Disk: %+v", err)
 				}
 			}
@@ -883,9 +964,9 @@ func resourceArmVirtualMachineDelete(d *schema.ResourceData, meta interface{}) e
 		}
 	}
 
-	// delete Data disks if opted in
-	if deleteDataDisks := d.Get("delete_data_disks_on_termination").(bool); deleteDataDisks {
-		log.Printf("[INFO] delete_data_disks_on_termination is enabled, deleting each data disk from %s", name)
+	// delete Data disks if opted in, either on the resource or via the `virtual_machine` feature
+	if deleteDataDisks := d.Get("delete_data_disks_on_termination").(bool) || features.DeleteDataDisksOnDeletion; deleteDataDisks {
+		log.Printf("[INFO] Deletion of the Data Disks is enabled, deleting each data disk from %s", name)
 
 		disks, err := expandAzureRmVirtualMachineDataDisk(d)
 		if err != nil {
@@ -894,11 +975,11 @@ func resourceArmVirtualMachineDelete(d *schema.ResourceData, meta interface{}) e
 
 		for _, disk := range disks {
 			if disk.Vhd != nil {
-				if err = resourceArmVirtualMachineDeleteVhd(*disk.Vhd.URI, meta); err != nil {
+				if err = resourceArmVirtualMachineDeleteVhd(ctx, *disk.Vhd.URI, meta); err != nil {
 					return fmt.Errorf("Error deleting Data Disk VHD: %+v", err)
 				}
 			} else if disk.ManagedDisk != nil {
-				if err = resourceArmVirtualMachineDeleteManagedDisk(*disk.ManagedDisk.ID, meta); err != nil {
+				if err = resourceArmVirtualMachineDeleteManagedDisk(ctx, *disk.ManagedDisk.ID, meta); err != nil {
 					return fmt.Errorf("Error deleting Data Managed Disk: %+v", err)
 				}
 			} else {
@@ -910,9 +991,8 @@ func resourceArmVirtualMachineDelete(d *schema.ResourceData, meta interface{}) e
 	return nil
 }
 
-func resourceArmVirtualMachineDeleteVhd(uri string, meta interface{}) error {
+func resourceArmVirtualMachineDeleteVhd(ctx context.Context, uri string, meta interface{}) error {
 	armClient := meta.(*ArmClient)
-	ctx := armClient.StopContext
 	environment := armClient.environment
 
 	vhdURL, err := url.Parse(uri)
@@ -931,7 +1011,7 @@ func resourceArmVirtualMachineDeleteVhd(uri string, meta interface{}) error {
 	containerName := path[0]
 	blobName := path[1]
 
-	resourceGroupName, err := findStorageAccountResourceGroup(meta, storageAccountName)
+	resourceGroupName, err := findStorageAccountResourceGroup(ctx, meta, storageAccountName)
 	if err != nil {
 		return fmt.Errorf("Error finding resource group for storage account %s: %+v", storageAccountName, err)
 	}
@@ -958,9 +1038,8 @@ func resourceArmVirtualMachineDeleteVhd(uri string, meta interface{}) error {
 	return nil
 }
 
-func resourceArmVirtualMachineDeleteManagedDisk(managedDiskID string, meta interface{}) error {
-	client := meta.(*ArmClient).diskClient
-	ctx := meta.(*ArmClient).StopContext
+func resourceArmVirtualMachineDeleteManagedDisk(ctx context.Context, managedDiskID string, meta interface{}) error {
+	client := meta.(*ArmClient).Compute().DisksClient
 
 	id, err := parseAzureResourceID(managedDiskID)
 	if err != nil {
@@ -1745,9 +1824,8 @@ func expandAzureRmVirtualMachineOsDisk(d *schema.ResourceData) (*compute.OSDisk,
 	return osDisk, nil
 }
 
-func findStorageAccountResourceGroup(meta interface{}, storageAccountName string) (string, error) {
-	client := meta.(*ArmClient).resourcesClient
-	ctx := meta.(*ArmClient).StopContext
+func findStorageAccountResourceGroup(ctx context.Context, meta interface{}, storageAccountName string) (string, error) {
+	client := meta.(*ArmClient).Resource().ResourcesClient
 	filter := fmt.Sprintf("name eq '%s' and resourceType eq 'Microsoft.Storage/storageAccounts'", storageAccountName)
 	expand := ""
 	var pager *int32
@@ -1830,9 +1908,8 @@ func resourceArmVirtualMachineStorageImageReferenceHash(v interface{}) int {
 	return hashcode.String(buf.String())
 }
 
-func resourceArmVirtualMachineGetManagedDiskInfo(disk *compute.ManagedDiskParameters, meta interface{}) (*compute.Disk, error) {
-	client := meta.(*ArmClient).diskClient
-	ctx := meta.(*ArmClient).StopContext
+func resourceArmVirtualMachineGetManagedDiskInfo(ctx context.Context, disk *compute.ManagedDiskParameters, meta interface{}) (*compute.Disk, error) {
+	client := meta.(*ArmClient).Compute().DisksClient
 
 	if disk == nil || disk.ID == nil {
 		return nil, nil
@@ -1854,8 +1931,8 @@ func resourceArmVirtualMachineGetManagedDiskInfo(disk *compute.ManagedDiskParame
 	return &diskResp, nil
 }
 func determineVirtualMachineIPAddress(ctx context.Context, meta interface{}, props *compute.VirtualMachineProperties) (string, error) {
-	nicClient := meta.(*ArmClient).ifaceClient
-	pipClient := meta.(*ArmClient).publicIPClient
+	nicClient := meta.(*ArmClient).Network().InterfacesClient
+	pipClient := meta.(*ArmClient).Network().PublicIPsClient
 
 	if props == nil {
 		return "", nil
@@ -1930,3 +2007,29 @@ func determineVirtualMachineIPAddress(ctx context.Context, meta interface{}, pro
 
 	return "", fmt.Errorf("No Public or Private IP Address found on the Primary Network Interface")
 }
+
+func resourceArmVirtualMachineCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	if !shouldValidateComputeCapacity(d, meta, "location", "vm_size", "zones") {
+		return nil
+	}
+
+	existing := make([]computeCapacityRequest, 0)
+	if d.Id() != "" && !d.HasChange("location") {
+		oldVmSize, _ := d.GetChange("vm_size")
+		existing = append(existing, computeCapacityRequest{
+			ResourceType: computeCapacityResourceTypeVirtualMachines,
+			Sku:          oldVmSize.(string),
+			Instances:    1,
+		})
+	}
+
+	requested := []computeCapacityRequest{
+		{
+			ResourceType: computeCapacityResourceTypeVirtualMachines,
+			Sku:          d.Get("vm_size").(string),
+			Instances:    1,
+		},
+	}
+
+	return validateComputeCapacity(meta, d.Get("location").(string), expandComputeCapacityZones(d, "zones"), existing, requested)
+}
@@ -0,0 +1,270 @@
+package azurerm
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2018-06-01/compute"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmVirtualMachineRunCommand() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmVirtualMachineRunCommandCreateUpdate,
+		Read:   resourceArmVirtualMachineRunCommandRead,
+		Update: resourceArmVirtualMachineRunCommandCreateUpdate,
+		Delete: resourceArmVirtualMachineRunCommandDelete,
+
+		// Run Commands can take up to 90 minutes to complete
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(90 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(90 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"virtual_machine_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateVirtualMachineID,
+			},
+
+			// defaults to `RunShellScript` for Linux and `RunPowerShellScript` for Windows Virtual Machines
+			"command_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			// only a hash of the script is stored in the state, which causes it to be run again when the script changes
+			"script": {
+				Type:         schema.TypeString,
+				Required:     true,
+				StateFunc:    virtualMachineRunCommandScriptStateFunc,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"parameters": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
+			"stdout": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"stderr": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceArmVirtualMachineRunCommandCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).Compute().VMClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	name := d.Get("name").(string)
+	virtualMachineId, err := azure.ParseVirtualMachineID(d.Get("virtual_machine_id").(string))
+	if err != nil {
+		return err
+	}
+
+	// only a single Run Command can run on a Virtual Machine at a time, which also can't happen during other operations
+	azureRMLockByName(virtualMachineId.Name, virtualMachineResourceName)
+	defer azureRMUnlockByName(virtualMachineId.Name, virtualMachineResourceName)
+
+	virtualMachine, err := client.Get(ctx, virtualMachineId.ResourceGroup, virtualMachineId.Name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(virtualMachine.Response) {
+			return fmt.Errorf("Virtual Machine %q (Resource Group %q) was not found", virtualMachineId.Name, virtualMachineId.ResourceGroup)
+		}
+
+		return fmt.Errorf("Error retrieving Virtual Machine %q (Resource Group %q): %+v", virtualMachineId.Name, virtualMachineId.ResourceGroup, err)
+	}
+
+	commandId := d.Get("command_id").(string)
+	if commandId == "" {
+		commandId = "RunShellScript"
+		if virtualMachineOSType(virtualMachine) == compute.Windows {
+			commandId = "RunPowerShellScript"
+		}
+	}
+
+	input := compute.RunCommandInput{
+		CommandID:  utils.String(commandId),
+		Script:     expandVirtualMachineRunCommandScript(d.Get("script").(string)),
+		Parameters: expandVirtualMachineRunCommandParameters(d.Get("parameters").(map[string]interface{})),
+	}
+
+	log.Printf("[DEBUG] Running Command %q (%q) on Virtual Machine %q (Resource Group %q)..", name, commandId, virtualMachineId.Name, virtualMachineId.ResourceGroup)
+	future, err := client.RunCommand(ctx, virtualMachineId.ResourceGroup, virtualMachineId.Name, input)
+	if err != nil {
+		return fmt.Errorf("Error running Command %q on Virtual Machine %q (Resource Group %q): %+v", name, virtualMachineId.Name, virtualMachineId.ResourceGroup, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for Command %q to run on Virtual Machine %q (Resource Group %q): %+v", name, virtualMachineId.Name, virtualMachineId.ResourceGroup, err)
+	}
+
+	result, err := future.Result(client)
+	if err != nil {
+		return fmt.Errorf("Error retrieving the result of Command %q on Virtual Machine %q (Resource Group %q): %+v", name, virtualMachineId.Name, virtualMachineId.ResourceGroup, err)
+	}
+
+	stdout, stderr, err := flattenVirtualMachineRunCommandOutput(result.Value)
+	if err != nil {
+		return fmt.Errorf("Error running Command %q on Virtual Machine %q (Resource Group %q): %+v", name, virtualMachineId.Name, virtualMachineId.ResourceGroup, err)
+	}
+	log.Printf("[DEBUG] Ran Command %q on Virtual Machine %q (Resource Group %q)", name, virtualMachineId.Name, virtualMachineId.ResourceGroup)
+
+	id := azure.VirtualMachineRunCommandID{
+		ResourceGroup:      virtualMachineId.ResourceGroup,
+		VirtualMachineName: virtualMachineId.Name,
+		Name:               name,
+	}
+	d.SetId(id.ID(meta.(*ArmClient).subscriptionId))
+
+	// the output of a Run Command isn't available once it's completed, so this is set here rather than in the Read
+	d.Set("command_id", commandId)
+	d.Set("stdout", stdout)
+	d.Set("stderr", stderr)
+
+	return resourceArmVirtualMachineRunCommandRead(d, meta)
+}
+
+func resourceArmVirtualMachineRunCommandRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).Compute().VMClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := azure.ParseVirtualMachineRunCommandID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	// a Run Command isn't a resource in its own right, so this checks the Virtual Machine it ran on still exists
+	resp, err := client.Get(ctx, id.ResourceGroup, id.VirtualMachineName, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Virtual Machine %q (Resource Group %q) was not found - removing Run Command %q from state", id.VirtualMachineName, id.ResourceGroup, id.Name)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Virtual Machine %q (Resource Group %q): %+v", id.VirtualMachineName, id.ResourceGroup, err)
+	}
+
+	d.Set("name", id.Name)
+
+	return nil
+}
+
+func resourceArmVirtualMachineRunCommandDelete(d *schema.ResourceData, _ interface{}) error {
+	id, err := azure.ParseVirtualMachineRunCommandID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	// there's nothing to undo once a Run Command has completed, so this is only removed from the state
+	log.Printf("[DEBUG] Removing Run Command %q for Virtual Machine %q (Resource Group %q) from state", id.Name, id.VirtualMachineName, id.ResourceGroup)
+	return nil
+}
+
+func virtualMachineRunCommandScriptStateFunc(v interface{}) string {
+	switch s := v.(type) {
+	case string:
+		hash := sha1.Sum([]byte(s))
+		return hex.EncodeToString(hash[:])
+	default:
+		return ""
+	}
+}
+
+func expandVirtualMachineRunCommandScript(input string) *[]string {
+	script := strings.Split(strings.Replace(input, "\r\n", "\n", -1), "\n")
+	return &script
+}
+
+func expandVirtualMachineRunCommandParameters(input map[string]interface{}) *[]compute.RunCommandInputParameter {
+	parameters := make([]compute.RunCommandInputParameter, 0)
+
+	for k, v := range input {
+		parameters = append(parameters, compute.RunCommandInputParameter{
+			Name:  utils.String(k),
+			Value: utils.String(v.(string)),
+		})
+	}
+
+	return &parameters
+}
+
+// flattenVirtualMachineRunCommandOutput returns the stdout and stderr of a Run Command, which for a Windows Virtual Machine
+// are returned as separate statuses - and for a Linux Virtual Machine are returned within a single status, in the format
+// `Enable succeeded: \n[stdout]\n{stdout}\n[stderr]\n{stderr}`
+func flattenVirtualMachineRunCommandOutput(input *[]compute.InstanceViewStatus) (string, string, error) {
+	stdout := ""
+	stderr := ""
+
+	if input == nil {
+		return stdout, stderr, nil
+	}
+
+	for _, status := range *input {
+		code := ""
+		if status.Code != nil {
+			code = *status.Code
+		}
+
+		message := ""
+		if status.Message != nil {
+			message = *status.Message
+		}
+
+		if status.Level == compute.Error {
+			return "", "", fmt.Errorf("%s: %s", code, message)
+		}
+
+		switch {
+		case strings.HasPrefix(strings.ToLower(code), "componentstatus/stdout/"):
+			stdout = message
+
+		case strings.HasPrefix(strings.ToLower(code), "componentstatus/stderr/"):
+			stderr = message
+
+		case strings.Contains(message, "[stdout]"):
+			output := message[strings.Index(message, "[stdout]")+len("[stdout]"):]
+			if i := strings.Index(output, "[stderr]"); i != -1 {
+				stderr = strings.TrimPrefix(output[i+len("[stderr]"):], "\n")
+				output = output[:i]
+			}
+			stdout = strings.TrimPrefix(output, "\n")
+		}
+	}
+
+	return stdout, stderr, nil
+}
@@ -0,0 +1,193 @@
+package azurerm
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2018-06-01/compute"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestFlattenVirtualMachineRunCommandOutput(t *testing.T) {
+	testData := []struct {
+		Name           string
+		Input          *[]compute.InstanceViewStatus
+		ExpectedStdout string
+		ExpectedStderr string
+		Error          bool
+	}{
+		{
+			Name:  "Empty",
+			Input: nil,
+		},
+		{
+			Name: "Linux",
+			Input: &[]compute.InstanceViewStatus{
+				{
+					Code:    utils.String("ProvisioningState/succeeded"),
+					Level:   compute.Info,
+					Message: utils.String("Enable succeeded: \n[stdout]\nhello\n\n[stderr]\nwarning\n"),
+				},
+			},
+			ExpectedStdout: "hello\n\n",
+			ExpectedStderr: "warning\n",
+		},
+		{
+			Name: "Linux without stderr",
+			Input: &[]compute.InstanceViewStatus{
+				{
+					Code:    utils.String("ProvisioningState/succeeded"),
+					Level:   compute.Info,
+					Message: utils.String("Enable succeeded: \n[stdout]\nhello\n"),
+				},
+			},
+			ExpectedStdout: "hello\n",
+		},
+		{
+			Name: "Windows",
+			Input: &[]compute.InstanceViewStatus{
+				{
+					Code:    utils.String("ComponentStatus/StdOut/succeeded"),
+					Level:   compute.Info,
+					Message: utils.String("hello"),
+				},
+				{
+					Code:    utils.String("ComponentStatus/StdErr/succeeded"),
+					Level:   compute.Info,
+					Message: utils.String("warning"),
+				},
+			},
+			ExpectedStdout: "hello",
+			ExpectedStderr: "warning",
+		},
+		{
+			Name: "Failed",
+			Input: &[]compute.InstanceViewStatus{
+				{
+					Code:    utils.String("ProvisioningState/failed"),
+					Level:   compute.Error,
+					Message: utils.String("Enable failed"),
+				},
+			},
+			Error: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		stdout, stderr, err := flattenVirtualMachineRunCommandOutput(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expected no error but got: %+v", err)
+		}
+
+		if v.Error {
+			t.Fatalf("Expected an error but didn't get one")
+		}
+
+		if stdout != v.ExpectedStdout {
+			t.Fatalf("Expected stdout to be %q but got %q", v.ExpectedStdout, stdout)
+		}
+
+		if stderr != v.ExpectedStderr {
+			t.Fatalf("Expected stderr to be %q but got %q", v.ExpectedStderr, stderr)
+		}
+	}
+}
+
+func TestAccAzureRMVirtualMachineRunCommand_basic(t *testing.T) {
+	resourceName := "azurerm_virtual_machine_run_command.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMLinuxVirtualMachineDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMVirtualMachineRunCommand_basic(ri, location, "hello"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "command_id", "RunShellScript"),
+					resource.TestMatchResourceAttr(resourceName, "stdout", regexp.MustCompile("hello")),
+				),
+			},
+			{
+				// changing the script runs the command again
+				Config: testAccAzureRMVirtualMachineRunCommand_basic(ri, location, "world"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(resourceName, "stdout", regexp.MustCompile("world")),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMVirtualMachineRunCommand_parameters(t *testing.T) {
+	resourceName := "azurerm_virtual_machine_run_command.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMLinuxVirtualMachineDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMVirtualMachineRunCommand_parameters(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(resourceName, "stdout", regexp.MustCompile("terraform")),
+					resource.TestMatchResourceAttr(resourceName, "stderr", regexp.MustCompile("oops")),
+				),
+			},
+		},
+	})
+}
+
+func testAccAzureRMVirtualMachineRunCommand_basic(rInt int, location string, message string) string {
+	template := testAccAzureRMLinuxVirtualMachine_authSSH(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_machine_run_command" "test" {
+  name               = "bootstrap"
+  virtual_machine_id = "${azurerm_linux_virtual_machine.test.id}"
+
+  script = <<SCRIPT
+#!/bin/bash
+echo "%s"
+SCRIPT
+}
+`, template, message)
+}
+
+func testAccAzureRMVirtualMachineRunCommand_parameters(rInt int, location string) string {
+	template := testAccAzureRMLinuxVirtualMachine_authSSH(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_machine_run_command" "test" {
+  name               = "bootstrap"
+  virtual_machine_id = "${azurerm_linux_virtual_machine.test.id}"
+  command_id         = "RunShellScript"
+
+  script = <<SCRIPT
+#!/bin/bash
+echo "hello $name"
+echo "oops" >&2
+SCRIPT
+
+  parameters = {
+    name = "terraform"
+  }
+}
+`, template)
+}
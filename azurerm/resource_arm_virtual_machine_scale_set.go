@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2018-06-01/compute"
 
@@ -16,6 +17,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -28,8 +30,13 @@ func resourceArmVirtualMachineScaleSet() *schema.Resource {
 		MigrateState:  resourceVirtualMachineScaleSetMigrateState,
 		SchemaVersion: 1,
 
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+		Importer: azure.ValidateResourceIDPriorToImport(azure.ValidateVirtualMachineScaleSetID),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -131,7 +138,7 @@ func resourceArmVirtualMachineScaleSet() *schema.Resource {
 			"health_probe_id": {
 				Type:         schema.TypeString,
 				Optional:     true,
-				ValidateFunc: azure.ValidateResourceID,
+				ValidateFunc: azure.ValidateLoadBalancerProbeID,
 			},
 
 			"automatic_os_upgrade": {
@@ -191,6 +198,8 @@ func resourceArmVirtualMachineScaleSet() *schema.Resource {
 				ForceNew: true,
 			},
 
+			"power_state": virtualMachinePowerStateSchema(),
+
 			"priority": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -254,7 +263,7 @@ func resourceArmVirtualMachineScaleSet() *schema.Resource {
 						"source_vault_id": {
 							Type:         schema.TypeString,
 							Required:     true,
-							ValidateFunc: azure.ValidateResourceID,
+							ValidateFunc: azure.ValidateKeyVaultID,
 						},
 
 						"vault_certificates": {
@@ -401,7 +410,7 @@ func resourceArmVirtualMachineScaleSet() *schema.Resource {
 						"network_security_group_id": {
 							Type:         schema.TypeString,
 							Optional:     true,
-							ValidateFunc: azure.ValidateResourceID,
+							ValidateFunc: azure.ValidateNetworkSecurityGroupID,
 						},
 
 						"dns_settings": {
@@ -436,7 +445,7 @@ func resourceArmVirtualMachineScaleSet() *schema.Resource {
 									"subnet_id": {
 										Type:         schema.TypeString,
 										Required:     true,
-										ValidateFunc: azure.ValidateResourceID,
+										ValidateFunc: azure.ValidateSubnetID,
 									},
 
 									"application_gateway_backend_address_pool_ids": {
@@ -451,7 +460,7 @@ func resourceArmVirtualMachineScaleSet() *schema.Resource {
 										Optional: true,
 										Elem: &schema.Schema{
 											Type:         schema.TypeString,
-											ValidateFunc: azure.ValidateResourceID,
+											ValidateFunc: azure.ValidateApplicationSecurityGroupID,
 										},
 										Set:      schema.HashString,
 										MaxItems: 20,
@@ -753,15 +762,18 @@ func resourceArmVirtualMachineScaleSet() *schema.Resource {
 }
 
 func resourceArmVirtualMachineScaleSetCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).vmScaleSetClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).Compute().VMScaleSetClient
+	rollingUpgradesClient := meta.(*ArmClient).Compute().VMScaleSetRollingUpgradesClient
+	vmsClient := meta.(*ArmClient).Compute().VMScaleSetVMsClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for Azure ARM Virtual Machine Scale Set creation.")
 
 	name := d.Get("name").(string)
 	resGroup := d.Get("resource_group_name").(string)
 
-	if requireResourcesToBeImported && d.IsNewResource() {
+	if meta.(*ArmClient).features.ExistingResources.RequireImport && d.IsNewResource() {
 		existing, err := client.Get(ctx, resGroup, name)
 		if err != nil {
 			if !utils.ResponseWasNotFound(existing.Response) {
@@ -881,6 +893,18 @@ func resourceArmVirtualMachineScaleSetCreateUpdate(d *schema.ResourceData, meta
 		properties.Plan = plan
 	}
 
+	// when the Upgrade Policy is Rolling, the latest Rolling Upgrade is tracked so that any started by this update can be waited on
+	rollInstances := meta.(*ArmClient).features.VirtualMachineScaleSet.RollInstancesWhenRequired
+	waitForRollingUpgrades := meta.(*ArmClient).features.VirtualMachineScaleSet.WaitForRollingUpgrades
+	waitForRollingUpgrade := !d.IsNewResource() && waitForRollingUpgrades && strings.EqualFold(upgradePolicy, string(compute.Rolling))
+	previousRollingUpgradeStartTime := ""
+	if waitForRollingUpgrade {
+		previousRollingUpgradeStartTime, err = virtualMachineScaleSetLatestRollingUpgradeStartTime(ctx, rollingUpgradesClient, resGroup, name)
+		if err != nil {
+			return err
+		}
+	}
+
 	future, err := client.CreateOrUpdate(ctx, resGroup, name, properties)
 	if err != nil {
 		return err
@@ -890,6 +914,38 @@ func resourceArmVirtualMachineScaleSetCreateUpdate(d *schema.ResourceData, meta
 		return err
 	}
 
+	if waitForRollingUpgrade {
+		if err := waitForVirtualMachineScaleSetRollingUpgrade(ctx, rollingUpgradesClient, vmsClient, resGroup, name, previousRollingUpgradeStartTime, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return err
+		}
+	}
+
+	// when the Upgrade Policy is Manual, changes to the model aren't applied to the existing instances until they're upgraded
+	if !d.IsNewResource() && rollInstances && strings.EqualFold(upgradePolicy, string(compute.Manual)) {
+		log.Printf("[DEBUG] Upgrading the Instances of Virtual Machine Scale Set %q (Resource Group %q) to the latest model..", name, resGroup)
+		instanceIds := compute.VirtualMachineScaleSetVMInstanceRequiredIDs{
+			InstanceIds: &[]string{"*"},
+		}
+		upgradeFuture, err := client.UpdateInstances(ctx, resGroup, name, instanceIds)
+		if err != nil {
+			return fmt.Errorf("Error upgrading the Instances of Virtual Machine Scale Set %q (Resource Group %q): %+v", name, resGroup, err)
+		}
+
+		if err = upgradeFuture.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return fmt.Errorf("Error waiting for the Instances of Virtual Machine Scale Set %q (Resource Group %q) to be upgraded: %+v", name, resGroup, err)
+		}
+		log.Printf("[DEBUG] Upgraded the Instances of Virtual Machine Scale Set %q (Resource Group %q).", name, resGroup)
+	}
+
+	// the power state is only changed when it's specified, otherwise any changes made outside of Terraform are retained
+	if d.HasChange("power_state") {
+		if powerState := d.Get("power_state").(string); powerState != "" {
+			if err := setVirtualMachineScaleSetPowerState(ctx, client, resGroup, name, powerState); err != nil {
+				return err
+			}
+		}
+	}
+
 	read, err := client.Get(ctx, resGroup, name)
 	if err != nil {
 		return err
@@ -904,15 +960,16 @@ func resourceArmVirtualMachineScaleSetCreateUpdate(d *schema.ResourceData, meta
 }
 
 func resourceArmVirtualMachineScaleSetRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).vmScaleSetClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).Compute().VMScaleSetClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := azure.ParseVirtualMachineScaleSetID(d.Id())
 	if err != nil {
 		return err
 	}
 	resGroup := id.ResourceGroup
-	name := id.Path["virtualMachineScaleSets"]
+	name := id.Name
 
 	resp, err := client.Get(ctx, resGroup, name)
 	if err != nil {
@@ -1053,21 +1110,31 @@ func resourceArmVirtualMachineScaleSetRead(d *schema.ResourceData, meta interfac
 		}
 	}
 
+	// a Scale Set without any instances has no power state, so the existing value is retained
+	if sku := resp.Sku; sku != nil && sku.Capacity != nil && *sku.Capacity > 0 {
+		powerState, err := virtualMachineScaleSetPowerState(ctx, client, resGroup, name)
+		if err != nil {
+			return err
+		}
+		d.Set("power_state", powerState)
+	}
+
 	flattenAndSetTags(d, resp.Tags)
 
 	return nil
 }
 
 func resourceArmVirtualMachineScaleSetDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).vmScaleSetClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).Compute().VMScaleSetClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := azure.ParseVirtualMachineScaleSetID(d.Id())
 	if err != nil {
 		return err
 	}
 	resGroup := id.ResourceGroup
-	name := id.Path["virtualMachineScaleSets"]
+	name := id.Name
 
 	future, err := client.Delete(ctx, resGroup, name)
 	if err != nil {
@@ -2246,7 +2313,7 @@ func azureRmVirtualMachineScaleSetSuppressRollingUpgradePolicyDiff(k, _, new str
 }
 
 // Make sure rolling_upgrade_policy is default value when upgrade_policy_mode is not Rolling.
-func azureRmVirtualMachineScaleSetCustomizeDiff(d *schema.ResourceDiff, _ interface{}) error {
+func azureRmVirtualMachineScaleSetCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
 	mode := d.Get("upgrade_policy_mode").(string)
 	if strings.ToLower(mode) != "rolling" {
 		if policyRaw, ok := d.GetOk("rolling_upgrade_policy.0"); ok {
@@ -2260,5 +2327,36 @@ func azureRmVirtualMachineScaleSetCustomizeDiff(d *schema.ResourceDiff, _ interf
 			}
 		}
 	}
+
+	if shouldValidateComputeCapacity(d, meta, "location", "sku", "zones") {
+		existing := make([]computeCapacityRequest, 0)
+		if d.Id() != "" && !d.HasChange("location") {
+			oldSku, _ := d.GetChange("sku")
+			existing = expandVirtualMachineScaleSetComputeCapacity(oldSku.([]interface{}))
+		}
+
+		requested := expandVirtualMachineScaleSetComputeCapacity(d.Get("sku").([]interface{}))
+		return validateComputeCapacity(meta, d.Get("location").(string), expandComputeCapacityZones(d, "zones"), existing, requested)
+	}
+
 	return nil
 }
+
+func expandVirtualMachineScaleSetComputeCapacity(input []interface{}) []computeCapacityRequest {
+	output := make([]computeCapacityRequest, 0)
+
+	for _, v := range input {
+		if v == nil {
+			continue
+		}
+
+		sku := v.(map[string]interface{})
+		output = append(output, computeCapacityRequest{
+			ResourceType: computeCapacityResourceTypeVirtualMachines,
+			Sku:          sku["name"].(string),
+			Instances:    int64(sku["capacity"].(int)),
+		})
+	}
+
+	return output
+}
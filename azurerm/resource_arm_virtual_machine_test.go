@@ -3,6 +3,8 @@ package azurerm
 import (
 	"fmt"
 	"net/http"
+	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
@@ -120,7 +122,7 @@ func testCheckAzureRMVirtualMachineExists(resourceName string, vm *compute.Virtu
 			return fmt.Errorf("Bad: no resource group found in state for virtual machine: %s", vmName)
 		}
 
-		client := testAccProvider.Meta().(*ArmClient).vmClient
+		client := testAccProvider.Meta().(*ArmClient).Compute().VMClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		resp, err := client.Get(ctx, resourceGroup, vmName, "")
@@ -139,7 +141,7 @@ func testCheckAzureRMVirtualMachineExists(resourceName string, vm *compute.Virtu
 }
 
 func testCheckAzureRMVirtualMachineDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*ArmClient).vmClient
+	client := testAccProvider.Meta().(*ArmClient).Compute().VMClient
 	ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 	for _, rs := range s.RootModule().Resources {
@@ -550,3 +552,35 @@ resource "azurerm_virtual_machine" "test" {
 }
 `, rInt, location, rInt, rInt, rInt, rInt, rString, rInt, rInt)
 }
+
+func TestAccAzureRMVirtualMachine_computeCapacityUnavailableSku(t *testing.T) {
+	ri := tf.AccRandTimeInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMVirtualMachineDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAzureRMVirtualMachine_computeCapacityUnavailableSku(ri, testLocation()),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("The SKU \"Standard_Unavailable_v2\" is not available"),
+			},
+		},
+	})
+}
+
+func testAccAzureRMVirtualMachine_computeCapacityUnavailableSku(rInt int, location string) string {
+	template := strings.Replace(testAccAzureRMVirtualMachine_basicLinuxMachine(rInt, location), "Standard_D1_v2", "Standard_Unavailable_v2", 1)
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {
+    compute_capacity {
+      validate_during_plan = true
+    }
+  }
+}
+
+%s
+`, template)
+}
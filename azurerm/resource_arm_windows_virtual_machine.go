@@ -0,0 +1,662 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2018-06-01/compute"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmWindowsVirtualMachine() *schema.Resource {
+	return &schema.Resource{
+		Create:   resourceArmWindowsVirtualMachineCreate,
+		Read:     resourceArmWindowsVirtualMachineRead,
+		Update:   resourceArmWindowsVirtualMachineUpdate,
+		Delete:   resourceArmWindowsVirtualMachineDelete,
+		Importer: virtualMachineImporter(compute.Windows, "azurerm_windows_virtual_machine"),
+
+		CustomizeDiff: resourceArmWindowsVirtualMachineCustomizeDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.VirtualMachineName,
+			},
+
+			"resource_group_name": azure.SchemaResourceGroupName(),
+
+			"location": azure.SchemaLocation(),
+
+			"size": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"admin_username": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 20),
+			},
+
+			"admin_password": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Sensitive:    true,
+				ValidateFunc: validation.StringLenBetween(8, 123),
+			},
+
+			"network_interface_ids": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: azure.ValidateResourceID,
+				},
+			},
+
+			"os_disk": virtualMachineOSDiskSchema(),
+
+			"source_image_reference": virtualMachineSourceImageReferenceSchema(),
+
+			"source_image_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ValidateFunc:  azure.ValidateResourceID,
+				ConflictsWith: []string{"source_image_reference"},
+			},
+
+			"plan": virtualMachinePlanSchema(),
+
+			"availability_set_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateAvailabilitySetID,
+				// the API returns this in upper-case
+				DiffSuppressFunc: ignoreCaseDiffSuppressFunc,
+				ConflictsWith:    []string{"zone"},
+			},
+
+			"zone": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"availability_set_id"},
+			},
+
+			"boot_diagnostics": virtualMachineBootDiagnosticsSchema(),
+
+			"identity": virtualMachineIdentitySchema(),
+
+			"computer_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				// defaults to the `name` of the Virtual Machine
+				ValidateFunc: validate.WindowsComputerName,
+			},
+
+			"custom_data": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Sensitive:    true,
+				ValidateFunc: validate.Base64String(),
+			},
+
+			"provision_vm_agent": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  true,
+			},
+
+			"allow_extension_operations": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"enable_automatic_updates": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  true,
+			},
+
+			"timezone": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"license_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"Windows_Client",
+					"Windows_Server",
+				}, false),
+			},
+
+			"winrm_listener": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"protocol": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(compute.HTTP),
+								string(compute.HTTPS),
+							}, false),
+						},
+
+						"certificate_url": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: azure.ValidateKeyVaultChildId,
+						},
+					},
+				},
+			},
+
+			"additional_unattend_content": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"setting": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(compute.AutoLogon),
+								string(compute.FirstLogonCommands),
+							}, false),
+						},
+
+						"content": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							Sensitive:    true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+					},
+				},
+			},
+
+			"secret": virtualMachineSecretSchema(map[string]*schema.Schema{
+				"store": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.NoZeroValues,
+				},
+
+				"url": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: azure.ValidateKeyVaultChildId,
+				},
+			}),
+
+			"tags": tagsSchema(),
+
+			"virtual_machine_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceArmWindowsVirtualMachineCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).Compute().VMClient
+	ctx, cancel := timeouts.ForCreate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+
+	if meta.(*ArmClient).features.ExistingResources.RequireImport {
+		existing, err := client.Get(ctx, resourceGroup, name, "")
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for presence of existing Windows Virtual Machine %q (Resource Group %q): %+v", name, resourceGroup, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_windows_virtual_machine", *existing.ID)
+		}
+	}
+
+	computerName := d.Get("computer_name").(string)
+	if computerName == "" {
+		computerName = name
+	}
+
+	osProfile := compute.OSProfile{
+		AdminUsername:            utils.String(d.Get("admin_username").(string)),
+		AdminPassword:            utils.String(d.Get("admin_password").(string)),
+		ComputerName:             utils.String(computerName),
+		AllowExtensionOperations: utils.Bool(d.Get("allow_extension_operations").(bool)),
+		WindowsConfiguration: &compute.WindowsConfiguration{
+			ProvisionVMAgent:          utils.Bool(d.Get("provision_vm_agent").(bool)),
+			EnableAutomaticUpdates:    utils.Bool(d.Get("enable_automatic_updates").(bool)),
+			AdditionalUnattendContent: expandWindowsVirtualMachineAdditionalUnattendContent(d.Get("additional_unattend_content").([]interface{})),
+			WinRM:                     expandWindowsVirtualMachineWinRMListeners(d.Get("winrm_listener").(*schema.Set).List()),
+		},
+		Secrets: expandVirtualMachineSecrets(d.Get("secret").([]interface{})),
+	}
+
+	if v := d.Get("timezone").(string); v != "" {
+		osProfile.WindowsConfiguration.TimeZone = utils.String(v)
+	}
+
+	if v := d.Get("custom_data").(string); v != "" {
+		osProfile.CustomData = utils.String(v)
+	}
+
+	params := compute.VirtualMachine{
+		Name:     utils.String(name),
+		Location: utils.String(azure.NormalizeLocation(d.Get("location").(string))),
+		VirtualMachineProperties: &compute.VirtualMachineProperties{
+			HardwareProfile: &compute.HardwareProfile{
+				VMSize: compute.VirtualMachineSizeTypes(d.Get("size").(string)),
+			},
+			OsProfile:          &osProfile,
+			NetworkProfile:     expandVirtualMachineNetworkInterfaceIDs(d.Get("network_interface_ids").([]interface{})),
+			DiagnosticsProfile: expandVirtualMachineBootDiagnostics(d.Get("boot_diagnostics").([]interface{})),
+			StorageProfile: &compute.StorageProfile{
+				ImageReference: expandVirtualMachineStorageProfileImageReference(d),
+				OsDisk:         expandVirtualMachineOSDisk(d.Get("os_disk").([]interface{}), compute.Windows),
+			},
+		},
+		Tags: expandTags(d.Get("tags").(map[string]interface{})),
+	}
+
+	if v := d.Get("license_type").(string); v != "" {
+		params.VirtualMachineProperties.LicenseType = utils.String(v)
+	}
+
+	if _, ok := d.GetOk("identity"); ok {
+		params.Identity = expandAzureRmVirtualMachineIdentity(d)
+	}
+
+	if _, ok := d.GetOk("plan"); ok {
+		plan, err := expandAzureRmVirtualMachinePlan(d)
+		if err != nil {
+			return err
+		}
+		params.Plan = plan
+	}
+
+	if v := d.Get("availability_set_id").(string); v != "" {
+		params.VirtualMachineProperties.AvailabilitySet = &compute.SubResource{
+			ID: utils.String(v),
+		}
+	}
+
+	if v := d.Get("zone").(string); v != "" {
+		params.Zones = &[]string{v}
+	}
+
+	azureRMLockByName(name, virtualMachineResourceName)
+	defer azureRMUnlockByName(name, virtualMachineResourceName)
+
+	log.Printf("[DEBUG] Creating Windows Virtual Machine %q (Resource Group %q)..", name, resourceGroup)
+	future, err := client.CreateOrUpdate(ctx, resourceGroup, name, params)
+	if err != nil {
+		return fmt.Errorf("Error creating Windows Virtual Machine %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for creation of Windows Virtual Machine %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	read, err := client.Get(ctx, resourceGroup, name, "")
+	if err != nil {
+		return fmt.Errorf("Error retrieving Windows Virtual Machine %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if read.ID == nil {
+		return fmt.Errorf("Error retrieving Windows Virtual Machine %q (Resource Group %q): `id` was nil", name, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	ipAddress, err := determineVirtualMachineIPAddress(ctx, meta, read.VirtualMachineProperties)
+	if err != nil {
+		return fmt.Errorf("Error determining IP Address for Windows Virtual Machine %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	d.SetConnInfo(map[string]string{
+		"type": "winrm",
+		"host": ipAddress,
+	})
+
+	return resourceArmWindowsVirtualMachineRead(d, meta)
+}
+
+func resourceArmWindowsVirtualMachineRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).Compute().VMClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := azure.ParseVirtualMachineID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.Name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Windows Virtual Machine %q was not found in Resource Group %q - removing from state!", id.Name, id.ResourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Windows Virtual Machine %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", id.ResourceGroup)
+	if location := resp.Location; location != nil {
+		d.Set("location", azure.NormalizeLocation(*location))
+	}
+
+	zone := ""
+	if resp.Zones != nil && len(*resp.Zones) > 0 {
+		zone = (*resp.Zones)[0]
+	}
+	d.Set("zone", zone)
+
+	if err := d.Set("identity", flattenVirtualMachineIdentity(resp.Identity)); err != nil {
+		return fmt.Errorf("Error setting `identity`: %+v", err)
+	}
+
+	if err := d.Set("plan", flattenAzureRmVirtualMachinePlan(resp.Plan)); err != nil {
+		return fmt.Errorf("Error setting `plan`: %+v", err)
+	}
+
+	if props := resp.VirtualMachineProperties; props != nil {
+		availabilitySetId := ""
+		if props.AvailabilitySet != nil && props.AvailabilitySet.ID != nil {
+			availabilitySetId = *props.AvailabilitySet.ID
+		}
+		d.Set("availability_set_id", availabilitySetId)
+
+		if profile := props.HardwareProfile; profile != nil {
+			d.Set("size", string(profile.VMSize))
+		}
+
+		licenseType := ""
+		if props.LicenseType != nil && *props.LicenseType != "None" {
+			licenseType = *props.LicenseType
+		}
+		d.Set("license_type", licenseType)
+
+		if err := d.Set("boot_diagnostics", flattenVirtualMachineBootDiagnostics(props.DiagnosticsProfile)); err != nil {
+			return fmt.Errorf("Error setting `boot_diagnostics`: %+v", err)
+		}
+
+		if err := d.Set("network_interface_ids", flattenVirtualMachineNetworkInterfaceIDs(props.NetworkProfile)); err != nil {
+			return fmt.Errorf("Error setting `network_interface_ids`: %+v", err)
+		}
+
+		if profile := props.OsProfile; profile != nil {
+			d.Set("admin_username", profile.AdminUsername)
+			d.Set("computer_name", profile.ComputerName)
+
+			allowExtensionOperations := true
+			if profile.AllowExtensionOperations != nil {
+				allowExtensionOperations = *profile.AllowExtensionOperations
+			}
+			d.Set("allow_extension_operations", allowExtensionOperations)
+
+			if config := profile.WindowsConfiguration; config != nil {
+				enableAutomaticUpdates := false
+				if config.EnableAutomaticUpdates != nil {
+					enableAutomaticUpdates = *config.EnableAutomaticUpdates
+				}
+				d.Set("enable_automatic_updates", enableAutomaticUpdates)
+
+				provisionVMAgent := true
+				if config.ProvisionVMAgent != nil {
+					provisionVMAgent = *config.ProvisionVMAgent
+				}
+				d.Set("provision_vm_agent", provisionVMAgent)
+
+				d.Set("timezone", config.TimeZone)
+
+				// the content of the Additional Unattend Content isn't returned from the API
+				existingUnattendContent := d.Get("additional_unattend_content").([]interface{})
+				if err := d.Set("additional_unattend_content", flattenWindowsVirtualMachineAdditionalUnattendContent(config.AdditionalUnattendContent, existingUnattendContent)); err != nil {
+					return fmt.Errorf("Error setting `additional_unattend_content`: %+v", err)
+				}
+
+				if err := d.Set("winrm_listener", flattenWindowsVirtualMachineWinRMListeners(config.WinRM)); err != nil {
+					return fmt.Errorf("Error setting `winrm_listener`: %+v", err)
+				}
+			}
+
+			if err := d.Set("secret", flattenVirtualMachineSecrets(profile.Secrets, true)); err != nil {
+				return fmt.Errorf("Error setting `secret`: %+v", err)
+			}
+		}
+
+		if profile := props.StorageProfile; profile != nil {
+			if err := d.Set("os_disk", flattenVirtualMachineOSDisk(profile.OsDisk)); err != nil {
+				return fmt.Errorf("Error setting `os_disk`: %+v", err)
+			}
+
+			sourceImageId := ""
+			if profile.ImageReference != nil && profile.ImageReference.ID != nil {
+				sourceImageId = *profile.ImageReference.ID
+			}
+			d.Set("source_image_id", sourceImageId)
+
+			if err := d.Set("source_image_reference", flattenVirtualMachineSourceImageReference(profile.ImageReference)); err != nil {
+				return fmt.Errorf("Error setting `source_image_reference`: %+v", err)
+			}
+		}
+
+		d.Set("virtual_machine_id", props.VMID)
+	}
+
+	flattenAndSetTags(d, resp.Tags)
+
+	return nil
+}
+
+func resourceArmWindowsVirtualMachineUpdate(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := timeouts.ForUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	update := compute.VirtualMachineUpdate{
+		VirtualMachineProperties: &compute.VirtualMachineProperties{},
+	}
+
+	if d.HasChange("allow_extension_operations") || d.HasChange("secret") {
+		update.VirtualMachineProperties.OsProfile = &compute.OSProfile{
+			AllowExtensionOperations: utils.Bool(d.Get("allow_extension_operations").(bool)),
+			Secrets:                  expandVirtualMachineSecrets(d.Get("secret").([]interface{})),
+		}
+	}
+
+	if d.HasChange("license_type") {
+		// the License Type has to be explicitly set to `None` to remove it
+		licenseType := "None"
+		if v := d.Get("license_type").(string); v != "" {
+			licenseType = v
+		}
+		update.VirtualMachineProperties.LicenseType = utils.String(licenseType)
+	}
+
+	if err := updateVirtualMachine(ctx, d, meta, "Windows", update); err != nil {
+		return err
+	}
+
+	return resourceArmWindowsVirtualMachineRead(d, meta)
+}
+
+func resourceArmWindowsVirtualMachineDelete(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	return deleteVirtualMachine(ctx, d, meta, "Windows")
+}
+
+func resourceArmWindowsVirtualMachineCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	if err := validateVirtualMachineSourceImage(d); err != nil {
+		return err
+	}
+
+	if err := validateVirtualMachineOSDisk(d); err != nil {
+		return err
+	}
+
+	// the Computer Name defaults to the name of the Virtual Machine, which can be longer than a Windows Computer Name
+	if d.Id() == "" && d.NewValueKnown("name") && d.NewValueKnown("computer_name") && d.Get("computer_name").(string) == "" {
+		name := d.Get("name").(string)
+		if _, errs := validate.WindowsComputerName(name, "name"); len(errs) > 0 {
+			return fmt.Errorf("`computer_name` must be specified since the `name` %q isn't a valid Windows Computer Name: %+v", name, errs[0])
+		}
+	}
+
+	if d.NewValueKnown("winrm_listener") {
+		for _, raw := range d.Get("winrm_listener").(*schema.Set).List() {
+			listener := raw.(map[string]interface{})
+			protocol := listener["protocol"].(string)
+			certificateUrl := listener["certificate_url"].(string)
+
+			if protocol == string(compute.HTTPS) && certificateUrl == "" {
+				return fmt.Errorf("A `certificate_url` must be specified for a `winrm_listener` using the `Https` protocol")
+			}
+
+			if protocol == string(compute.HTTP) && certificateUrl != "" {
+				return fmt.Errorf("A `certificate_url` cannot be specified for a `winrm_listener` using the `Http` protocol")
+			}
+		}
+	}
+
+	return validateVirtualMachineComputeCapacity(d, meta)
+}
+
+func expandWindowsVirtualMachineAdditionalUnattendContent(input []interface{}) *[]compute.AdditionalUnattendContent {
+	output := make([]compute.AdditionalUnattendContent, 0)
+
+	for _, v := range input {
+		raw := v.(map[string]interface{})
+
+		output = append(output, compute.AdditionalUnattendContent{
+			SettingName: compute.SettingNames(raw["setting"].(string)),
+			Content:     utils.String(raw["content"].(string)),
+
+			// no other possible values
+			PassName:      compute.OobeSystem,
+			ComponentName: compute.MicrosoftWindowsShellSetup,
+		})
+	}
+
+	return &output
+}
+
+func flattenWindowsVirtualMachineAdditionalUnattendContent(input *[]compute.AdditionalUnattendContent, existing []interface{}) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	output := make([]interface{}, 0)
+	for i, v := range *input {
+		content := ""
+		if len(existing) > i {
+			if raw, ok := existing[i].(map[string]interface{}); ok {
+				if setting := raw["setting"].(string); setting == string(v.SettingName) {
+					content = raw["content"].(string)
+				}
+			}
+		}
+
+		output = append(output, map[string]interface{}{
+			"setting": string(v.SettingName),
+			"content": content,
+		})
+	}
+
+	return output
+}
+
+func expandWindowsVirtualMachineWinRMListeners(input []interface{}) *compute.WinRMConfiguration {
+	listeners := make([]compute.WinRMListener, 0)
+
+	for _, v := range input {
+		raw := v.(map[string]interface{})
+
+		listener := compute.WinRMListener{
+			Protocol: compute.ProtocolTypes(raw["protocol"].(string)),
+		}
+
+		if certificateUrl := raw["certificate_url"].(string); certificateUrl != "" {
+			listener.CertificateURL = utils.String(certificateUrl)
+		}
+
+		listeners = append(listeners, listener)
+	}
+
+	return &compute.WinRMConfiguration{
+		Listeners: &listeners,
+	}
+}
+
+func flattenWindowsVirtualMachineWinRMListeners(input *compute.WinRMConfiguration) []interface{} {
+	if input == nil || input.Listeners == nil {
+		return []interface{}{}
+	}
+
+	output := make([]interface{}, 0)
+	for _, v := range *input.Listeners {
+		certificateUrl := ""
+		if v.CertificateURL != nil {
+			certificateUrl = *v.CertificateURL
+		}
+
+		output = append(output, map[string]interface{}{
+			"protocol":        string(v.Protocol),
+			"certificate_url": certificateUrl,
+		})
+	}
+
+	return output
+}
@@ -0,0 +1,48 @@
+package azurerm
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+func TestAccAzureRMResourceProvidersAreRegisteredLazily(t *testing.T) {
+	config := testGetAzureConfig(t)
+	if config == nil {
+		return
+	}
+
+	armClient, err := getArmClient(config, false, "", azure.DefaultRetryOptions(), azure.LogRedactionOptions{}, false, false, azure.EnvironmentOptions{}, nil)
+	if err != nil {
+		t.Fatalf("Error building ARM Client: %+v", err)
+	}
+
+	registrar := armClient.clientOptions.ResourceProviderRegistrar
+	if registrar == nil {
+		t.Fatalf("Expected a Resource Provider Registrar to be configured")
+	}
+
+	client := armClient.Resource().ProvidersClient
+	ctx := testAccProvider.StopContext()
+
+	namespaces := []string{
+		"Microsoft.Compute",
+		"Microsoft.Network",
+		"Microsoft.Storage",
+	}
+	for _, namespace := range namespaces {
+		if err := registrar.EnsureRegistered(ctx, namespace); err != nil {
+			t.Fatalf("Error registering Resource Provider %q: %+v", namespace, err)
+		}
+
+		provider, err := client.Get(ctx, namespace, "")
+		if err != nil {
+			t.Fatalf("Error retrieving Resource Provider %q: %+v", namespace, err)
+		}
+
+		if provider.RegistrationState == nil || !strings.EqualFold(*provider.RegistrationState, "Registered") {
+			t.Fatalf("Expected Resource Provider %q to be Registered", namespace)
+		}
+	}
+}
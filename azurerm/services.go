@@ -0,0 +1,604 @@
+package azurerm
+
+import (
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/apimgmt"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/applicationinsights"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/authorization"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/automation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/batch"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/cdn"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/cognitive"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/compute"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/containers"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/cosmos"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/databricks"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/datafactory"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/datalake"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/devspace"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/devtestlabs"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/dns"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/eventgrid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/eventhub"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/graph"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/hdinsight"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/iothub"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/keyvault"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/loganalytics"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/logic"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/managementgroup"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/mariadb"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/media"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/monitor"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/msi"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/mssql"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/mysql"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/notificationhub"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/policy"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/postgres"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/recoveryservices"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/redis"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/relay"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/resource"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/scheduler"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/search"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/securitycenter"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/servicebus"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/servicefabric"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/signalr"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/sql"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/storage"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/streamanalytics"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/trafficmanager"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/web"
+)
+
+func (c *ArmClient) ApiManagement() *apimgmt.Client {
+	c.servicesLock.Lock()
+	defer c.servicesLock.Unlock()
+
+	if c.apimgmt == nil {
+		c.apimgmt = apimgmt.BuildClient(c.clientOptions)
+	}
+
+	return c.apimgmt
+}
+
+func (c *ArmClient) AppInsights() *applicationinsights.Client {
+	c.servicesLock.Lock()
+	defer c.servicesLock.Unlock()
+
+	if c.appInsights == nil {
+		c.appInsights = applicationinsights.BuildClient(c.clientOptions)
+	}
+
+	return c.appInsights
+}
+
+func (c *ArmClient) Authorization() *authorization.Client {
+	c.servicesLock.Lock()
+	defer c.servicesLock.Unlock()
+
+	if c.authorization == nil {
+		c.authorization = authorization.BuildClient(c.clientOptions)
+	}
+
+	return c.authorization
+}
+
+func (c *ArmClient) Automation() *automation.Client {
+	c.servicesLock.Lock()
+	defer c.servicesLock.Unlock()
+
+	if c.automation == nil {
+		c.automation = automation.BuildClient(c.clientOptions)
+	}
+
+	return c.automation
+}
+
+func (c *ArmClient) Batch() *batch.Client {
+	c.servicesLock.Lock()
+	defer c.servicesLock.Unlock()
+
+	if c.batch == nil {
+		c.batch = batch.BuildClient(c.clientOptions)
+	}
+
+	return c.batch
+}
+
+func (c *ArmClient) Cdn() *cdn.Client {
+	c.servicesLock.Lock()
+	defer c.servicesLock.Unlock()
+
+	if c.cdn == nil {
+		c.cdn = cdn.BuildClient(c.clientOptions)
+	}
+
+	return c.cdn
+}
+
+func (c *ArmClient) Cognitive() *cognitive.Client {
+	c.servicesLock.Lock()
+	defer c.servicesLock.Unlock()
+
+	if c.cognitive == nil {
+		c.cognitive = cognitive.BuildClient(c.clientOptions)
+	}
+
+	return c.cognitive
+}
+
+func (c *ArmClient) Compute() *compute.Client {
+	c.servicesLock.Lock()
+	defer c.servicesLock.Unlock()
+
+	if c.compute == nil {
+		c.compute = compute.BuildClient(c.clientOptions)
+	}
+
+	return c.compute
+}
+
+func (c *ArmClient) Containers() *containers.Client {
+	c.servicesLock.Lock()
+	defer c.servicesLock.Unlock()
+
+	if c.containers == nil {
+		c.containers = containers.BuildClient(c.clientOptions)
+	}
+
+	return c.containers
+}
+
+func (c *ArmClient) Cosmos() *cosmos.Client {
+	c.servicesLock.Lock()
+	defer c.servicesLock.Unlock()
+
+	if c.cosmos == nil {
+		c.cosmos = cosmos.BuildClient(c.clientOptions)
+	}
+
+	return c.cosmos
+}
+
+func (c *ArmClient) Databricks() *databricks.Client {
+	c.servicesLock.Lock()
+	defer c.servicesLock.Unlock()
+
+	if c.databricks == nil {
+		c.databricks = databricks.BuildClient(c.clientOptions)
+	}
+
+	return c.databricks
+}
+
+func (c *ArmClient) DataFactory() *datafactory.Client {
+	c.servicesLock.Lock()
+	defer c.servicesLock.Unlock()
+
+	if c.dataFactory == nil {
+		c.dataFactory = datafactory.BuildClient(c.clientOptions)
+	}
+
+	return c.dataFactory
+}
+
+func (c *ArmClient) DataLake() *datalake.Client {
+	c.servicesLock.Lock()
+	defer c.servicesLock.Unlock()
+
+	if c.dataLake == nil {
+		c.dataLake = datalake.BuildClient(c.clientOptions)
+	}
+
+	return c.dataLake
+}
+
+func (c *ArmClient) DevSpace() *devspace.Client {
+	c.servicesLock.Lock()
+	defer c.servicesLock.Unlock()
+
+	if c.devSpace == nil {
+		c.devSpace = devspace.BuildClient(c.clientOptions)
+	}
+
+	return c.devSpace
+}
+
+func (c *ArmClient) DevTestLabs() *devtestlabs.Client {
+	c.servicesLock.Lock()
+	defer c.servicesLock.Unlock()
+
+	if c.devTestLabs == nil {
+		c.devTestLabs = devtestlabs.BuildClient(c.clientOptions)
+	}
+
+	return c.devTestLabs
+}
+
+func (c *ArmClient) Dns() *dns.Client {
+	c.servicesLock.Lock()
+	defer c.servicesLock.Unlock()
+
+	if c.dns == nil {
+		c.dns = dns.BuildClient(c.clientOptions)
+	}
+
+	return c.dns
+}
+
+func (c *ArmClient) EventGrid() *eventgrid.Client {
+	c.servicesLock.Lock()
+	defer c.servicesLock.Unlock()
+
+	if c.eventGrid == nil {
+		c.eventGrid = eventgrid.BuildClient(c.clientOptions)
+	}
+
+	return c.eventGrid
+}
+
+func (c *ArmClient) EventHub() *eventhub.Client {
+	c.servicesLock.Lock()
+	defer c.servicesLock.Unlock()
+
+	if c.eventhub == nil {
+		c.eventhub = eventhub.BuildClient(c.clientOptions)
+	}
+
+	return c.eventhub
+}
+
+func (c *ArmClient) Graph() *graph.Client {
+	c.servicesLock.Lock()
+	defer c.servicesLock.Unlock()
+
+	if c.graph == nil {
+		c.graph = graph.BuildClient(c.clientOptions)
+	}
+
+	return c.graph
+}
+
+func (c *ArmClient) HDInsight() *hdinsight.Client {
+	c.servicesLock.Lock()
+	defer c.servicesLock.Unlock()
+
+	if c.hdinsight == nil {
+		c.hdinsight = hdinsight.BuildClient(c.clientOptions)
+	}
+
+	return c.hdinsight
+}
+
+func (c *ArmClient) IoTHub() *iothub.Client {
+	c.servicesLock.Lock()
+	defer c.servicesLock.Unlock()
+
+	if c.iothub == nil {
+		c.iothub = iothub.BuildClient(c.clientOptions)
+	}
+
+	return c.iothub
+}
+
+func (c *ArmClient) KeyVault() *keyvault.Client {
+	c.servicesLock.Lock()
+	defer c.servicesLock.Unlock()
+
+	if c.keyVault == nil {
+		c.keyVault = keyvault.BuildClient(c.clientOptions)
+	}
+
+	return c.keyVault
+}
+
+func (c *ArmClient) LogAnalytics() *loganalytics.Client {
+	c.servicesLock.Lock()
+	defer c.servicesLock.Unlock()
+
+	if c.logAnalytics == nil {
+		c.logAnalytics = loganalytics.BuildClient(c.clientOptions)
+	}
+
+	return c.logAnalytics
+}
+
+func (c *ArmClient) Logic() *logic.Client {
+	c.servicesLock.Lock()
+	defer c.servicesLock.Unlock()
+
+	if c.logic == nil {
+		c.logic = logic.BuildClient(c.clientOptions)
+	}
+
+	return c.logic
+}
+
+func (c *ArmClient) ManagementGroups() *managementgroup.Client {
+	c.servicesLock.Lock()
+	defer c.servicesLock.Unlock()
+
+	if c.managementGroups == nil {
+		c.managementGroups = managementgroup.BuildClient(c.clientOptions)
+	}
+
+	return c.managementGroups
+}
+
+func (c *ArmClient) MariaDB() *mariadb.Client {
+	c.servicesLock.Lock()
+	defer c.servicesLock.Unlock()
+
+	if c.mariadb == nil {
+		c.mariadb = mariadb.BuildClient(c.clientOptions)
+	}
+
+	return c.mariadb
+}
+
+func (c *ArmClient) Media() *media.Client {
+	c.servicesLock.Lock()
+	defer c.servicesLock.Unlock()
+
+	if c.media == nil {
+		c.media = media.BuildClient(c.clientOptions)
+	}
+
+	return c.media
+}
+
+func (c *ArmClient) Monitor() *monitor.Client {
+	c.servicesLock.Lock()
+	defer c.servicesLock.Unlock()
+
+	if c.monitor == nil {
+		c.monitor = monitor.BuildClient(c.clientOptions)
+	}
+
+	return c.monitor
+}
+
+func (c *ArmClient) Msi() *msi.Client {
+	c.servicesLock.Lock()
+	defer c.servicesLock.Unlock()
+
+	if c.msi == nil {
+		c.msi = msi.BuildClient(c.clientOptions)
+	}
+
+	return c.msi
+}
+
+func (c *ArmClient) MsSql() *mssql.Client {
+	c.servicesLock.Lock()
+	defer c.servicesLock.Unlock()
+
+	if c.mssql == nil {
+		c.mssql = mssql.BuildClient(c.clientOptions)
+	}
+
+	return c.mssql
+}
+
+func (c *ArmClient) MySQL() *mysql.Client {
+	c.servicesLock.Lock()
+	defer c.servicesLock.Unlock()
+
+	if c.mysql == nil {
+		c.mysql = mysql.BuildClient(c.clientOptions)
+	}
+
+	return c.mysql
+}
+
+func (c *ArmClient) Network() *network.Client {
+	c.servicesLock.Lock()
+	defer c.servicesLock.Unlock()
+
+	if c.network == nil {
+		c.network = network.BuildClient(c.clientOptions)
+	}
+
+	return c.network
+}
+
+func (c *ArmClient) NotificationHubs() *notificationhub.Client {
+	c.servicesLock.Lock()
+	defer c.servicesLock.Unlock()
+
+	if c.notificationHubs == nil {
+		c.notificationHubs = notificationhub.BuildClient(c.clientOptions)
+	}
+
+	return c.notificationHubs
+}
+
+func (c *ArmClient) Policy() *policy.Client {
+	c.servicesLock.Lock()
+	defer c.servicesLock.Unlock()
+
+	if c.policy == nil {
+		c.policy = policy.BuildClient(c.clientOptions)
+	}
+
+	return c.policy
+}
+
+func (c *ArmClient) Postgres() *postgres.Client {
+	c.servicesLock.Lock()
+	defer c.servicesLock.Unlock()
+
+	if c.postgres == nil {
+		c.postgres = postgres.BuildClient(c.clientOptions)
+	}
+
+	return c.postgres
+}
+
+func (c *ArmClient) RecoveryServices() *recoveryservices.Client {
+	c.servicesLock.Lock()
+	defer c.servicesLock.Unlock()
+
+	if c.recoveryServices == nil {
+		c.recoveryServices = recoveryservices.BuildClient(c.clientOptions)
+	}
+
+	return c.recoveryServices
+}
+
+func (c *ArmClient) Redis() *redis.Client {
+	c.servicesLock.Lock()
+	defer c.servicesLock.Unlock()
+
+	if c.redis == nil {
+		c.redis = redis.BuildClient(c.clientOptions)
+	}
+
+	return c.redis
+}
+
+func (c *ArmClient) Relay() *relay.Client {
+	c.servicesLock.Lock()
+	defer c.servicesLock.Unlock()
+
+	if c.relay == nil {
+		c.relay = relay.BuildClient(c.clientOptions)
+	}
+
+	return c.relay
+}
+
+func (c *ArmClient) Resource() *resource.Client {
+	c.servicesLock.Lock()
+	defer c.servicesLock.Unlock()
+
+	if c.resource == nil {
+		c.resource = resource.BuildClient(c.clientOptions)
+	}
+
+	return c.resource
+}
+
+func (c *ArmClient) Scheduler() *scheduler.Client {
+	c.servicesLock.Lock()
+	defer c.servicesLock.Unlock()
+
+	if c.scheduler == nil {
+		c.scheduler = scheduler.BuildClient(c.clientOptions)
+	}
+
+	return c.scheduler
+}
+
+func (c *ArmClient) Search() *search.Client {
+	c.servicesLock.Lock()
+	defer c.servicesLock.Unlock()
+
+	if c.search == nil {
+		c.search = search.BuildClient(c.clientOptions)
+	}
+
+	return c.search
+}
+
+func (c *ArmClient) SecurityCenter() *securitycenter.Client {
+	c.servicesLock.Lock()
+	defer c.servicesLock.Unlock()
+
+	if c.securityCenter == nil {
+		c.securityCenter = securitycenter.BuildClient(c.clientOptions)
+	}
+
+	return c.securityCenter
+}
+
+func (c *ArmClient) ServiceBus() *servicebus.Client {
+	c.servicesLock.Lock()
+	defer c.servicesLock.Unlock()
+
+	if c.servicebus == nil {
+		c.servicebus = servicebus.BuildClient(c.clientOptions)
+	}
+
+	return c.servicebus
+}
+
+func (c *ArmClient) ServiceFabric() *servicefabric.Client {
+	c.servicesLock.Lock()
+	defer c.servicesLock.Unlock()
+
+	if c.serviceFabric == nil {
+		c.serviceFabric = servicefabric.BuildClient(c.clientOptions)
+	}
+
+	return c.serviceFabric
+}
+
+func (c *ArmClient) SignalR() *signalr.Client {
+	c.servicesLock.Lock()
+	defer c.servicesLock.Unlock()
+
+	if c.signalr == nil {
+		c.signalr = signalr.BuildClient(c.clientOptions)
+	}
+
+	return c.signalr
+}
+
+func (c *ArmClient) Sql() *sql.Client {
+	c.servicesLock.Lock()
+	defer c.servicesLock.Unlock()
+
+	if c.sql == nil {
+		c.sql = sql.BuildClient(c.clientOptions)
+	}
+
+	return c.sql
+}
+
+func (c *ArmClient) Storage() *storage.Client {
+	c.servicesLock.Lock()
+	defer c.servicesLock.Unlock()
+
+	if c.storage == nil {
+		c.storage = storage.BuildClient(c.clientOptions)
+	}
+
+	return c.storage
+}
+
+func (c *ArmClient) StreamAnalytics() *streamanalytics.Client {
+	c.servicesLock.Lock()
+	defer c.servicesLock.Unlock()
+
+	if c.streamAnalytics == nil {
+		c.streamAnalytics = streamanalytics.BuildClient(c.clientOptions)
+	}
+
+	return c.streamAnalytics
+}
+
+func (c *ArmClient) TrafficManager() *trafficmanager.Client {
+	c.servicesLock.Lock()
+	defer c.servicesLock.Unlock()
+
+	if c.trafficManager == nil {
+		c.trafficManager = trafficmanager.BuildClient(c.clientOptions)
+	}
+
+	return c.trafficManager
+}
+
+func (c *ArmClient) Web() *web.Client {
+	c.servicesLock.Lock()
+	defer c.servicesLock.Unlock()
+
+	if c.web == nil {
+		c.web = web.BuildClient(c.clientOptions)
+	}
+
+	return c.web
+}
@@ -0,0 +1,47 @@
+package azurerm
+
+import (
+	"testing"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/common"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/redis"
+)
+
+func TestArmClientServicesAreBuiltLazily(t *testing.T) {
+	client := &ArmClient{
+		clientOptions: &common.ClientOptions{
+			ResourceManagerEndpoint: "https://management.azure.com/",
+			SubscriptionId:          "00000000-0000-0000-0000-000000000000",
+		},
+	}
+
+	if client.compute != nil {
+		t.Fatalf("Expected the Compute Service not to be built until it's accessed")
+	}
+
+	first := client.Compute()
+	if first == nil {
+		t.Fatalf("Expected the Compute Service to be built on first access")
+	}
+	if first.VMClient.BaseURI != "https://management.azure.com/" {
+		t.Fatalf("Expected the Compute Service to use the configured endpoint but got %q", first.VMClient.BaseURI)
+	}
+	if second := client.Compute(); first != second {
+		t.Fatalf("Expected the Compute Service to only be built once")
+	}
+
+	if client.network != nil {
+		t.Fatalf("Expected the Network Service not to be built when another Service is accessed")
+	}
+}
+
+func TestArmClientServicesCanBeInjected(t *testing.T) {
+	fake := &redis.Client{}
+	client := &ArmClient{
+		redis: fake,
+	}
+
+	if actual := client.Redis(); actual != fake {
+		t.Fatalf("Expected the injected Redis Service to be returned")
+	}
+}
@@ -2,6 +2,7 @@ package azurerm
 
 import (
 	"fmt"
+	"reflect"
 	"strings"
 
 	"github.com/hashicorp/terraform/helper/schema"
@@ -114,3 +115,142 @@ func flattenAndSetTags(d *schema.ResourceData, tagMap map[string]*string) {
 
 	d.Set("tags", output)
 }
+
+// addDefaultTagsToResource adds the computed `tags_all` attribute to a resource which supports tags, and
+// wraps its CRUD functions so that the `default_tags` configured on the Provider are sent alongside the
+// tags configured on the resource - without showing up as a diff on the `tags` field
+func addDefaultTagsToResource(r *schema.Resource) {
+	tags, ok := r.Schema["tags"]
+	if !ok || tags.Type != schema.TypeMap || !tags.Optional {
+		return
+	}
+
+	// resources which can't be updated in-place need to be recreated when the Default Tags change
+	r.Schema["tags_all"] = &schema.Schema{
+		Type:     schema.TypeMap,
+		Computed: true,
+		ForceNew: r.Update == nil,
+	}
+
+	customizeDiff := r.CustomizeDiff
+	r.CustomizeDiff = func(d *schema.ResourceDiff, meta interface{}) error {
+		if customizeDiff != nil {
+			if err := customizeDiff(d, meta); err != nil {
+				return err
+			}
+		}
+
+		if !d.NewValueKnown("tags") {
+			return d.SetNewComputed("tags_all")
+		}
+
+		allTags := mergeDefaultTags(defaultTagsFromMeta(meta), d.Get("tags").(map[string]interface{}))
+		if existing := d.Get("tags_all").(map[string]interface{}); reflect.DeepEqual(existing, allTags) {
+			return nil
+		}
+
+		return d.SetNew("tags_all", allTags)
+	}
+
+	r.Create = wrapCreateUpdateWithDefaultTags(r.Create)
+	if r.Update != nil {
+		r.Update = wrapCreateUpdateWithDefaultTags(r.Update)
+	}
+
+	read := r.Read
+	r.Read = func(d *schema.ResourceData, meta interface{}) error {
+		configuredTags := d.Get("tags").(map[string]interface{})
+
+		if err := read(d, meta); err != nil {
+			return err
+		}
+
+		// the resource's been removed
+		if d.Id() == "" {
+			return nil
+		}
+
+		return flattenAndSetDefaultTags(d, defaultTagsFromMeta(meta), configuredTags)
+	}
+}
+
+func wrapCreateUpdateWithDefaultTags(f func(*schema.ResourceData, interface{}) error) func(*schema.ResourceData, interface{}) error {
+	return func(d *schema.ResourceData, meta interface{}) error {
+		defaultTags := defaultTagsFromMeta(meta)
+		configuredTags := d.Get("tags").(map[string]interface{})
+
+		if err := d.Set("tags", mergeDefaultTags(defaultTags, configuredTags)); err != nil {
+			return fmt.Errorf("Error merging the Default Tags into `tags`: %+v", err)
+		}
+
+		err := f(d, meta)
+
+		// the Read function of the resource will have set all of the tags returned from Azure into `tags` - as such
+		// we need to remove the Default Tags which haven't been configured on the resource
+		if d.Id() != "" {
+			if setErr := flattenAndSetDefaultTags(d, defaultTags, configuredTags); setErr != nil && err == nil {
+				return setErr
+			}
+		}
+
+		return err
+	}
+}
+
+// defaultTagsFromMeta returns the Default Tags configured on the Provider - which are empty when the Provider
+// hasn't been configured (e.g. when the resource is used during validation)
+func defaultTagsFromMeta(meta interface{}) map[string]interface{} {
+	if meta == nil {
+		return nil
+	}
+
+	return meta.(*ArmClient).defaultTags
+}
+
+// mergeDefaultTags returns the tags configured on the resource merged into the Default Tags
+// configured on the Provider, where the tags configured on the resource take precedence
+func mergeDefaultTags(defaultTags map[string]interface{}, tagsMap map[string]interface{}) map[string]interface{} {
+	output := make(map[string]interface{}, len(defaultTags)+len(tagsMap))
+
+	for k, v := range defaultTags {
+		output[k] = v
+	}
+
+	for k, v := range tagsMap {
+		output[k] = v
+	}
+
+	return output
+}
+
+// removeDefaultTags returns the tags assigned to a resource, less any Default Tags which weren't explicitly
+// configured on the resource (and haven't been changed outside of Terraform)
+func removeDefaultTags(defaultTags map[string]interface{}, allTags map[string]interface{}, configuredTags map[string]interface{}) map[string]interface{} {
+	output := make(map[string]interface{}, len(allTags))
+
+	for k, v := range allTags {
+		if _, configured := configuredTags[k]; !configured {
+			if defaultValue, isDefault := defaultTags[k]; isDefault && defaultValue == v {
+				continue
+			}
+		}
+
+		output[k] = v
+	}
+
+	return output
+}
+
+func flattenAndSetDefaultTags(d *schema.ResourceData, defaultTags map[string]interface{}, configuredTags map[string]interface{}) error {
+	allTags := d.Get("tags").(map[string]interface{})
+
+	if err := d.Set("tags_all", allTags); err != nil {
+		return fmt.Errorf("Error setting `tags_all`: %+v", err)
+	}
+
+	if err := d.Set("tags", removeDefaultTags(defaultTags, allTags, configuredTags)); err != nil {
+		return fmt.Errorf("Error setting `tags`: %+v", err)
+	}
+
+	return nil
+}
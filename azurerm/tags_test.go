@@ -2,8 +2,11 @@ package azurerm
 
 import (
 	"fmt"
+	"reflect"
 	"strings"
 	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
 )
 
 func TestValidateMaximumNumberOfARMTags(t *testing.T) {
@@ -113,3 +116,132 @@ func TestFilterARMTags(t *testing.T) {
 		t.Fatalf("Expected %v in filtered tag map, got %v", valueData[1], *filtered["key2"])
 	}
 }
+
+func TestMergeDefaultARMTags(t *testing.T) {
+	defaultTags := map[string]interface{}{
+		"environment": "Production",
+		"owner":       "ops",
+	}
+	testData := map[string]interface{}{
+		"environment": "Staging",
+		"project":     "example",
+	}
+
+	merged := mergeDefaultTags(defaultTags, testData)
+
+	expected := map[string]interface{}{
+		"environment": "Staging",
+		"owner":       "ops",
+		"project":     "example",
+	}
+	if !reflect.DeepEqual(merged, expected) {
+		t.Fatalf("Expected %+v but got %+v", expected, merged)
+	}
+}
+
+func TestRemoveDefaultARMTags(t *testing.T) {
+	defaultTags := map[string]interface{}{
+		"environment": "Production",
+		"owner":       "ops",
+		"team":        "platform",
+	}
+	allTags := map[string]interface{}{
+		"environment": "Production",
+		"owner":       "someone-else",
+		"project":     "example",
+		"team":        "platform",
+	}
+	configuredTags := map[string]interface{}{
+		"project": "example",
+		"team":    "platform",
+	}
+
+	actual := removeDefaultTags(defaultTags, allTags, configuredTags)
+
+	// `environment` matches the Default Tag and isn't configured, `owner` has been changed outside of Terraform
+	// and `team` is configured explicitly on the resource
+	expected := map[string]interface{}{
+		"owner":   "someone-else",
+		"project": "example",
+		"team":    "platform",
+	}
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
+func TestAddDefaultTagsToResource(t *testing.T) {
+	var createdWithTags map[string]interface{}
+	r := &schema.Resource{
+		Create: func(d *schema.ResourceData, meta interface{}) error {
+			createdWithTags = d.Get("tags").(map[string]interface{})
+			d.SetId("example")
+			return nil
+		},
+		Read: func(d *schema.ResourceData, meta interface{}) error {
+			return nil
+		},
+		Delete: func(d *schema.ResourceData, meta interface{}) error {
+			return nil
+		},
+		Schema: map[string]*schema.Schema{
+			"tags": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+			},
+		},
+	}
+
+	addDefaultTagsToResource(r)
+
+	tagsAll, ok := r.Schema["tags_all"]
+	if !ok {
+		t.Fatalf("Expected `tags_all` to be added to the Schema")
+	}
+	if !tagsAll.Computed || !tagsAll.ForceNew {
+		t.Fatalf("Expected `tags_all` to be Computed and ForceNew, since the resource doesn't support Update")
+	}
+
+	// the Provider isn't configured during validation, so the wrappers mustn't depend on it
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"tags": map[string]interface{}{
+			"environment": "Production",
+		},
+	})
+	if err := r.Create(d, nil); err != nil {
+		t.Fatalf("Expected no error but got: %+v", err)
+	}
+
+	expected := map[string]interface{}{
+		"environment": "Production",
+	}
+	if !reflect.DeepEqual(createdWithTags, expected) {
+		t.Fatalf("Expected %+v but got %+v", expected, createdWithTags)
+	}
+	if actual := d.Get("tags_all").(map[string]interface{}); !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("Expected `tags_all` to be %+v but got %+v", expected, actual)
+	}
+}
+
+func TestDefaultTagsForceNewWhenUpdateIsUnsupported(t *testing.T) {
+	provider := Provider().(*schema.Provider)
+	if err := provider.InternalValidate(); err != nil {
+		t.Fatalf("Expected the Provider to be valid but got: %+v", err)
+	}
+
+	for name, r := range provider.ResourcesMap {
+		tagsAll, ok := r.Schema["tags_all"]
+		if !ok {
+			continue
+		}
+
+		if r.Update == nil && !tagsAll.ForceNew {
+			t.Errorf("Expected `tags_all` to be ForceNew on %q since it doesn't support Update", name)
+		}
+
+		if r.Update != nil && tagsAll.ForceNew {
+			t.Errorf("Expected `tags_all` not to be ForceNew on %q since it supports Update", name)
+		}
+	}
+}
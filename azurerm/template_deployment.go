@@ -0,0 +1,355 @@
+package azurerm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2018-05-01/resources"
+	"github.com/hashicorp/go-multierror"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	resourceSvc "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/resource"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+// deleteTemplateDeploymentNestedItems deletes the resources provisioned by the specified Template Deployment (and any
+// Template Deployments nested within it) in reverse dependency order, returning an error listing any which couldn't be deleted.
+// Resources within `preExistingIds` existed prior to the Template Deployment and so aren't deleted
+func deleteTemplateDeploymentNestedItems(ctx context.Context, client *resourceSvc.Client, resourceGroup string, name string, preExistingIds []string) error {
+	deployment, err := client.DeploymentsClient.Get(ctx, resourceGroup, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(deployment.Response) {
+			return nil
+		}
+		return fmt.Errorf("Error retrieving Template Deployment %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	resourceIds, err := listTemplateDeploymentNestedItems(ctx, client.DeploymentOperationsClient, resourceGroup, name)
+	if err != nil {
+		return err
+	}
+	resourceIds = filterTemplateDeploymentPreExistingItems(resourceIds, preExistingIds)
+
+	dependencies := make(map[string][]string)
+	if props := deployment.Properties; props != nil {
+		dependencies = flattenTemplateDeploymentDependencies(props.Dependencies)
+	}
+
+	apiVersions := make(map[string]string)
+	var errors *multierror.Error
+	for _, resourceId := range templateDeploymentDeletionOrder(resourceIds, dependencies) {
+		log.Printf("[DEBUG] Deleting %q provisioned by Template Deployment %q (Resource Group %q)..", resourceId, name, resourceGroup)
+		if err := deleteTemplateDeploymentNestedItem(ctx, client, resourceId, apiVersions); err != nil {
+			errors = multierror.Append(errors, fmt.Errorf("Error deleting %q: %+v", resourceId, err))
+		}
+	}
+
+	if err := errors.ErrorOrNil(); err != nil {
+		return fmt.Errorf("Error deleting the resources provisioned by Template Deployment %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	return nil
+}
+
+// listTemplateDeploymentNestedItems returns the ID's of the resources provisioned by the specified Template Deployment
+// (including those provisioned by any nested Template Deployments) in the order they were provisioned - resources which
+// were only read (for example, those which are referenced by the Template) aren't included. Since Azure reports every
+// resource which is PUT as being created, this includes resources which existed prior to the Template Deployment
+func listTemplateDeploymentNestedItems(ctx context.Context, client resourceSvc.DeploymentOperationsClient, resourceGroup string, name string) ([]string, error) {
+	allOperations, err := client.List(ctx, resourceGroup, name)
+	if err != nil {
+		return nil, fmt.Errorf("Error listing the Operations for Template Deployment %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	operations := filterTemplateDeploymentCreateOperations(allOperations)
+	sortTemplateDeploymentOperations(operations)
+
+	resourceIds := make([]string, 0)
+	for _, operation := range operations {
+		target := operation.Properties.TargetResource
+		if target.ResourceType == nil || !strings.EqualFold(*target.ResourceType, "Microsoft.Resources/deployments") {
+			resourceIds = append(resourceIds, *target.ID)
+			continue
+		}
+
+		// the resources provisioned by a nested Template Deployment aren't included in the Operations of this one
+		id, err := azure.ParseTemplateDeploymentID(*target.ID)
+		if err != nil {
+			log.Printf("[DEBUG] Unable to parse the nested Template Deployment ID %q - skipping: %+v", *target.ID, err)
+			continue
+		}
+
+		nested, err := listTemplateDeploymentNestedItems(ctx, client, id.ResourceGroup, id.Name)
+		if err != nil {
+			return nil, err
+		}
+		resourceIds = append(resourceIds, nested...)
+	}
+
+	return resourceIds, nil
+}
+
+// filterTemplateDeploymentCreateOperations returns the successful Operations whose `provisioningOperation` is `Create` -
+// which Azure uses for every resource which is PUT, regardless of whether the resource existed beforehand
+func filterTemplateDeploymentCreateOperations(input []resourceSvc.DeploymentOperation) []resourceSvc.DeploymentOperation {
+	output := make([]resourceSvc.DeploymentOperation, 0)
+	for _, operation := range input {
+		props := operation.Properties
+		if props == nil || props.TargetResource == nil || props.TargetResource.ID == nil {
+			continue
+		}
+
+		if props.ProvisioningState == nil || !strings.EqualFold(*props.ProvisioningState, "Succeeded") {
+			continue
+		}
+
+		if props.ProvisioningOperation == nil || !strings.EqualFold(*props.ProvisioningOperation, "Create") {
+			log.Printf("[DEBUG] %q wasn't created by the Template Deployment - skipping", *props.TargetResource.ID)
+			continue
+		}
+
+		output = append(output, operation)
+	}
+
+	return output
+}
+
+// sortTemplateDeploymentOperations sorts the specified Operations into the order they were performed, with any
+// Operations which don't have a timestamp at the end (in the order they were returned)
+func sortTemplateDeploymentOperations(operations []resourceSvc.DeploymentOperation) {
+	sort.SliceStable(operations, func(i, j int) bool {
+		first := operations[i].Properties.Timestamp
+		second := operations[j].Properties.Timestamp
+		if first == nil || second == nil {
+			return first != nil && second == nil
+		}
+		return first.Before(second.Time)
+	})
+}
+
+// listTemplateDeploymentPreExistingItems previews the specified deployment of the Template Deployment and returns the
+// ID's of the resources it targets which already exist but weren't provisioned by it - which, together with the
+// `existing` pre-existing resources, are excluded when deleting the resources provisioned by the Template Deployment
+func listTemplateDeploymentPreExistingItems(ctx context.Context, client *resourceSvc.Client, resourceGroup string, name string, deployment resources.Deployment, isNewResource bool, existing []string) ([]string, error) {
+	whatIfClient := client.DeploymentsWhatIfClient
+	future, err := whatIfClient.WhatIf(ctx, resourceGroup, name, deployment)
+	if err != nil {
+		return nil, fmt.Errorf("Error previewing the changes for Template Deployment %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+	if err := future.WaitForCompletionRef(ctx, whatIfClient.Client); err != nil {
+		return nil, fmt.Errorf("Error waiting for the changes for Template Deployment %q (Resource Group %q) to be previewed: %+v", name, resourceGroup, err)
+	}
+	result, err := future.Result(whatIfClient)
+	if err != nil {
+		return nil, fmt.Errorf("Error retrieving the changes for Template Deployment %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+	if result.Error != nil {
+		return nil, fmt.Errorf("Error previewing the changes for Template Deployment %q (Resource Group %q): %s", name, resourceGroup, flattenTemplateDeploymentError(result.Error))
+	}
+
+	// resources provisioned by an earlier deployment of this Template Deployment also exist, but aren't pre-existing
+	provisioned := make([]string, 0)
+	if !isNewResource {
+		provisioned, err = listTemplateDeploymentNestedItems(ctx, client.DeploymentOperationsClient, resourceGroup, name)
+		if err != nil {
+			return nil, err
+		}
+		provisioned = filterTemplateDeploymentPreExistingItems(provisioned, existing)
+	}
+
+	return mergeTemplateDeploymentPreExistingItems(existing, flattenTemplateDeploymentExistingTargets(result.Properties), provisioned), nil
+}
+
+// flattenTemplateDeploymentExistingTargets returns the ID's of the resources targeted by the Template Deployment which
+// already exist, according to the preview of its changes. Resources whose change can't be determined (`Deploy`) are
+// treated as existing, so that these are never deleted
+func flattenTemplateDeploymentExistingTargets(input *resourceSvc.WhatIfOperationProperties) []string {
+	output := make([]string, 0)
+	if input == nil || input.Changes == nil {
+		return output
+	}
+
+	for _, v := range *input.Changes {
+		if v.ResourceID == nil || v.ChangeType == nil {
+			continue
+		}
+
+		// resources which are `Ignore`'d or `Delete`'d aren't targeted by the Template
+		changeType := strings.ToLower(*v.ChangeType)
+		if changeType == "create" || changeType == "ignore" || changeType == "delete" {
+			continue
+		}
+
+		output = append(output, *v.ResourceID)
+	}
+
+	return output
+}
+
+// mergeTemplateDeploymentPreExistingItems returns the `existing` pre-existing resources along with the `targets` which
+// weren't `provisioned` by the Template Deployment, de-duplicated (case-insensitively) and sorted
+func mergeTemplateDeploymentPreExistingItems(existing []string, targets []string, provisioned []string) []string {
+	seen := make(map[string]struct{})
+	for _, v := range provisioned {
+		seen[strings.ToLower(v)] = struct{}{}
+	}
+
+	output := make([]string, 0)
+	for _, v := range existing {
+		if _, ok := seen[strings.ToLower(v)]; ok {
+			continue
+		}
+		seen[strings.ToLower(v)] = struct{}{}
+		output = append(output, v)
+	}
+	for _, v := range targets {
+		if _, ok := seen[strings.ToLower(v)]; ok {
+			continue
+		}
+		seen[strings.ToLower(v)] = struct{}{}
+		output = append(output, v)
+	}
+
+	sort.Slice(output, func(i, j int) bool {
+		return strings.ToLower(output[i]) < strings.ToLower(output[j])
+	})
+
+	return output
+}
+
+// filterTemplateDeploymentPreExistingItems returns the specified resources, excluding any within `preExistingIds`
+func filterTemplateDeploymentPreExistingItems(resourceIds []string, preExistingIds []string) []string {
+	preExisting := make(map[string]struct{}, len(preExistingIds))
+	for _, v := range preExistingIds {
+		preExisting[strings.ToLower(v)] = struct{}{}
+	}
+
+	output := make([]string, 0, len(resourceIds))
+	for _, v := range resourceIds {
+		if _, ok := preExisting[strings.ToLower(v)]; ok {
+			log.Printf("[DEBUG] %q existed prior to the Template Deployment - skipping", v)
+			continue
+		}
+		output = append(output, v)
+	}
+
+	return output
+}
+
+// flattenTemplateDeploymentDependencies returns a map of (lower-cased) resource ID's to the ID's of the resources they depend on
+func flattenTemplateDeploymentDependencies(input *[]resources.Dependency) map[string][]string {
+	output := make(map[string][]string)
+	if input == nil {
+		return output
+	}
+
+	for _, v := range *input {
+		if v.ID == nil || v.DependsOn == nil {
+			continue
+		}
+
+		key := strings.ToLower(*v.ID)
+		for _, dependsOn := range *v.DependsOn {
+			if dependsOn.ID != nil {
+				output[key] = append(output[key], strings.ToLower(*dependsOn.ID))
+			}
+		}
+	}
+
+	return output
+}
+
+// templateDeploymentDeletionOrder returns the order in which the specified resources (in the order they were provisioned)
+// should be deleted, such that each resource is deleted before those it depends on (including any parent resources)
+func templateDeploymentDeletionOrder(resourceIds []string, dependencies map[string][]string) []string {
+	remaining := make([]string, 0)
+	seen := make(map[string]struct{})
+	for i := len(resourceIds) - 1; i >= 0; i-- {
+		key := strings.ToLower(resourceIds[i])
+		if _, exists := seen[key]; exists {
+			continue
+		}
+		seen[key] = struct{}{}
+		remaining = append(remaining, resourceIds[i])
+	}
+
+	dependsOn := func(resourceId string, other string) bool {
+		resourceKey := strings.ToLower(resourceId)
+		otherKey := strings.ToLower(other)
+		if strings.HasPrefix(resourceKey, otherKey+"/") {
+			return true
+		}
+
+		for _, v := range dependencies[resourceKey] {
+			if v == otherKey {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	output := make([]string, 0, len(remaining))
+	for len(remaining) > 0 {
+		next := 0
+		for i, candidate := range remaining {
+			required := false
+			for j, other := range remaining {
+				if i != j && dependsOn(other, candidate) {
+					required = true
+					break
+				}
+			}
+
+			if !required {
+				next = i
+				break
+			}
+		}
+
+		// when there's a circular dependency the most recently provisioned resource is deleted first
+		output = append(output, remaining[next])
+		remaining = append(remaining[:next], remaining[next+1:]...)
+	}
+
+	return output
+}
+
+func deleteTemplateDeploymentNestedItem(ctx context.Context, client *resourceSvc.Client, resourceId string, apiVersions map[string]string) error {
+	namespace, resourceType, err := resourceSvc.ParseResourceType(resourceId)
+	if err != nil {
+		return err
+	}
+
+	key := strings.ToLower(fmt.Sprintf("%s/%s", namespace, resourceType))
+	apiVersion, ok := apiVersions[key]
+	if !ok {
+		apiVersion, err = resourceSvc.ResolveAPIVersion(ctx, client.ProvidersClient, resourceId)
+		if err != nil {
+			return err
+		}
+		apiVersions[key] = apiVersion
+	}
+
+	// the resource may have already been removed, for example when it's nested within a resource which has been deleted
+	existing, err := client.ResourcesByIDClient.GetByID(ctx, resourceId, apiVersion)
+	if err != nil {
+		if utils.ResponseWasNotFound(existing.Response) {
+			return nil
+		}
+		return fmt.Errorf("Error retrieving: %+v", err)
+	}
+
+	future, err := client.ResourcesByIDClient.DeleteByID(ctx, resourceId, apiVersion)
+	if err != nil {
+		return err
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.ResourcesByIDClient.Client); err != nil {
+		return fmt.Errorf("Error waiting for deletion: %+v", err)
+	}
+
+	return nil
+}
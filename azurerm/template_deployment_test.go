@@ -0,0 +1,255 @@
+package azurerm
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2018-05-01/resources"
+	"github.com/Azure/go-autorest/autorest/date"
+	resourceSvc "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/resource"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestTemplateDeploymentDeletionOrder(t *testing.T) {
+	network := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1"
+	subnet := network + "/subnets/subnet1"
+	publicIP := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/publicIPAddresses/ip1"
+	nic := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/networkInterfaces/nic1"
+	storage := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Storage/storageAccounts/account1"
+
+	testData := []struct {
+		Name         string
+		ResourceIds  []string
+		Dependencies map[string][]string
+		Expected     []string
+	}{
+		{
+			Name:         "None",
+			ResourceIds:  []string{},
+			Dependencies: map[string][]string{},
+			Expected:     []string{},
+		},
+		{
+			Name:         "Reverse Provisioning Order",
+			ResourceIds:  []string{storage, publicIP},
+			Dependencies: map[string][]string{},
+			Expected:     []string{publicIP, storage},
+		},
+		{
+			Name:         "Duplicates",
+			ResourceIds:  []string{storage, publicIP, storage},
+			Dependencies: map[string][]string{},
+			Expected:     []string{storage, publicIP},
+		},
+		{
+			Name:         "Child Resources",
+			ResourceIds:  []string{subnet, network},
+			Dependencies: map[string][]string{},
+			Expected:     []string{subnet, network},
+		},
+		{
+			Name:        "Explicit Dependencies",
+			ResourceIds: []string{nic, publicIP, network, subnet},
+			Dependencies: map[string][]string{
+				strings.ToLower(nic): {strings.ToLower(publicIP), strings.ToLower(subnet)},
+			},
+			Expected: []string{nic, subnet, network, publicIP},
+		},
+		{
+			Name:        "Circular Dependencies",
+			ResourceIds: []string{storage, publicIP},
+			Dependencies: map[string][]string{
+				strings.ToLower(storage):  {strings.ToLower(publicIP)},
+				strings.ToLower(publicIP): {strings.ToLower(storage)},
+			},
+			Expected: []string{publicIP, storage},
+		},
+	}
+
+	for _, v := range testData {
+		t.Run(v.Name, func(t *testing.T) {
+			actual := templateDeploymentDeletionOrder(v.ResourceIds, v.Dependencies)
+			if !reflect.DeepEqual(actual, v.Expected) {
+				t.Fatalf("Expected %+v but got %+v", v.Expected, actual)
+			}
+		})
+	}
+}
+
+func TestTemplateDeploymentDependencies_flatten(t *testing.T) {
+	input := []resources.Dependency{
+		{
+			ID: utils.String("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/networkInterfaces/NIC1"),
+			DependsOn: &[]resources.BasicDependency{
+				{
+					ID: utils.String("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/publicIPAddresses/IP1"),
+				},
+			},
+		},
+		{
+			ID: utils.String("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Storage/storageAccounts/account1"),
+		},
+	}
+
+	expected := map[string][]string{
+		"/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/group1/providers/microsoft.network/networkinterfaces/nic1": {
+			"/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/group1/providers/microsoft.network/publicipaddresses/ip1",
+		},
+	}
+
+	if actual := flattenTemplateDeploymentDependencies(&input); !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
+func TestTemplateDeploymentCreateOperations_filter(t *testing.T) {
+	operation := func(id string, provisioningOperation string, provisioningState string) resourceSvc.DeploymentOperation {
+		return resourceSvc.DeploymentOperation{
+			Properties: &resourceSvc.DeploymentOperationProperties{
+				ProvisioningOperation: utils.String(provisioningOperation),
+				ProvisioningState:     utils.String(provisioningState),
+				TargetResource: &resources.TargetResource{
+					ID: utils.String(id),
+				},
+			},
+		}
+	}
+
+	input := []resourceSvc.DeploymentOperation{
+		operation("created", "Create", "Succeeded"),
+		operation("failed", "Create", "Failed"),
+		operation("read", "Read", "Succeeded"),
+		operation("action", "Action", "Succeeded"),
+		{
+			Properties: &resourceSvc.DeploymentOperationProperties{
+				ProvisioningOperation: utils.String("EvaluateDeploymentOutput"),
+				ProvisioningState:     utils.String("Succeeded"),
+			},
+		},
+	}
+
+	actual := filterTemplateDeploymentCreateOperations(input)
+	if len(actual) != 1 || *actual[0].Properties.TargetResource.ID != "created" {
+		t.Fatalf("Expected only the `created` Operation but got %+v", actual)
+	}
+}
+
+func TestTemplateDeploymentOperations_sort(t *testing.T) {
+	operation := func(id string, timestamp *time.Time) resourceSvc.DeploymentOperation {
+		props := &resourceSvc.DeploymentOperationProperties{
+			TargetResource: &resources.TargetResource{
+				ID: utils.String(id),
+			},
+		}
+		if timestamp != nil {
+			props.Timestamp = &date.Time{Time: *timestamp}
+		}
+		return resourceSvc.DeploymentOperation{
+			Properties: props,
+		}
+	}
+
+	first := time.Date(2020, 1, 1, 10, 0, 0, 0, time.UTC)
+	second := first.Add(time.Minute)
+	input := []resourceSvc.DeploymentOperation{
+		operation("missing1", nil),
+		operation("second", &second),
+		operation("missing2", nil),
+		operation("first", &first),
+	}
+
+	sortTemplateDeploymentOperations(input)
+
+	actual := make([]string, 0)
+	for _, v := range input {
+		actual = append(actual, *v.Properties.TargetResource.ID)
+	}
+	expected := []string{"first", "second", "missing1", "missing2"}
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
+func TestTemplateDeploymentExistingTargets_flatten(t *testing.T) {
+	change := func(id string, changeType string) resourceSvc.WhatIfChange {
+		return resourceSvc.WhatIfChange{
+			ResourceID: utils.String(id),
+			ChangeType: utils.String(changeType),
+		}
+	}
+
+	input := &resourceSvc.WhatIfOperationProperties{
+		Changes: &[]resourceSvc.WhatIfChange{
+			change("created", "Create"),
+			change("modified", "Modify"),
+			change("unchanged", "NoChange"),
+			change("unknown", "Deploy"),
+			change("ignored", "Ignore"),
+			change("deleted", "Delete"),
+		},
+	}
+
+	expected := []string{"modified", "unchanged", "unknown"}
+	if actual := flattenTemplateDeploymentExistingTargets(input); !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
+func TestTemplateDeploymentPreExistingItems_merge(t *testing.T) {
+	network := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1"
+	publicIP := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/publicIPAddresses/ip1"
+	storage := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Storage/storageAccounts/account1"
+
+	testData := []struct {
+		Name        string
+		Existing    []string
+		Targets     []string
+		Provisioned []string
+		Expected    []string
+	}{
+		{
+			Name:        "None",
+			Existing:    []string{},
+			Targets:     []string{},
+			Provisioned: []string{},
+			Expected:    []string{},
+		},
+		{
+			Name:        "New Deployment",
+			Existing:    []string{},
+			Targets:     []string{storage, network},
+			Provisioned: []string{},
+			Expected:    []string{network, storage},
+		},
+		{
+			Name:        "Previously Provisioned",
+			Existing:    []string{network},
+			Targets:     []string{strings.ToUpper(network), publicIP, storage},
+			Provisioned: []string{strings.ToLower(publicIP)},
+			Expected:    []string{network, storage},
+		},
+	}
+
+	for _, v := range testData {
+		t.Run(v.Name, func(t *testing.T) {
+			actual := mergeTemplateDeploymentPreExistingItems(v.Existing, v.Targets, v.Provisioned)
+			if !reflect.DeepEqual(actual, v.Expected) {
+				t.Fatalf("Expected %+v but got %+v", v.Expected, actual)
+			}
+		})
+	}
+}
+
+func TestTemplateDeploymentPreExistingItems_filter(t *testing.T) {
+	network := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1"
+	subnet := network + "/subnets/subnet1"
+	storage := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Storage/storageAccounts/account1"
+
+	actual := filterTemplateDeploymentPreExistingItems([]string{network, subnet, storage}, []string{strings.ToLower(network)})
+	expected := []string{subnet, storage}
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
@@ -38,7 +38,7 @@ func evaluateSchemaValidateFunc(i interface{}, k string, validateFunc schema.Sch
 	}
 
 	if len(errors) > 0 {
-		return false, fmt.Errorf(strings.Join(errorStrings, "\n"))
+		return false, fmt.Errorf("%s", strings.Join(errorStrings, "\n"))
 	}
 
 	return true, nil
@@ -0,0 +1,260 @@
+package azurerm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2018-06-01/compute"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+const (
+	virtualMachinePowerStateDeallocated = "deallocated"
+	virtualMachinePowerStateRunning     = "running"
+	virtualMachinePowerStateStopped     = "stopped"
+)
+
+func virtualMachinePowerStateSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+		// when unspecified the power state isn't managed, but is still exposed
+		Computed: true,
+		ValidateFunc: validation.StringInSlice([]string{
+			virtualMachinePowerStateDeallocated,
+			virtualMachinePowerStateRunning,
+			virtualMachinePowerStateStopped,
+		}, false),
+	}
+}
+
+// normalizeVirtualMachinePowerState converts an Instance View Status Code (e.g. `PowerState/running`) into one of
+// the power states exposed by the `power_state` field, treating the transitional states as their destination
+func normalizeVirtualMachinePowerState(code string) (string, bool) {
+	code = strings.ToLower(code)
+	if !strings.HasPrefix(code, "powerstate/") {
+		return "", false
+	}
+
+	switch strings.TrimPrefix(code, "powerstate/") {
+	case "deallocated", "deallocating":
+		return virtualMachinePowerStateDeallocated, true
+	case "running", "starting":
+		return virtualMachinePowerStateRunning, true
+	case "stopped", "stopping":
+		return virtualMachinePowerStateStopped, true
+	}
+
+	return "", false
+}
+
+// virtualMachinePowerState returns the current power state of the specified Virtual Machine from its Instance View
+func virtualMachinePowerState(ctx context.Context, client compute.VirtualMachinesClient, resourceGroup string, name string) (string, error) {
+	instanceView, err := client.InstanceView(ctx, resourceGroup, name)
+	if err != nil {
+		return "", fmt.Errorf("Error retrieving the Instance View for Virtual Machine %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if instanceView.Statuses != nil {
+		for _, status := range *instanceView.Statuses {
+			if status.Code == nil {
+				continue
+			}
+
+			if powerState, ok := normalizeVirtualMachinePowerState(*status.Code); ok {
+				return powerState, nil
+			}
+		}
+	}
+
+	return "", nil
+}
+
+// virtualMachinePowerOff stops the specified Virtual Machine, without deallocating the underlying compute resources
+func virtualMachinePowerOff(ctx context.Context, client compute.VirtualMachinesClient, resourceGroup string, name string) error {
+	log.Printf("[DEBUG] Powering off Virtual Machine %q (Resource Group %q)..", name, resourceGroup)
+	future, err := client.PowerOff(ctx, resourceGroup, name)
+	if err != nil {
+		return fmt.Errorf("Error powering off Virtual Machine %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for Virtual Machine %q (Resource Group %q) to be powered off: %+v", name, resourceGroup, err)
+	}
+
+	log.Printf("[DEBUG] Powered off Virtual Machine %q (Resource Group %q)", name, resourceGroup)
+	return nil
+}
+
+// setVirtualMachinePowerState starts, stops or deallocates the specified Virtual Machine as required
+// for it to reach the specified power state
+func setVirtualMachinePowerState(ctx context.Context, client compute.VirtualMachinesClient, resourceGroup string, name string, powerState string) error {
+	current, err := virtualMachinePowerState(ctx, client, resourceGroup, name)
+	if err != nil {
+		return err
+	}
+
+	if current == powerState {
+		return nil
+	}
+
+	switch powerState {
+	case virtualMachinePowerStateDeallocated:
+		return virtualMachineDeallocate(ctx, client, resourceGroup, name)
+	case virtualMachinePowerStateRunning:
+		return virtualMachineStart(ctx, client, resourceGroup, name)
+	case virtualMachinePowerStateStopped:
+		// a deallocated Virtual Machine can't be powered off, so it needs to be started first
+		if current == virtualMachinePowerStateDeallocated {
+			if err := virtualMachineStart(ctx, client, resourceGroup, name); err != nil {
+				return err
+			}
+		}
+
+		return virtualMachinePowerOff(ctx, client, resourceGroup, name)
+	}
+
+	return fmt.Errorf("Unsupported power state %q for Virtual Machine %q (Resource Group %q)", powerState, name, resourceGroup)
+}
+
+// restoreVirtualMachinePowerState returns a Virtual Machine which has been deallocated (e.g. to be resized) to the power
+// state it was previously in when the update fails - this is best-effort, so any error is logged rather than returned
+func restoreVirtualMachinePowerState(ctx context.Context, client compute.VirtualMachinesClient, resourceGroup string, name string, powerState string) {
+	if powerState == "" {
+		log.Printf("[WARN] The previous power state of Virtual Machine %q (Resource Group %q) is unknown - leaving it deallocated", name, resourceGroup)
+		return
+	}
+
+	log.Printf("[DEBUG] Returning Virtual Machine %q (Resource Group %q) to the %q power state..", name, resourceGroup, powerState)
+	if err := setVirtualMachinePowerState(ctx, client, resourceGroup, name, powerState); err != nil {
+		log.Printf("[WARN] Unable to return Virtual Machine %q (Resource Group %q) to the %q power state: %+v", name, resourceGroup, powerState, err)
+	}
+}
+
+// virtualMachineScaleSetPowerState returns the power state of the instances within the specified Virtual Machine Scale Set -
+// which is empty when the Scale Set has no instances, or when the instances aren't all in the same power state
+func virtualMachineScaleSetPowerState(ctx context.Context, client compute.VirtualMachineScaleSetsClient, resourceGroup string, name string) (string, error) {
+	instanceView, err := client.GetInstanceView(ctx, resourceGroup, name)
+	if err != nil {
+		return "", fmt.Errorf("Error retrieving the Instance View for Virtual Machine Scale Set %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	powerStates := make(map[string]bool)
+	if summary := instanceView.VirtualMachine; summary != nil && summary.StatusesSummary != nil {
+		for _, status := range *summary.StatusesSummary {
+			if status.Code == nil || status.Count == nil || *status.Count == 0 {
+				continue
+			}
+
+			if powerState, ok := normalizeVirtualMachinePowerState(*status.Code); ok {
+				powerStates[powerState] = true
+			}
+		}
+	}
+
+	if len(powerStates) != 1 {
+		return "", nil
+	}
+
+	for powerState := range powerStates {
+		return powerState, nil
+	}
+
+	return "", nil
+}
+
+// setVirtualMachineScaleSetPowerState starts, stops or deallocates all of the instances within the specified
+// Virtual Machine Scale Set as required for them to reach the specified power state
+func setVirtualMachineScaleSetPowerState(ctx context.Context, client compute.VirtualMachineScaleSetsClient, resourceGroup string, name string, powerState string) error {
+	current, err := virtualMachineScaleSetPowerState(ctx, client, resourceGroup, name)
+	if err != nil {
+		return err
+	}
+
+	transitions, err := virtualMachineScaleSetPowerStateTransitions(current, powerState)
+	if err != nil {
+		return fmt.Errorf("Error changing the power state of Virtual Machine Scale Set %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	log.Printf("[DEBUG] Changing the power state of Virtual Machine Scale Set %q (Resource Group %q) to %q..", name, resourceGroup, powerState)
+	for _, transition := range transitions {
+		if err := transitionVirtualMachineScaleSetPowerState(ctx, client, resourceGroup, name, transition); err != nil {
+			return err
+		}
+	}
+
+	log.Printf("[DEBUG] Changed the power state of Virtual Machine Scale Set %q (Resource Group %q) to %q", name, resourceGroup, powerState)
+	return nil
+}
+
+// virtualMachineScaleSetPowerStateTransitions returns the power states which the instances within a Virtual Machine
+// Scale Set need to be moved into (in order) to get from the `current` power state (which is empty when the instances
+// are in a mix of power states) to the specified power state
+func virtualMachineScaleSetPowerStateTransitions(current string, powerState string) ([]string, error) {
+	switch powerState {
+	case virtualMachinePowerStateDeallocated, virtualMachinePowerStateRunning:
+		if current == powerState {
+			return []string{}, nil
+		}
+
+		return []string{powerState}, nil
+
+	case virtualMachinePowerStateStopped:
+		switch current {
+		case virtualMachinePowerStateStopped:
+			return []string{}, nil
+		case virtualMachinePowerStateRunning:
+			return []string{virtualMachinePowerStateStopped}, nil
+		}
+
+		// deallocated instances can't be powered off, so these (and any in a mix of power states) need to be started first
+		return []string{virtualMachinePowerStateRunning, virtualMachinePowerStateStopped}, nil
+	}
+
+	return nil, fmt.Errorf("Unsupported power state %q", powerState)
+}
+
+// transitionVirtualMachineScaleSetPowerState deallocates, starts or powers off all of the instances within the
+// specified Virtual Machine Scale Set
+func transitionVirtualMachineScaleSetPowerState(ctx context.Context, client compute.VirtualMachineScaleSetsClient, resourceGroup string, name string, powerState string) error {
+	// omitting the Instance IDs applies the operation to all instances within the Scale Set
+	switch powerState {
+	case virtualMachinePowerStateDeallocated:
+		future, err := client.Deallocate(ctx, resourceGroup, name, nil)
+		if err != nil {
+			return fmt.Errorf("Error deallocating Virtual Machine Scale Set %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+
+		if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return fmt.Errorf("Error waiting for Virtual Machine Scale Set %q (Resource Group %q) to be deallocated: %+v", name, resourceGroup, err)
+		}
+
+	case virtualMachinePowerStateRunning:
+		future, err := client.Start(ctx, resourceGroup, name, nil)
+		if err != nil {
+			return fmt.Errorf("Error starting Virtual Machine Scale Set %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+
+		if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return fmt.Errorf("Error waiting for Virtual Machine Scale Set %q (Resource Group %q) to start: %+v", name, resourceGroup, err)
+		}
+
+	case virtualMachinePowerStateStopped:
+		future, err := client.PowerOff(ctx, resourceGroup, name, nil)
+		if err != nil {
+			return fmt.Errorf("Error powering off Virtual Machine Scale Set %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+
+		if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return fmt.Errorf("Error waiting for Virtual Machine Scale Set %q (Resource Group %q) to be powered off: %+v", name, resourceGroup, err)
+		}
+
+	default:
+		return fmt.Errorf("Unsupported power state %q for Virtual Machine Scale Set %q (Resource Group %q)", powerState, name, resourceGroup)
+	}
+
+	return nil
+}
@@ -0,0 +1,144 @@
+package azurerm
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestNormalizeVirtualMachinePowerState(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected string
+		Valid    bool
+	}{
+		{
+			Input: "ProvisioningState/succeeded",
+			Valid: false,
+		},
+		{
+			Input: "PowerState/unknown",
+			Valid: false,
+		},
+		{
+			Input:    "PowerState/running",
+			Expected: "running",
+			Valid:    true,
+		},
+		{
+			Input:    "PowerState/starting",
+			Expected: "running",
+			Valid:    true,
+		},
+		{
+			Input:    "powerstate/Stopped",
+			Expected: "stopped",
+			Valid:    true,
+		},
+		{
+			Input:    "PowerState/stopping",
+			Expected: "stopped",
+			Valid:    true,
+		},
+		{
+			Input:    "PowerState/deallocated",
+			Expected: "deallocated",
+			Valid:    true,
+		},
+		{
+			Input:    "PowerState/deallocating",
+			Expected: "deallocated",
+			Valid:    true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, valid := normalizeVirtualMachinePowerState(v.Input)
+		if valid != v.Valid {
+			t.Fatalf("Expected valid to be %t but got %t", v.Valid, valid)
+		}
+
+		if actual != v.Expected {
+			t.Fatalf("Expected %q but got %q", v.Expected, actual)
+		}
+	}
+}
+
+func TestVirtualMachineScaleSetPowerStateTransitions(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Current  string
+		Desired  string
+		Expected []string
+		Error    bool
+	}{
+		{
+			Name:     "Already Running",
+			Current:  virtualMachinePowerStateRunning,
+			Desired:  virtualMachinePowerStateRunning,
+			Expected: []string{},
+		},
+		{
+			Name:     "Mixed to Running",
+			Current:  "",
+			Desired:  virtualMachinePowerStateRunning,
+			Expected: []string{virtualMachinePowerStateRunning},
+		},
+		{
+			Name:     "Running to Deallocated",
+			Current:  virtualMachinePowerStateRunning,
+			Desired:  virtualMachinePowerStateDeallocated,
+			Expected: []string{virtualMachinePowerStateDeallocated},
+		},
+		{
+			Name:     "Running to Stopped",
+			Current:  virtualMachinePowerStateRunning,
+			Desired:  virtualMachinePowerStateStopped,
+			Expected: []string{virtualMachinePowerStateStopped},
+		},
+		{
+			Name:     "Deallocated to Stopped",
+			Current:  virtualMachinePowerStateDeallocated,
+			Desired:  virtualMachinePowerStateStopped,
+			Expected: []string{virtualMachinePowerStateRunning, virtualMachinePowerStateStopped},
+		},
+		{
+			Name:     "Mixed to Stopped",
+			Current:  "",
+			Desired:  virtualMachinePowerStateStopped,
+			Expected: []string{virtualMachinePowerStateRunning, virtualMachinePowerStateStopped},
+		},
+		{
+			Name:     "Already Stopped",
+			Current:  virtualMachinePowerStateStopped,
+			Desired:  virtualMachinePowerStateStopped,
+			Expected: []string{},
+		},
+		{
+			Name:    "Unsupported",
+			Current: virtualMachinePowerStateRunning,
+			Desired: "hibernated",
+			Error:   true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Run(v.Name, func(t *testing.T) {
+			actual, err := virtualMachineScaleSetPowerStateTransitions(v.Current, v.Desired)
+			if v.Error {
+				if err == nil {
+					t.Fatalf("Expected an error but didn't get one")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Expected no error but got: %+v", err)
+			}
+
+			if !reflect.DeepEqual(actual, v.Expected) {
+				t.Fatalf("Expected %+v but got %+v", v.Expected, actual)
+			}
+		})
+	}
+}
@@ -0,0 +1,192 @@
+package azurerm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2018-06-01/compute"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+const (
+	// virtualMachineScaleSetRollingUpgradePending is returned whilst no Rolling Upgrade has been started since the one
+	// specified and the instances haven't all been upgraded to the latest model
+	virtualMachineScaleSetRollingUpgradePending = "Pending"
+
+	// virtualMachineScaleSetRollingUpgradeUpToDate is returned when no Rolling Upgrade has been started since the one
+	// specified but all of the instances are running the latest model - and so no Rolling Upgrade is required
+	virtualMachineScaleSetRollingUpgradeUpToDate = "UpToDate"
+)
+
+// virtualMachineScaleSetLatestRollingUpgradeStartTime returns the start time of the latest Rolling Upgrade for the
+// specified Virtual Machine Scale Set - which is empty when a Rolling Upgrade has never been run
+func virtualMachineScaleSetLatestRollingUpgradeStartTime(ctx context.Context, client compute.VirtualMachineScaleSetRollingUpgradesClient, resourceGroup string, name string) (string, error) {
+	resp, err := client.GetLatest(ctx, resourceGroup, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return "", nil
+		}
+
+		return "", fmt.Errorf("Error retrieving the latest Rolling Upgrade for Virtual Machine Scale Set %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	return virtualMachineScaleSetRollingUpgradeStartTime(resp.RollingUpgradeStatusInfoProperties), nil
+}
+
+// waitForVirtualMachineScaleSetRollingUpgrade waits for a Rolling Upgrade started after the one specified to roll
+// forward across all of the instances within the Virtual Machine Scale Set (returning the per-instance errors if it's
+// aborted) - or for all of the instances to be confirmed as running the latest model, when no Rolling Upgrade is required
+func waitForVirtualMachineScaleSetRollingUpgrade(ctx context.Context, client compute.VirtualMachineScaleSetRollingUpgradesClient, vmsClient compute.VirtualMachineScaleSetVMsClient, resourceGroup string, name string, previousStartTime string, timeout time.Duration) error {
+	log.Printf("[DEBUG] Waiting for the Rolling Upgrade of Virtual Machine Scale Set %q (Resource Group %q) to complete..", name, resourceGroup)
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			virtualMachineScaleSetRollingUpgradePending,
+			string(compute.RollingUpgradeStatusCodeRollingForward),
+		},
+		Target: []string{
+			string(compute.RollingUpgradeStatusCodeCompleted),
+			virtualMachineScaleSetRollingUpgradeUpToDate,
+		},
+		Refresh: virtualMachineScaleSetRollingUpgradeRefreshFunc(ctx, client, vmsClient, resourceGroup, name, previousStartTime),
+		// the Rolling Upgrade is started by the Scale Set once the model's been updated, so give it a chance to show up
+		Delay:      30 * time.Second,
+		MinTimeout: 15 * time.Second,
+		Timeout:    timeout,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for the Rolling Upgrade of Virtual Machine Scale Set %q (Resource Group %q) to complete: %+v", name, resourceGroup, err)
+	}
+
+	log.Printf("[DEBUG] Rolling Upgrade of Virtual Machine Scale Set %q (Resource Group %q) has completed", name, resourceGroup)
+	return nil
+}
+
+func virtualMachineScaleSetRollingUpgradeRefreshFunc(ctx context.Context, client compute.VirtualMachineScaleSetRollingUpgradesClient, vmsClient compute.VirtualMachineScaleSetVMsClient, resourceGroup string, name string, previousStartTime string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := client.GetLatest(ctx, resourceGroup, name)
+		if err != nil && !utils.ResponseWasNotFound(resp.Response) {
+			return nil, "", fmt.Errorf("Error retrieving the latest Rolling Upgrade for Virtual Machine Scale Set %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+
+		props := resp.RollingUpgradeStatusInfoProperties
+		if err != nil || props == nil || props.RunningStatus == nil || virtualMachineScaleSetRollingUpgradeStartTime(props) == previousStartTime {
+			// the Rolling Upgrade either hasn't started yet, or isn't required since the instances are already up to date
+			upToDate, err := virtualMachineScaleSetInstancesUseLatestModel(ctx, vmsClient, resourceGroup, name)
+			if err != nil {
+				return nil, "", err
+			}
+
+			if upToDate {
+				return resp, virtualMachineScaleSetRollingUpgradeUpToDate, nil
+			}
+
+			log.Printf("[DEBUG] Waiting for a Rolling Upgrade of Virtual Machine Scale Set %q (Resource Group %q) to start..", name, resourceGroup)
+			return resp, virtualMachineScaleSetRollingUpgradePending, nil
+		}
+
+		code := props.RunningStatus.Code
+		log.Printf("[DEBUG] Rolling Upgrade of Virtual Machine Scale Set %q (Resource Group %q) is %q", name, resourceGroup, string(code))
+		if code == compute.RollingUpgradeStatusCodeCancelled || code == compute.RollingUpgradeStatusCodeFaulted {
+			return resp, string(code), flattenVirtualMachineScaleSetRollingUpgradeError(props)
+		}
+
+		return resp, string(code), nil
+	}
+}
+
+// virtualMachineScaleSetInstancesUseLatestModel returns whether all of the instances within the specified Virtual
+// Machine Scale Set have had the latest model applied
+func virtualMachineScaleSetInstancesUseLatestModel(ctx context.Context, client compute.VirtualMachineScaleSetVMsClient, resourceGroup string, name string) (bool, error) {
+	iterator, err := client.ListComplete(ctx, resourceGroup, name, "", "", "")
+	if err != nil {
+		return false, fmt.Errorf("Error listing the Instances of Virtual Machine Scale Set %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	for iterator.NotDone() {
+		instance := iterator.Value()
+		props := instance.VirtualMachineScaleSetVMProperties
+		if props == nil || props.LatestModelApplied == nil || !*props.LatestModelApplied {
+			return false, nil
+		}
+
+		if err := iterator.NextWithContext(ctx); err != nil {
+			return false, fmt.Errorf("Error listing the Instances of Virtual Machine Scale Set %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+	}
+
+	return true, nil
+}
+
+func virtualMachineScaleSetRollingUpgradeStartTime(input *compute.RollingUpgradeStatusInfoProperties) string {
+	if input == nil || input.RunningStatus == nil || input.RunningStatus.StartTime == nil {
+		return ""
+	}
+
+	return input.RunningStatus.StartTime.String()
+}
+
+// flattenVirtualMachineScaleSetRollingUpgradeError returns an error describing why a Rolling Upgrade was aborted,
+// including the progress made and the error for each instance which failed to upgrade
+func flattenVirtualMachineScaleSetRollingUpgradeError(input *compute.RollingUpgradeStatusInfoProperties) error {
+	if input == nil {
+		return fmt.Errorf("the Rolling Upgrade was aborted")
+	}
+
+	status := "aborted"
+	if input.RunningStatus != nil && input.RunningStatus.Code != "" {
+		status = strings.ToLower(string(input.RunningStatus.Code))
+	}
+
+	message := fmt.Sprintf("the Rolling Upgrade was %s", status)
+
+	if progress := input.Progress; progress != nil {
+		successful, failed, inProgress, pending := int32(0), int32(0), int32(0), int32(0)
+		if progress.SuccessfulInstanceCount != nil {
+			successful = *progress.SuccessfulInstanceCount
+		}
+		if progress.FailedInstanceCount != nil {
+			failed = *progress.FailedInstanceCount
+		}
+		if progress.InProgressInstanceCount != nil {
+			inProgress = *progress.InProgressInstanceCount
+		}
+		if progress.PendingInstanceCount != nil {
+			pending = *progress.PendingInstanceCount
+		}
+
+		message += fmt.Sprintf(" (%d succeeded, %d failed, %d in progress, %d pending)", successful, failed, inProgress, pending)
+	}
+
+	if apiErr := input.Error; apiErr != nil {
+		if apiErr.Message != nil {
+			code := ""
+			if apiErr.Code != nil {
+				code = *apiErr.Code
+			}
+
+			message += fmt.Sprintf(": %s: %s", code, *apiErr.Message)
+		}
+
+		if apiErr.Details != nil {
+			for _, detail := range *apiErr.Details {
+				target := ""
+				if detail.Target != nil {
+					target = *detail.Target
+				}
+
+				detailMessage := ""
+				if detail.Message != nil {
+					detailMessage = *detail.Message
+				}
+
+				message += fmt.Sprintf("\n - %s: %s", target, detailMessage)
+			}
+		}
+	}
+
+	return fmt.Errorf("%s", message)
+}
@@ -0,0 +1,68 @@
+package azurerm
+
+import (
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2018-06-01/compute"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestFlattenVirtualMachineScaleSetRollingUpgradeError(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    *compute.RollingUpgradeStatusInfoProperties
+		Expected string
+	}{
+		{
+			Name:     "Empty",
+			Input:    nil,
+			Expected: "the Rolling Upgrade was aborted",
+		},
+		{
+			Name: "Cancelled",
+			Input: &compute.RollingUpgradeStatusInfoProperties{
+				RunningStatus: &compute.RollingUpgradeRunningStatus{
+					Code: compute.RollingUpgradeStatusCodeCancelled,
+				},
+			},
+			Expected: "the Rolling Upgrade was cancelled",
+		},
+		{
+			Name: "Faulted",
+			Input: &compute.RollingUpgradeStatusInfoProperties{
+				RunningStatus: &compute.RollingUpgradeRunningStatus{
+					Code: compute.RollingUpgradeStatusCodeFaulted,
+				},
+				Progress: &compute.RollingUpgradeProgressInfo{
+					SuccessfulInstanceCount: utils.Int32(1),
+					FailedInstanceCount:     utils.Int32(2),
+					PendingInstanceCount:    utils.Int32(3),
+				},
+				Error: &compute.APIError{
+					Code:    utils.String("MaxUnhealthyInstancePercentExceededInRollingUpgrade"),
+					Message: utils.String("Maximum unhealthy instance percent exceeded"),
+					Details: &[]compute.APIErrorBase{
+						{
+							Target:  utils.String("1"),
+							Message: utils.String("The instance is unhealthy"),
+						},
+						{
+							Target:  utils.String("2"),
+							Message: utils.String("The instance failed to start"),
+						},
+					},
+				},
+			},
+			Expected: "the Rolling Upgrade was faulted (1 succeeded, 2 failed, 0 in progress, 3 pending): MaxUnhealthyInstancePercentExceededInRollingUpgrade: Maximum unhealthy instance percent exceeded\n - 1: The instance is unhealthy\n - 2: The instance failed to start",
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		actual := flattenVirtualMachineScaleSetRollingUpgradeError(v.Input)
+		if actual.Error() != v.Expected {
+			t.Fatalf("Expected %q but got %q", v.Expected, actual.Error())
+		}
+	}
+}
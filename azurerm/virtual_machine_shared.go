@@ -0,0 +1,891 @@
+package azurerm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2018-06-01/compute"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+// the schema, expand and flatten functions in this file are shared between the
+// `azurerm_linux_virtual_machine` and `azurerm_windows_virtual_machine` resources
+
+func virtualMachineOSDiskSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Required: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"caching": {
+					Type:     schema.TypeString,
+					Required: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						string(compute.CachingTypesNone),
+						string(compute.CachingTypesReadOnly),
+						string(compute.CachingTypesReadWrite),
+					}, false),
+				},
+
+				// changing the Storage Account Type or increasing the size of the OS Disk requires that
+				// the Virtual Machine is deallocated - which happens automatically during the update
+				"storage_account_type": {
+					Type:     schema.TypeString,
+					Required: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						string(compute.StorageAccountTypesPremiumLRS),
+						string(compute.StorageAccountTypesStandardLRS),
+						string(compute.StorageAccountTypesStandardSSDLRS),
+					}, false),
+				},
+
+				"disk_size_gb": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.IntBetween(0, 4095),
+				},
+
+				"name": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Computed: true,
+					ForceNew: true,
+				},
+
+				"write_accelerator_enabled": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
+
+				"id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+func virtualMachineSourceImageReferenceSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:          schema.TypeList,
+		Optional:      true,
+		ForceNew:      true,
+		MaxItems:      1,
+		ConflictsWith: []string{"source_image_id"},
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"publisher": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.NoZeroValues,
+				},
+				"offer": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.NoZeroValues,
+				},
+				"sku": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.NoZeroValues,
+				},
+				"version": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.NoZeroValues,
+				},
+			},
+		},
+	}
+}
+
+func virtualMachinePlanSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		ForceNew: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:     schema.TypeString,
+					Required: true,
+					ForceNew: true,
+				},
+
+				"publisher": {
+					Type:     schema.TypeString,
+					Required: true,
+					ForceNew: true,
+				},
+
+				"product": {
+					Type:     schema.TypeString,
+					Required: true,
+					ForceNew: true,
+				},
+			},
+		},
+	}
+}
+
+func virtualMachineIdentitySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"type": {
+					Type: schema.TypeString,
+					// the API returns these in lower-case, but they're case sensitive when sent
+					DiffSuppressFunc: ignoreCaseDiffSuppressFunc,
+					Required:         true,
+					ValidateFunc: validation.StringInSlice([]string{
+						string(compute.ResourceIdentityTypeSystemAssigned),
+						string(compute.ResourceIdentityTypeUserAssigned),
+						string(compute.ResourceIdentityTypeSystemAssignedUserAssigned),
+					}, false),
+				},
+
+				"identity_ids": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MinItems: 1,
+					Elem: &schema.Schema{
+						Type:         schema.TypeString,
+						ValidateFunc: azure.ValidateResourceID,
+					},
+				},
+
+				"principal_id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+func virtualMachineBootDiagnosticsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"storage_account_uri": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.NoZeroValues,
+				},
+			},
+		},
+	}
+}
+
+func virtualMachineSecretSchema(certificateSchema map[string]*schema.Schema) *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"key_vault_id": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: azure.ValidateResourceID,
+				},
+
+				"certificate": {
+					Type:     schema.TypeSet,
+					Required: true,
+					MinItems: 1,
+					Elem: &schema.Resource{
+						Schema: certificateSchema,
+					},
+				},
+			},
+		},
+	}
+}
+
+func expandVirtualMachineOSDisk(input []interface{}, osType compute.OperatingSystemTypes) *compute.OSDisk {
+	raw := input[0].(map[string]interface{})
+
+	disk := compute.OSDisk{
+		Caching: compute.CachingTypes(raw["caching"].(string)),
+		ManagedDisk: &compute.ManagedDiskParameters{
+			StorageAccountType: compute.StorageAccountTypes(raw["storage_account_type"].(string)),
+		},
+		WriteAcceleratorEnabled: utils.Bool(raw["write_accelerator_enabled"].(bool)),
+
+		// these have to be hard-coded so there's no point exposing them
+		// for CreateOption, whilst it's possible for this to be "Attach" for an OS Disk
+		// from what we can tell this approach has been superseded by provisioning from
+		// an image of the machine (e.g. an Image/Shared Image Gallery)
+		CreateOption: compute.DiskCreateOptionTypesFromImage,
+		OsType:       osType,
+	}
+
+	if size := raw["disk_size_gb"].(int); size > 0 {
+		disk.DiskSizeGB = utils.Int32(int32(size))
+	}
+
+	if name := raw["name"].(string); name != "" {
+		disk.Name = utils.String(name)
+	}
+
+	return &disk
+}
+
+func flattenVirtualMachineOSDisk(input *compute.OSDisk) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	diskSizeGb := 0
+	if input.DiskSizeGB != nil {
+		diskSizeGb = int(*input.DiskSizeGB)
+	}
+
+	id := ""
+	storageAccountType := ""
+	if input.ManagedDisk != nil {
+		storageAccountType = string(input.ManagedDisk.StorageAccountType)
+		if input.ManagedDisk.ID != nil {
+			id = *input.ManagedDisk.ID
+		}
+	}
+
+	name := ""
+	if input.Name != nil {
+		name = *input.Name
+	}
+
+	writeAcceleratorEnabled := false
+	if input.WriteAcceleratorEnabled != nil {
+		writeAcceleratorEnabled = *input.WriteAcceleratorEnabled
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"caching":                   string(input.Caching),
+			"disk_size_gb":              diskSizeGb,
+			"id":                        id,
+			"name":                      name,
+			"storage_account_type":      storageAccountType,
+			"write_accelerator_enabled": writeAcceleratorEnabled,
+		},
+	}
+}
+
+func expandVirtualMachineSourceImageReference(input []interface{}) *compute.ImageReference {
+	if len(input) == 0 {
+		return nil
+	}
+
+	raw := input[0].(map[string]interface{})
+	return &compute.ImageReference{
+		Publisher: utils.String(raw["publisher"].(string)),
+		Offer:     utils.String(raw["offer"].(string)),
+		Sku:       utils.String(raw["sku"].(string)),
+		Version:   utils.String(raw["version"].(string)),
+	}
+}
+
+func flattenVirtualMachineSourceImageReference(input *compute.ImageReference) []interface{} {
+	// images provisioned from a Custom Image/Shared Image Gallery only return the ID
+	if input == nil || input.ID != nil {
+		return []interface{}{}
+	}
+
+	publisher := ""
+	if input.Publisher != nil {
+		publisher = *input.Publisher
+	}
+
+	offer := ""
+	if input.Offer != nil {
+		offer = *input.Offer
+	}
+
+	sku := ""
+	if input.Sku != nil {
+		sku = *input.Sku
+	}
+
+	version := ""
+	if input.Version != nil {
+		version = *input.Version
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"publisher": publisher,
+			"offer":     offer,
+			"sku":       sku,
+			"version":   version,
+		},
+	}
+}
+
+func expandVirtualMachineStorageProfileImageReference(d *schema.ResourceData) *compute.ImageReference {
+	if v := d.Get("source_image_id").(string); v != "" {
+		return &compute.ImageReference{
+			ID: utils.String(v),
+		}
+	}
+
+	return expandVirtualMachineSourceImageReference(d.Get("source_image_reference").([]interface{}))
+}
+
+func expandVirtualMachineBootDiagnostics(input []interface{}) *compute.DiagnosticsProfile {
+	if len(input) == 0 {
+		return &compute.DiagnosticsProfile{
+			BootDiagnostics: &compute.BootDiagnostics{
+				Enabled: utils.Bool(false),
+			},
+		}
+	}
+
+	raw := input[0].(map[string]interface{})
+	return &compute.DiagnosticsProfile{
+		BootDiagnostics: &compute.BootDiagnostics{
+			Enabled:    utils.Bool(true),
+			StorageURI: utils.String(raw["storage_account_uri"].(string)),
+		},
+	}
+}
+
+func flattenVirtualMachineBootDiagnostics(input *compute.DiagnosticsProfile) []interface{} {
+	if input == nil || input.BootDiagnostics == nil || input.BootDiagnostics.Enabled == nil || !*input.BootDiagnostics.Enabled {
+		return []interface{}{}
+	}
+
+	storageAccountUri := ""
+	if input.BootDiagnostics.StorageURI != nil {
+		storageAccountUri = *input.BootDiagnostics.StorageURI
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"storage_account_uri": storageAccountUri,
+		},
+	}
+}
+
+func expandVirtualMachineIdentityOrNone(d *schema.ResourceData) *compute.VirtualMachineIdentity {
+	if v, ok := d.GetOk("identity"); ok && len(v.([]interface{})) > 0 {
+		return expandAzureRmVirtualMachineIdentity(d)
+	}
+
+	return &compute.VirtualMachineIdentity{
+		Type: compute.ResourceIdentityTypeNone,
+	}
+}
+
+func flattenVirtualMachineIdentity(input *compute.VirtualMachineIdentity) []interface{} {
+	if input == nil || input.Type == compute.ResourceIdentityTypeNone {
+		return []interface{}{}
+	}
+
+	return flattenAzureRmVirtualMachineIdentity(input)
+}
+
+func expandVirtualMachineNetworkInterfaceIDs(input []interface{}) *compute.NetworkProfile {
+	networkInterfaces := make([]compute.NetworkInterfaceReference, 0)
+
+	for i, v := range input {
+		networkInterfaces = append(networkInterfaces, compute.NetworkInterfaceReference{
+			ID: utils.String(v.(string)),
+			NetworkInterfaceReferenceProperties: &compute.NetworkInterfaceReferenceProperties{
+				// the first Network Interface is the Primary
+				Primary: utils.Bool(i == 0),
+			},
+		})
+	}
+
+	return &compute.NetworkProfile{
+		NetworkInterfaces: &networkInterfaces,
+	}
+}
+
+func flattenVirtualMachineNetworkInterfaceIDs(input *compute.NetworkProfile) []interface{} {
+	if input == nil || input.NetworkInterfaces == nil {
+		return []interface{}{}
+	}
+
+	output := make([]interface{}, 0)
+	for _, v := range *input.NetworkInterfaces {
+		if v.ID == nil {
+			continue
+		}
+
+		output = append(output, *v.ID)
+	}
+
+	return output
+}
+
+func expandVirtualMachineSecrets(input []interface{}) *[]compute.VaultSecretGroup {
+	output := make([]compute.VaultSecretGroup, 0)
+
+	for _, raw := range input {
+		v := raw.(map[string]interface{})
+
+		certificates := make([]compute.VaultCertificate, 0)
+		for _, certificateRaw := range v["certificate"].(*schema.Set).List() {
+			certificate := certificateRaw.(map[string]interface{})
+
+			vaultCertificate := compute.VaultCertificate{
+				CertificateURL: utils.String(certificate["url"].(string)),
+			}
+
+			// the Certificate Store is only applicable to Windows Virtual Machines
+			if store, ok := certificate["store"]; ok {
+				vaultCertificate.CertificateStore = utils.String(store.(string))
+			}
+
+			certificates = append(certificates, vaultCertificate)
+		}
+
+		output = append(output, compute.VaultSecretGroup{
+			SourceVault: &compute.SubResource{
+				ID: utils.String(v["key_vault_id"].(string)),
+			},
+			VaultCertificates: &certificates,
+		})
+	}
+
+	return &output
+}
+
+func flattenVirtualMachineSecrets(input *[]compute.VaultSecretGroup, includeStore bool) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	output := make([]interface{}, 0)
+	for _, v := range *input {
+		keyVaultId := ""
+		if v.SourceVault != nil && v.SourceVault.ID != nil {
+			keyVaultId = *v.SourceVault.ID
+		}
+
+		certificates := make([]interface{}, 0)
+		if v.VaultCertificates != nil {
+			for _, c := range *v.VaultCertificates {
+				certificate := make(map[string]interface{})
+
+				if c.CertificateURL != nil {
+					certificate["url"] = *c.CertificateURL
+				}
+
+				if includeStore {
+					store := ""
+					if c.CertificateStore != nil {
+						store = *c.CertificateStore
+					}
+					certificate["store"] = store
+				}
+
+				certificates = append(certificates, certificate)
+			}
+		}
+
+		output = append(output, map[string]interface{}{
+			"key_vault_id": keyVaultId,
+			"certificate":  certificates,
+		})
+	}
+
+	return output
+}
+
+// virtualMachineImporter returns an Importer which validates the Virtual Machine being imported
+// uses the specified Operating System, so that it's imported into the correct resource
+func virtualMachineImporter(osType compute.OperatingSystemTypes, resourceType string) *schema.ResourceImporter {
+	return &schema.ResourceImporter{
+		State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+			client := meta.(*ArmClient).Compute().VMClient
+			ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+			defer cancel()
+
+			id, err := azure.ParseVirtualMachineID(d.Id())
+			if err != nil {
+				return nil, fmt.Errorf("Error importing %q: %+v", d.Id(), err)
+			}
+
+			vm, err := client.Get(ctx, id.ResourceGroup, id.Name, "")
+			if err != nil {
+				return nil, fmt.Errorf("Error retrieving Virtual Machine %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+			}
+
+			actualOsType := virtualMachineOSType(vm)
+			if !strings.EqualFold(string(actualOsType), string(osType)) {
+				return nil, fmt.Errorf("The %s Virtual Machine %q (Resource Group %q) can't be imported into a %q resource", actualOsType, id.Name, id.ResourceGroup, resourceType)
+			}
+
+			// Virtual Machines provisioned using unmanaged disks need to use the `azurerm_virtual_machine` resource
+			if props := vm.VirtualMachineProperties; props != nil && props.StorageProfile != nil && props.StorageProfile.OsDisk != nil {
+				if props.StorageProfile.OsDisk.ManagedDisk == nil {
+					return nil, fmt.Errorf("The Virtual Machine %q (Resource Group %q) uses an unmanaged OS Disk, which isn't supported by the %q resource - use the `azurerm_virtual_machine` resource instead", id.Name, id.ResourceGroup, resourceType)
+				}
+			}
+
+			return []*schema.ResourceData{d}, nil
+		},
+	}
+}
+
+func virtualMachineOSType(vm compute.VirtualMachine) compute.OperatingSystemTypes {
+	props := vm.VirtualMachineProperties
+	if props == nil {
+		return ""
+	}
+
+	if props.StorageProfile != nil && props.StorageProfile.OsDisk != nil && props.StorageProfile.OsDisk.OsType != "" {
+		return props.StorageProfile.OsDisk.OsType
+	}
+
+	if props.OsProfile != nil {
+		if props.OsProfile.LinuxConfiguration != nil {
+			return compute.Linux
+		}
+
+		if props.OsProfile.WindowsConfiguration != nil {
+			return compute.Windows
+		}
+	}
+
+	return ""
+}
+
+// virtualMachineIsRunning returns whether the specified Virtual Machine is currently running, based on
+// the Power State within its Instance View
+func virtualMachineIsRunning(ctx context.Context, client compute.VirtualMachinesClient, resourceGroup string, name string) (bool, error) {
+	powerState, err := virtualMachinePowerState(ctx, client, resourceGroup, name)
+	if err != nil {
+		return false, err
+	}
+
+	return powerState == virtualMachinePowerStateRunning, nil
+}
+
+// virtualMachineDeallocate deallocates the specified Virtual Machine, which is required before certain
+// properties (such as the Network Interfaces or the size of the OS Disk) can be updated
+func virtualMachineDeallocate(ctx context.Context, client compute.VirtualMachinesClient, resourceGroup string, name string) error {
+	log.Printf("[DEBUG] Deallocating Virtual Machine %q (Resource Group %q)..", name, resourceGroup)
+	future, err := client.Deallocate(ctx, resourceGroup, name)
+	if err != nil {
+		return fmt.Errorf("Error deallocating Virtual Machine %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for Virtual Machine %q (Resource Group %q) to be deallocated: %+v", name, resourceGroup, err)
+	}
+
+	log.Printf("[DEBUG] Deallocated Virtual Machine %q (Resource Group %q)", name, resourceGroup)
+	return nil
+}
+
+// virtualMachineStart starts the specified Virtual Machine
+func virtualMachineStart(ctx context.Context, client compute.VirtualMachinesClient, resourceGroup string, name string) error {
+	log.Printf("[DEBUG] Starting Virtual Machine %q (Resource Group %q)..", name, resourceGroup)
+	future, err := client.Start(ctx, resourceGroup, name)
+	if err != nil {
+		return fmt.Errorf("Error starting Virtual Machine %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for Virtual Machine %q (Resource Group %q) to start: %+v", name, resourceGroup, err)
+	}
+
+	log.Printf("[DEBUG] Started Virtual Machine %q (Resource Group %q)", name, resourceGroup)
+	return nil
+}
+
+// virtualMachineRequiresDeallocationToResize returns whether the Virtual Machine needs to be deallocated to be resized
+// to the specified size - which is the case when this size isn't available on the hardware cluster currently hosting it
+func virtualMachineRequiresDeallocationToResize(ctx context.Context, client compute.VirtualMachinesClient, resourceGroup string, name string, size string) (bool, error) {
+	sizes, err := client.ListAvailableSizes(ctx, resourceGroup, name)
+	if err != nil {
+		return false, fmt.Errorf("Error retrieving the available sizes for Virtual Machine %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if sizes.Value != nil {
+		for _, v := range *sizes.Value {
+			if v.Name != nil && strings.EqualFold(*v.Name, size) {
+				return false, nil
+			}
+		}
+	}
+
+	log.Printf("[DEBUG] Size %q isn't available on the hardware cluster hosting Virtual Machine %q (Resource Group %q) - it'll need to be deallocated to resize", size, name, resourceGroup)
+	return true, nil
+}
+
+// updateVirtualMachineOSDisk updates the size and/or Storage Account Type of the Managed Disk used as the OS Disk
+// of a Virtual Machine - which requires that the Virtual Machine is deallocated
+func updateVirtualMachineOSDisk(ctx context.Context, client compute.DisksClient, diskId string, input []interface{}) error {
+	id, err := parseAzureResourceID(diskId)
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	name := id.Path["disks"]
+
+	raw := input[0].(map[string]interface{})
+	update := compute.DiskUpdate{
+		DiskUpdateProperties: &compute.DiskUpdateProperties{},
+		Sku: &compute.DiskSku{
+			Name: compute.DiskStorageAccountTypes(raw["storage_account_type"].(string)),
+		},
+	}
+	if size := raw["disk_size_gb"].(int); size > 0 {
+		update.DiskUpdateProperties.DiskSizeGB = utils.Int32(int32(size))
+	}
+
+	log.Printf("[DEBUG] Updating OS Disk %q (Resource Group %q)..", name, resourceGroup)
+	future, err := client.Update(ctx, resourceGroup, name, update)
+	if err != nil {
+		return fmt.Errorf("Error updating OS Disk %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for OS Disk %q (Resource Group %q) to be updated: %+v", name, resourceGroup, err)
+	}
+
+	return nil
+}
+
+// updateVirtualMachine updates the properties shared between the Linux and Windows Virtual Machine resources in-place,
+// deallocating the Virtual Machine (and returning it to the power state it was previously in) when required for the update
+func updateVirtualMachine(ctx context.Context, d *schema.ResourceData, meta interface{}, osType string, update compute.VirtualMachineUpdate) error {
+	client := meta.(*ArmClient).Compute().VMClient
+	disksClient := meta.(*ArmClient).Compute().DisksClient
+
+	id, err := azure.ParseVirtualMachineID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	azureRMLockByName(id.Name, virtualMachineResourceName)
+	defer azureRMUnlockByName(id.Name, virtualMachineResourceName)
+
+	if d.HasChange("boot_diagnostics") {
+		update.VirtualMachineProperties.DiagnosticsProfile = expandVirtualMachineBootDiagnostics(d.Get("boot_diagnostics").([]interface{}))
+	}
+
+	if d.HasChange("identity") {
+		update.Identity = expandVirtualMachineIdentityOrNone(d)
+	}
+
+	if d.HasChange("network_interface_ids") {
+		update.VirtualMachineProperties.NetworkProfile = expandVirtualMachineNetworkInterfaceIDs(d.Get("network_interface_ids").([]interface{}))
+	}
+
+	if d.HasChange("size") {
+		update.VirtualMachineProperties.HardwareProfile = &compute.HardwareProfile{
+			VMSize: compute.VirtualMachineSizeTypes(d.Get("size").(string)),
+		}
+	}
+
+	if d.HasChange("os_disk.0.caching") || d.HasChange("os_disk.0.write_accelerator_enabled") {
+		update.VirtualMachineProperties.StorageProfile = &compute.StorageProfile{
+			OsDisk: &compute.OSDisk{
+				Caching:                 compute.CachingTypes(d.Get("os_disk.0.caching").(string)),
+				WriteAcceleratorEnabled: utils.Bool(d.Get("os_disk.0.write_accelerator_enabled").(bool)),
+			},
+		}
+	}
+
+	update.Tags = expandTags(d.Get("tags").(map[string]interface{}))
+
+	// the Network Interfaces can only be changed, and the OS Disk can only be resized, when the Virtual Machine is deallocated
+	updateOSDisk := d.HasChange("os_disk.0.disk_size_gb") || d.HasChange("os_disk.0.storage_account_type")
+	shouldDeallocate := updateOSDisk || d.HasChange("network_interface_ids")
+
+	if d.HasChange("size") && !shouldDeallocate {
+		shouldDeallocate, err = virtualMachineRequiresDeallocationToResize(ctx, client, id.ResourceGroup, id.Name, d.Get("size").(string))
+		if err != nil {
+			return err
+		}
+	}
+
+	previousPowerState := ""
+	restorePowerState := false
+	defer func() {
+		if restorePowerState {
+			restoreVirtualMachinePowerState(ctx, client, id.ResourceGroup, id.Name, previousPowerState)
+		}
+	}()
+	if shouldDeallocate {
+		previousPowerState, err = virtualMachinePowerState(ctx, client, id.ResourceGroup, id.Name)
+		if err != nil {
+			return err
+		}
+		if previousPowerState == "" {
+			log.Printf("[WARN] Unable to determine the power state of %s Virtual Machine %q (Resource Group %q) - it'll remain deallocated once updated", osType, id.Name, id.ResourceGroup)
+		}
+
+		if err := virtualMachineDeallocate(ctx, client, id.ResourceGroup, id.Name); err != nil {
+			return err
+		}
+
+		// should the update fail, the Virtual Machine is returned to its previous power state on a best-effort basis
+		restorePowerState = true
+	}
+
+	if updateOSDisk {
+		if err := updateVirtualMachineOSDisk(ctx, disksClient, d.Get("os_disk.0.id").(string), d.Get("os_disk").([]interface{})); err != nil {
+			return err
+		}
+	}
+
+	log.Printf("[DEBUG] Updating %s Virtual Machine %q (Resource Group %q)..", osType, id.Name, id.ResourceGroup)
+	future, err := client.Update(ctx, id.ResourceGroup, id.Name, update)
+	if err != nil {
+		return fmt.Errorf("Error updating %s Virtual Machine %q (Resource Group %q): %+v", osType, id.Name, id.ResourceGroup, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for update of %s Virtual Machine %q (Resource Group %q): %+v", osType, id.Name, id.ResourceGroup, err)
+	}
+	restorePowerState = false
+
+	// a Virtual Machine which was stopped (rather than deallocated) is started and then powered off again
+	if shouldDeallocate && previousPowerState != "" {
+		if err := setVirtualMachinePowerState(ctx, client, id.ResourceGroup, id.Name, previousPowerState); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// deleteVirtualMachine deletes a Linux or Windows Virtual Machine and its OS Disk, which is managed by the resource
+func deleteVirtualMachine(ctx context.Context, d *schema.ResourceData, meta interface{}, osType string) error {
+	client := meta.(*ArmClient).Compute().VMClient
+
+	id, err := azure.ParseVirtualMachineID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	azureRMLockByName(id.Name, virtualMachineResourceName)
+	defer azureRMUnlockByName(id.Name, virtualMachineResourceName)
+
+	existing, err := client.Get(ctx, id.ResourceGroup, id.Name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(existing.Response) {
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving %s Virtual Machine %q (Resource Group %q): %+v", osType, id.Name, id.ResourceGroup, err)
+	}
+
+	osDiskId := ""
+	if props := existing.VirtualMachineProperties; props != nil && props.StorageProfile != nil && props.StorageProfile.OsDisk != nil {
+		if disk := props.StorageProfile.OsDisk.ManagedDisk; disk != nil && disk.ID != nil {
+			osDiskId = *disk.ID
+		}
+	}
+
+	log.Printf("[DEBUG] Deleting %s Virtual Machine %q (Resource Group %q)..", osType, id.Name, id.ResourceGroup)
+	future, err := client.Delete(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		return fmt.Errorf("Error deleting %s Virtual Machine %q (Resource Group %q): %+v", osType, id.Name, id.ResourceGroup, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for deletion of %s Virtual Machine %q (Resource Group %q): %+v", osType, id.Name, id.ResourceGroup, err)
+	}
+
+	// the OS Disk is always deleted, since it can't be reused by another Virtual Machine resource
+	if osDiskId != "" {
+		log.Printf("[DEBUG] Deleting OS Disk %q from %s Virtual Machine %q (Resource Group %q)..", osDiskId, osType, id.Name, id.ResourceGroup)
+		if err := resourceArmVirtualMachineDeleteManagedDisk(ctx, osDiskId, meta); err != nil {
+			return fmt.Errorf("Error deleting OS Disk from %s Virtual Machine %q (Resource Group %q): %+v", osType, id.Name, id.ResourceGroup, err)
+		}
+	}
+
+	return nil
+}
+
+// validateVirtualMachineOSDisk validates that the OS Disk isn't being shrunk, since Managed Disks can only be expanded
+func validateVirtualMachineOSDisk(d *schema.ResourceDiff) error {
+	if d.Id() == "" || !d.HasChange("os_disk.0.disk_size_gb") {
+		return nil
+	}
+
+	oldSize, newSize := d.GetChange("os_disk.0.disk_size_gb")
+	if newSize.(int) > 0 && newSize.(int) < oldSize.(int) {
+		return fmt.Errorf("The `disk_size_gb` of the `os_disk` can only be increased - but was being changed from %d to %d", oldSize.(int), newSize.(int))
+	}
+
+	return nil
+}
+
+// validateVirtualMachineSourceImage validates that exactly one of `source_image_id` and `source_image_reference`
+// is specified, since the API doesn't allow both
+func validateVirtualMachineSourceImage(d *schema.ResourceDiff) error {
+	if !d.NewValueKnown("source_image_id") || !d.NewValueKnown("source_image_reference") {
+		return nil
+	}
+
+	hasSourceImageId := d.Get("source_image_id").(string) != ""
+	hasSourceImageReference := len(d.Get("source_image_reference").([]interface{})) > 0
+	if hasSourceImageId == hasSourceImageReference {
+		return fmt.Errorf("Exactly one of `source_image_id` or `source_image_reference` must be specified")
+	}
+
+	return nil
+}
+
+// validateVirtualMachineComputeCapacity validates the availability of the `size` of a Virtual Machine, when
+// the `compute_capacity` feature is enabled
+func validateVirtualMachineComputeCapacity(d *schema.ResourceDiff, meta interface{}) error {
+	if !shouldValidateComputeCapacity(d, meta, "location", "size", "zone") {
+		return nil
+	}
+
+	existing := make([]computeCapacityRequest, 0)
+	if d.Id() != "" && !d.HasChange("location") {
+		oldSize, _ := d.GetChange("size")
+		existing = append(existing, computeCapacityRequest{
+			ResourceType: computeCapacityResourceTypeVirtualMachines,
+			Sku:          oldSize.(string),
+			Instances:    1,
+		})
+	}
+
+	requested := []computeCapacityRequest{
+		{
+			ResourceType: computeCapacityResourceTypeVirtualMachines,
+			Sku:          d.Get("size").(string),
+			Instances:    1,
+		},
+	}
+
+	zones := make([]string, 0)
+	if zone := d.Get("zone").(string); zone != "" {
+		zones = append(zones, zone)
+	}
+
+	return validateComputeCapacity(meta, d.Get("location").(string), zones, existing, requested)
+}